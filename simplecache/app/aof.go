@@ -0,0 +1,342 @@
+package app
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	FsyncAlways   = "always"
+	FsyncEverySec = "everysec"
+	FsyncNever    = "no"
+)
+
+var defaultAOFRewriteMinSize int64 = 64 * 1024 * 1024
+
+// aof is the append-only log of commands that mutated the cache.
+type aof struct {
+	mu    sync.Mutex
+	path  string
+	file  *os.File
+	fsync string
+	dirty bool
+
+	// size is the current size of the log and baseSize its size right after
+	// the last rewrite. The log is rewritten once it doubles.
+	size           int64
+	baseSize       int64
+	rewriteMinSize int64
+
+	// While a rewrite is running new commands are also collected in
+	// rewriteBuf, to be appended to the rewritten log.
+	rewriting  bool
+	rewriteBuf []byte
+
+	stop chan bool
+}
+
+func openAOF(path string, fsync string, rewriteMinSize int64) (*aof, error) {
+	switch fsync {
+	case FsyncAlways, FsyncEverySec, FsyncNever:
+	case "":
+		fsync = FsyncEverySec
+	default:
+		return nil, errors.New("unknown fsync policy " + fsync)
+	}
+
+	if rewriteMinSize <= 0 {
+		rewriteMinSize = defaultAOFRewriteMinSize
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return &aof{
+		path:           path,
+		file:           f,
+		fsync:          fsync,
+		size:           info.Size(),
+		baseSize:       info.Size(),
+		rewriteMinSize: rewriteMinSize,
+		stop:           make(chan bool),
+	}, nil
+}
+
+func (l *aof) append(cmd command) {
+	b, err := marshalJSON(cmd)
+	if err != nil {
+		log.Println("aof:", err)
+		return
+	}
+	b = append(b, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rewriting {
+		l.rewriteBuf = append(l.rewriteBuf, b...)
+	}
+
+	n, err := l.file.Write(b)
+	l.size += int64(n)
+	if err != nil {
+		log.Println("aof:", err)
+		return
+	}
+
+	if l.fsync == FsyncAlways {
+		if err := l.file.Sync(); err != nil {
+			log.Println("aof:", err)
+		}
+		return
+	}
+	l.dirty = true
+}
+
+func (l *aof) sync() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.dirty {
+		return
+	}
+	if err := l.file.Sync(); err != nil {
+		log.Println("aof:", err)
+		return
+	}
+	l.dirty = false
+}
+
+func (l *aof) needsRewrite() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return !l.rewriting && l.size > l.rewriteMinSize && l.size > 2*l.baseSize
+}
+
+func (l *aof) close() error {
+	close(l.stop)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.file.Sync(); err != nil {
+		l.file.Close()
+		return err
+	}
+
+	return l.file.Close()
+}
+
+// runAOF syncs the log once a second when the policy is everysec and
+// starts a background rewrite when the log has grown enough.
+func runAOF(c *cache, l *aof) {
+	ticker := time.NewTicker(time.Second)
+	for {
+		select {
+		case <-ticker.C:
+			if l.fsync == FsyncEverySec {
+				l.sync()
+			}
+			if l.needsRewrite() {
+				if err := c.rewriteAOF(); err != nil {
+					log.Println("aof rewrite:", err)
+				}
+			}
+		case <-l.stop:
+			ticker.Stop()
+			return
+		}
+	}
+}
+
+// rewriteAOF compacts the log into one restore command per key. Commands
+// logged while the new file is written are appended to it before it
+// replaces the old log.
+func (c *cache) rewriteAOF() error {
+	c.rlockAll()
+	l := c.aof
+	if l == nil {
+		c.runlockAll()
+		return errors.New("append-only log is disabled")
+	}
+	l.mu.Lock()
+	if l.rewriting {
+		l.mu.Unlock()
+		c.runlockAll()
+		return errors.New("rewrite is already in progress")
+	}
+	l.rewriting = true
+	l.rewriteBuf = nil
+	l.mu.Unlock()
+	entries := c.copyItems()
+	c.runlockAll()
+
+	f, err := os.CreateTemp(filepath.Dir(l.path), filepath.Base(l.path)+".rewrite")
+	if err != nil {
+		l.abortRewrite()
+		return err
+	}
+
+	if err := writeRestoreCommands(f, entries); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		l.abortRewrite()
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rewriting = false
+	buf := l.rewriteBuf
+	l.rewriteBuf = nil
+
+	if _, err := f.Write(buf); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := os.Rename(f.Name(), l.path); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	l.file.Close()
+	l.file = f
+	l.size = info.Size()
+	l.baseSize = l.size
+	l.dirty = false
+
+	return nil
+}
+
+func (l *aof) abortRewrite() {
+	l.mu.Lock()
+	l.rewriting = false
+	l.rewriteBuf = nil
+	l.mu.Unlock()
+}
+
+func writeRestoreCommands(f *os.File, entries []snapshotEntry) error {
+	w := bufio.NewWriter(f)
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	for _, e := range entries {
+		r, err := encodeItem(e.key, e.item)
+		if err != nil {
+			return err
+		}
+		cmd := command{Op: "restore", Key: r.Key, Type: r.Type, Value: r.Value, Expired: r.Expired, Version: r.Version}
+		if err := encoder.Encode(cmd); err != nil {
+			return err
+		}
+	}
+
+	return w.Flush()
+}
+
+// replayAOF applies every command from the log at path. A command cut off
+// by a crash at the end of the file is ignored.
+func (c *cache) replayAOF(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	decoder := json.NewDecoder(bufio.NewReader(f))
+	for {
+		var cmd command
+		err := decoder.Decode(&cmd)
+		if err == io.EOF {
+			return nil
+		}
+		if err == io.ErrUnexpectedEOF {
+			log.Println("aof: ignoring truncated command at the end of", path)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := c.apply(cmd); err != nil {
+			return err
+		}
+	}
+}
+
+// enableAOF loads the keyspace from the log at path and starts logging
+// to it. If there is no log yet, the current keyspace is written to it
+// first.
+func (c *cache) enableAOF(path string, fsync string, rewriteMinSize int64) error {
+	_, err := os.Stat(path)
+	exists := err == nil
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	if exists {
+		if err := c.replayAOF(path); err != nil {
+			return err
+		}
+	}
+
+	l, err := openAOF(path, fsync, rewriteMinSize)
+	if err != nil {
+		return err
+	}
+
+	c.lockAll()
+	c.aof = l
+	c.unlockAll()
+
+	if !exists {
+		if err := c.rewriteAOF(); err != nil {
+			return err
+		}
+	}
+
+	go runAOF(c, l)
+
+	return nil
+}
+
+func (c *cache) disableAOF() error {
+	c.lockAll()
+	l := c.aof
+	c.aof = nil
+	c.unlockAll()
+
+	if l == nil {
+		return nil
+	}
+
+	return l.close()
+}
@@ -0,0 +1,132 @@
+package app
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCache_AOFReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.aof")
+
+	tc := NewCache(time.Duration(5 * time.Millisecond))
+	tc.ExpiredTimeMultiplier = time.Millisecond
+	if err := tc.enableAOF(path, FsyncAlways, 0); err != nil {
+		t.Fatal("Can't enable aof", err)
+	}
+
+	tc.set("s", "value", 0)
+	tc.set("d", "deleted", 0)
+	tc.deleteItem("d")
+	tc.set("e", "expired", 5)
+	tc.rpush("l", "a", 0)
+	tc.rpush("l", "b", 0)
+	tc.rpush("l", "c", 0)
+	tc.pop("l")
+	tc.hset("h", map[string]interface{}{"name": "Igor"}, 0)
+	tc.hset("h", map[string]interface{}{"age": 28}, 0)
+
+	<-time.After(20 * time.Millisecond)
+
+	if err := tc.disableAOF(); err != nil {
+		t.Fatal("Can't close aof", err)
+	}
+
+	lc := NewCache(0)
+	if err := lc.enableAOF(path, FsyncAlways, 0); err != nil {
+		t.Fatal("Can't replay aof", err)
+	}
+	defer lc.disableAOF()
+
+	if s, err := lc.get("s"); err != nil || s != "value" {
+		t.Error("String value doesn't replayed", s)
+	}
+
+	if _, err := lc.get("d"); err == nil {
+		t.Error("Found d that was deleted")
+	}
+
+	if _, found := lc.getShard("e").items["e"]; found {
+		t.Error("Found e that was expired by janitor")
+	}
+
+	list, err := lc.lgetall("l")
+	if err != nil || len(list) != 2 || list[0] != "a" || list[1] != "b" {
+		t.Error("List value doesn't replayed", list)
+	}
+
+	dict, err := lc.hgetall("h")
+	if err != nil || len(dict) != 2 || dict["name"] != "Igor" || dict["age"] != json.Number("28") {
+		t.Error("Dict value doesn't replayed", dict)
+	}
+}
+
+func TestCache_AOFRewrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.aof")
+
+	tc := NewCache(0)
+	if err := tc.enableAOF(path, FsyncNever, 0); err != nil {
+		t.Fatal("Can't enable aof", err)
+	}
+
+	for i := 0; i < 100; i++ {
+		tc.set("counter", i, 0)
+		tc.rpush("l", i, 0)
+	}
+	for i := 0; i < 50; i++ {
+		tc.pop("l")
+	}
+
+	before, _ := os.Stat(path)
+	if err := tc.rewriteAOF(); err != nil {
+		t.Fatal("Can't rewrite aof", err)
+	}
+	after, _ := os.Stat(path)
+
+	if after.Size() >= before.Size() {
+		t.Error("Rewritten aof isn't smaller", before.Size(), after.Size())
+	}
+
+	tc.set("afterRewrite", "value", 0)
+	tc.disableAOF()
+
+	lc := NewCache(0)
+	if err := lc.enableAOF(path, FsyncNever, 0); err != nil {
+		t.Fatal("Can't replay aof", err)
+	}
+	defer lc.disableAOF()
+
+	if v, err := lc.get("counter"); err != nil || v != json.Number("99") {
+		t.Error("Counter doesn't equals 99 after rewrite", v)
+	}
+
+	if list, err := lc.lgetall("l"); err != nil || len(list) != 50 {
+		t.Error("Length of list after rewrite doesn't equals 50", list)
+	}
+
+	if v, err := lc.get("afterRewrite"); err != nil || v != "value" {
+		t.Error("Command written after rewrite is lost", v)
+	}
+}
+
+func TestCache_AOFTruncated(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.aof")
+	data := `{"op":"set","key":"a","value":1}` + "\n" + `{"op":"set","key":"b","val`
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tc := NewCache(0)
+	if err := tc.replayAOF(path); err != nil {
+		t.Fatal("Truncated aof isn't replayed", err)
+	}
+
+	if _, err := tc.get("a"); err != nil {
+		t.Error("Can't find a that was written before truncated command")
+	}
+	if _, err := tc.get("b"); err == nil {
+		t.Error("Found b from truncated command")
+	}
+}
@@ -1,60 +1,590 @@
 package app
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/gorilla/mux"
+	"golang.org/x/net/websocket"
+	"io"
 	"log"
+	"net"
 	"net/http"
+	"os"
+	"os/signal"
 	"strconv"
+	"sync"
+	"sync/atomic"
+	"syscall"
 	"time"
 )
 
+// setObject is the body of the string writes. Value is kept as it was
+// written, see jsonValue.
 type setObject struct {
-	Key     string      `json:"key"`
-	Expired int         `json:"expired"`
-	Value   interface{} `json:"value"`
+	Key     string          `json:"key"`
+	Expired int             `json:"expired"`
+	Value   json.RawMessage `json:"value"`
+	NX      bool            `json:"nx"`
+	XX      bool            `json:"xx"`
+}
+
+type casObject struct {
+	Key     string          `json:"key"`
+	Expired int             `json:"expired"`
+	Value   json.RawMessage `json:"value"`
+	Version int64           `json:"version"`
+}
+
+// expireObject sets a TTL of Seconds plus Milliseconds.
+type expireObject struct {
+	Key          string `json:"key"`
+	Seconds      int64  `json:"seconds"`
+	Milliseconds int64  `json:"milliseconds"`
+}
+
+// expireAtObject sets an expiry at a unix time in seconds, or in
+// milliseconds when TimestampMs is given.
+type expireAtObject struct {
+	Key         string `json:"key"`
+	Timestamp   int64  `json:"timestamp"`
+	TimestampMs int64  `json:"timestamp_ms"`
+}
+
+type publishObject struct {
+	Channel string          `json:"channel"`
+	Message json.RawMessage `json:"message"`
+}
+
+// txObject is the body of a transaction that only applies when the
+// watched keys still have the given versions, 0 for a missing key.
+type txObject struct {
+	Watch    map[string]int64 `json:"watch"`
+	Commands []txCommand      `json:"commands"`
+}
+
+// incrObject is the body of the counter requests. Expired is applied when
+// the key is created, and on every increment when Refresh is set.
+type incrObject struct {
+	Key     string `json:"key"`
+	Expired int    `json:"expired"`
+	Refresh bool   `json:"refresh"`
+	Value   int64  `json:"value"`
+}
+
+type incrFloatObject struct {
+	Key     string  `json:"key"`
+	Expired int     `json:"expired"`
+	Refresh bool    `json:"refresh"`
+	Value   float64 `json:"value"`
+}
+
+// pushObject is the body of the list pushes. Values are kept as they were
+// written, like those of setObject.
+type pushObject struct {
+	Key     string            `json:"key"`
+	Expired int               `json:"expired"`
+	Value   json.RawMessage   `json:"value"`
+	Values  []json.RawMessage `json:"values"`
+}
+
+// values returns the elements of "values", or the single "value" when
+// there are none.
+func (po pushObject) values() []interface{} {
+	if len(po.Values) == 0 {
+		return []interface{}{jsonValue(po.Value)}
+	}
+
+	return jsonValues(po.Values)
+}
+
+type lsetObject struct {
+	Key   string          `json:"key"`
+	Index int             `json:"index"`
+	Value json.RawMessage `json:"value"`
+}
+
+type lremObject struct {
+	Key   string          `json:"key"`
+	Count int             `json:"count"`
+	Value json.RawMessage `json:"value"`
+}
+
+type ltrimObject struct {
+	Key   string `json:"key"`
+	Start int    `json:"start"`
+	Stop  int    `json:"stop"`
+}
+
+type linsertObject struct {
+	Key      string          `json:"key"`
+	Position string          `json:"position"`
+	Pivot    json.RawMessage `json:"pivot"`
+	Value    json.RawMessage `json:"value"`
 }
 
 type setHObject struct {
-	Key     string                 `json:"key"`
-	Expired int                    `json:"expired"`
-	Value   map[string]interface{} `json:"value"`
+	Key     string                     `json:"key"`
+	Expired int                        `json:"expired"`
+	Value   map[string]json.RawMessage `json:"value"`
+}
+
+type saddObject struct {
+	Key     string   `json:"key"`
+	Expired int      `json:"expired"`
+	Value   []string `json:"value"`
+}
+
+type sremObject struct {
+	Key   string   `json:"key"`
+	Value []string `json:"value"`
+}
+
+type setStoreObject struct {
+	Destination string   `json:"destination"`
+	Keys        []string `json:"keys"`
+}
+
+type hdelObject struct {
+	Key    string   `json:"key"`
+	Fields []string `json:"fields"`
+}
+
+type hsetnxObject struct {
+	Key     string          `json:"key"`
+	Expired int             `json:"expired"`
+	Field   string          `json:"field"`
+	Value   json.RawMessage `json:"value"`
+}
+
+type hincrbyObject struct {
+	Key   string `json:"key"`
+	Field string `json:"field"`
+	Value int64  `json:"value"`
+}
+
+type hincrbyfloatObject struct {
+	Key   string  `json:"key"`
+	Field string  `json:"field"`
+	Value float64 `json:"value"`
+}
+
+type zaddObject struct {
+	Key     string    `json:"key"`
+	Expired int       `json:"expired"`
+	Value   []zmember `json:"value"`
+	NX      bool      `json:"nx"`
+	XX      bool      `json:"xx"`
+	Incr    bool      `json:"incr"`
+}
+
+type zremObject struct {
+	Key   string   `json:"key"`
+	Value []string `json:"value"`
+}
+
+// moveObject moves Key from the database of the request to DB.
+type moveObject struct {
+	Key string `json:"key"`
+	DB  int    `json:"db"`
+}
+
+type Config struct {
+	// Shards is the number of independently locked parts of the keyspace.
+	Shards int
+
+	// SnapshotPath is the file the keyspace is saved to and loaded from.
+	// Snapshots are disabled when it is empty.
+	SnapshotPath     string
+	SnapshotInterval time.Duration
+
+	// AOFPath is the append-only log of all mutations. When the log exists
+	// it is replayed on startup instead of loading the snapshot.
+	AOFPath           string
+	AOFFsync          string
+	AOFRewriteMinSize int64
+
+	// MaxMemory limits the estimated memory used by the values and MaxKeys
+	// the number of keys, zero means no limit. EvictionPolicy decides which
+	// keys are removed when a limit is reached.
+	MaxMemory       int64
+	MaxKeys         int64
+	EvictionPolicy  string
+	EvictionSamples int
+
+	// SubscriberBuffer is the number of messages a pub/sub subscriber may
+	// fall behind before it is disconnected.
+	SubscriberBuffer int
+
+	// RESPAddr is the address of the listener speaking the Redis protocol,
+	// it's disabled when empty.
+	RESPAddr string
+
+	// GRPCAddr is the address of the gRPC server, it's disabled when empty.
+	GRPCAddr string
+
+	// Databases is the number of logical databases, each with its own
+	// keyspace. Zero means a single one. Database n > 0 is persisted to
+	// the snapshot and log paths with the suffix ".n".
+	Databases int
+
+	// Follow is the URL of the leader the instance replicates, it's a
+	// read-only follower then. ReplBacklogSize is the size in bytes of the
+	// latest commands a leader keeps for followers that reconnect.
+	Follow          string
+	ReplBacklogSize int
+
+	// ClusterAddr is the URL other nodes and clients reach the HTTP API
+	// of the node at. It enables cluster mode, where the node serves only
+	// the hash slots the slot map assigns to it.
+	ClusterAddr string
+
+	// RaftAddr is the URL the other members of a raft group reach the node
+	// at. It enables raft mode, where every write is committed to the raft
+	// log of the group before it's applied. RaftPeers are the members of a
+	// new group including the node itself, a node joining a running group
+	// starts without them. RaftDir keeps the log and the snapshots, they
+	// are kept in memory only when it's empty. RaftSnapshotEntries is the
+	// number of applied entries the log is compacted after.
+	RaftAddr            string
+	RaftPeers           []string
+	RaftDir             string
+	RaftSnapshotEntries int
 }
 
 type App struct {
+	// cache is the default database, dbs[0].
 	cache  *cache
+	dbs    []*cache
+	config Config
+	stop   chan bool
 	Router *mux.Router
+
+	// repl is the replication backlog of all databases, replicas the
+	// followers connected to the instance and follower the state of
+	// following a leader, nil for a leader.
+	repl       *backlog
+	replicasMu sync.Mutex
+	replicas   map[*replica]bool
+	follower   atomic.Pointer[follower]
+
+	// cluster is the slot map in cluster mode, nil otherwise.
+	cluster *cluster
+
+	// raft is the node of the raft group in raft mode, nil otherwise.
+	raft      *raftNode
+	raftBatch raftBatch
+}
+
+func NewApp(config Config) (*App, error) {
+	switch config.EvictionPolicy {
+	case "", NoEviction, AllKeysLRU, AllKeysLFU, AllKeysRandom, VolatileLRU, VolatileTTL:
+	default:
+		return nil, errors.New("unknown eviction policy " + config.EvictionPolicy)
+	}
+
+	if config.RaftAddr != "" {
+		switch {
+		case config.Follow != "" || config.ClusterAddr != "":
+			return nil, errors.New("raft mode can't be combined with replication or cluster mode")
+		case config.SnapshotPath != "" || config.AOFPath != "":
+			return nil, errors.New("raft mode keeps the data in the raft directory, snapshots and the append-only log must be disabled")
+		case (config.MaxMemory > 0 || config.MaxKeys > 0) && config.EvictionPolicy != "" && config.EvictionPolicy != NoEviction:
+			return nil, errors.New("raft mode needs the noeviction policy, evictions would differ between the nodes")
+		}
+	}
+
+	databases := config.Databases
+	if databases <= 0 {
+		databases = 1
+	}
+
+	a := &App{
+		dbs:      make([]*cache, databases),
+		config:   config,
+		stop:     make(chan bool),
+		Router:   mux.NewRouter(),
+		repl:     newBacklog(config.ReplBacklogSize),
+		replicas: map[*replica]bool{},
+	}
+
+	for i := range a.dbs {
+		c, err := a.newDB(i)
+		if err != nil {
+			return nil, err
+		}
+		a.dbs[i] = c
+	}
+	a.cache = a.dbs[0]
+
+	if config.ClusterAddr != "" {
+		a.cluster = newCluster(config)
+	}
+
+	if config.Follow != "" {
+		a.startFollowing(config.Follow)
+	}
+
+	if config.RaftAddr != "" {
+		if err := a.newRaft(config, &httpRaftTransport{client: &http.Client{}}); err != nil {
+			return nil, err
+		}
+	}
+
+	return a, nil
 }
 
-func NewApp() *App {
-	return &App{
-		cache:  NewCache(time.Duration(1 * time.Second)),
-		Router: mux.NewRouter(),
+// newDB creates the database with index db and loads its data. All
+// databases share the pub/sub channels of the first one.
+func (a *App) newDB(db int) (*cache, error) {
+	config := a.config
+	c := NewShardedCache(time.Duration(1*time.Second), config.Shards)
+
+	if config.EvictionPolicy != "" {
+		c.EvictionPolicy = config.EvictionPolicy
+	}
+	c.MaxMemory = config.MaxMemory
+	c.MaxKeys = config.MaxKeys
+	if config.EvictionSamples > 0 {
+		c.EvictionSamples = config.EvictionSamples
+	}
+	if config.SubscriberBuffer > 0 {
+		c.pubsub.bufferSize = config.SubscriberBuffer
+		c.keyspace.bufferSize = config.SubscriberBuffer
+	}
+	if db > 0 {
+		c.pubsub = a.dbs[0].pubsub
+	}
+	c.db = db
+	c.repl = a.repl
+
+	loadSnapshot := config.SnapshotPath != ""
+	if config.AOFPath != "" {
+		if _, err := os.Stat(dbPath(config.AOFPath, db)); err == nil {
+			loadSnapshot = false
+		}
+	}
+
+	if loadSnapshot {
+		if err := c.loadSnapshot(dbPath(config.SnapshotPath, db)); err != nil {
+			return nil, err
+		}
+	}
+
+	if config.AOFPath != "" {
+		if err := c.enableAOF(dbPath(config.AOFPath, db), config.AOFFsync, config.AOFRewriteMinSize); err != nil {
+			return nil, err
+		}
 	}
+
+	return c, nil
 }
 
 func (a *App) Initialize() {
 	a.initializeRoutes()
+	if a.raft != nil {
+		a.raft.start()
+		go a.runRaftExpiry()
+	}
 }
 
 func (a *App) initializeRoutes() {
-	a.Router.HandleFunc("/get/{key}", a.get).Methods("GET")
-	a.Router.HandleFunc("/set", a.set).Methods("POST")
-	a.Router.HandleFunc("/keys", a.keys).Methods("GET")
-	a.Router.HandleFunc("/unset/{key}", a.unset).Methods("DELETE")
-	a.Router.HandleFunc("/rpush", a.rpush).Methods("POST")
-	a.Router.HandleFunc("/pop/{key}", a.pop).Methods("GET")
-	a.Router.HandleFunc("/lgetall/{key}", a.lgetall).Methods("GET")
-	a.Router.HandleFunc("/lget/{key}/{id:[0-9]+}", a.lget).Methods("GET")
-	a.Router.HandleFunc("/hset", a.hset).Methods("POST")
-	a.Router.HandleFunc("/hgetall/{key}", a.hgetall).Methods("GET")
-	a.Router.HandleFunc("/hget/{key}/{dictKey}", a.hget).Methods("GET")
+	a.Router.Use(a.selectDB)
+	a.Router.Use(a.rejectWrites)
+	a.Router.HandleFunc("/replication", a.replication).Methods("GET")
+	a.Router.HandleFunc("/replication/sync", a.replicationSync).Methods("GET")
+	a.Router.HandleFunc("/replication/follow", a.follow).Methods("POST")
+	a.Router.HandleFunc("/replication/promote", a.promote).Methods("POST")
+	if a.cluster != nil {
+		a.Router.Use(a.routeSlot)
+		a.Router.HandleFunc("/cluster/node", a.clusterNode).Methods("GET")
+		a.Router.HandleFunc("/cluster/slots", a.clusterSlots).Methods("GET")
+		a.Router.HandleFunc("/cluster/state", a.clusterState).Methods("POST")
+		a.Router.HandleFunc("/cluster/assign", a.clusterAssign).Methods("POST")
+		a.Router.HandleFunc("/cluster/migrate", a.clusterMigrate).Methods("POST")
+		a.Router.HandleFunc("/cluster/import", a.clusterImport).Methods("POST")
+		a.Router.HandleFunc("/cluster/restore", a.clusterRestore).Methods("POST")
+	}
+	if a.raft != nil {
+		a.Router.Use(a.raftWrites)
+		a.Router.HandleFunc("/raft", a.raftStatus).Methods("GET")
+		a.Router.HandleFunc("/raft/join", a.raftJoin).Methods("POST")
+		a.Router.HandleFunc("/raft/leave", a.raftLeave).Methods("POST")
+		a.Router.HandleFunc("/raft/vote", a.raftVote).Methods("POST")
+		a.Router.HandleFunc("/raft/append", a.raftAppend).Methods("POST")
+		a.Router.HandleFunc("/raft/snapshot", a.raftInstallSnapshot).Methods("POST")
+	}
+	a.addRoutes(a.Router.PathPrefix(dbPrefix).Subrouter())
+	a.addRoutes(a.Router)
+}
+
+// addRoutes registers the endpoints on r, the router itself or the one of
+// the /db/{db} prefix.
+func (a *App) addRoutes(r *mux.Router) {
+	r.HandleFunc("/get/{key}", a.get).Methods("GET")
+	r.HandleFunc("/set", a.set).Methods("POST")
+	r.HandleFunc("/gets/{key}", a.gets).Methods("GET")
+	r.HandleFunc("/version/{key}", a.version).Methods("GET")
+	r.HandleFunc("/versions", a.versions).Methods("GET")
+	r.HandleFunc("/cas", a.cas).Methods("POST")
+	r.HandleFunc("/getset", a.getset).Methods("POST")
+	r.HandleFunc("/setbytes/{key}", a.setBytes).Methods("POST", "PUT")
+	r.HandleFunc("/getbytes/{key}", a.getBytes).Methods("GET", "HEAD")
+	r.HandleFunc("/append/{key}", a.appendBytes).Methods("POST")
+	r.HandleFunc("/keys", a.keys).Methods("GET")
+	r.HandleFunc("/scan", a.scan).Methods("GET")
+	r.HandleFunc("/incr", a.incr).Methods("POST")
+	r.HandleFunc("/decr", a.decr).Methods("POST")
+	r.HandleFunc("/incrby", a.incrby).Methods("POST")
+	r.HandleFunc("/incrbyfloat", a.incrbyfloat).Methods("POST")
+	r.HandleFunc("/unset/{key}", a.unset).Methods("DELETE")
+	r.HandleFunc("/expire", a.expire).Methods("POST")
+	r.HandleFunc("/expireat", a.expireat).Methods("POST")
+	r.HandleFunc("/persist/{key}", a.persist).Methods("POST")
+	r.HandleFunc("/ttl/{key}", a.ttl).Methods("GET")
+	r.HandleFunc("/pttl/{key}", a.pttl).Methods("GET")
+	r.HandleFunc("/rpush", a.rpush).Methods("POST")
+	r.HandleFunc("/lpush", a.lpush).Methods("POST")
+	r.HandleFunc("/pop/{key}", a.pop).Methods("GET")
+	r.HandleFunc("/lpop/{key}", a.lpop).Methods("GET")
+	r.HandleFunc("/blpop", a.blpop).Methods("GET")
+	r.HandleFunc("/brpop", a.brpop).Methods("GET")
+	r.HandleFunc("/lgetall/{key}", a.lgetall).Methods("GET")
+	r.HandleFunc("/lget/{key}/{id:-?[0-9]+}", a.lget).Methods("GET")
+	r.HandleFunc("/llen/{key}", a.llen).Methods("GET")
+	r.HandleFunc("/lrange/{key}/{start:-?[0-9]+}/{stop:-?[0-9]+}", a.lrange).Methods("GET")
+	r.HandleFunc("/lset", a.lset).Methods("POST")
+	r.HandleFunc("/lrem", a.lrem).Methods("POST")
+	r.HandleFunc("/ltrim", a.ltrim).Methods("POST")
+	r.HandleFunc("/linsert", a.linsert).Methods("POST")
+	r.HandleFunc("/hset", a.hset).Methods("POST")
+	r.HandleFunc("/hgetall/{key}", a.hgetall).Methods("GET")
+	r.HandleFunc("/hget/{key}/{dictKey}", a.hget).Methods("GET")
+	r.HandleFunc("/hdel", a.hdel).Methods("POST")
+	r.HandleFunc("/hexists/{key}/{dictKey}", a.hexists).Methods("GET")
+	r.HandleFunc("/hlen/{key}", a.hlen).Methods("GET")
+	r.HandleFunc("/hkeys/{key}", a.hkeys).Methods("GET")
+	r.HandleFunc("/hvals/{key}", a.hvals).Methods("GET")
+	r.HandleFunc("/hmget/{key}", a.hmget).Methods("GET")
+	r.HandleFunc("/hsetnx", a.hsetnx).Methods("POST")
+	r.HandleFunc("/hincrby", a.hincrby).Methods("POST")
+	r.HandleFunc("/hincrbyfloat", a.hincrbyfloat).Methods("POST")
+	r.HandleFunc("/sadd", a.sadd).Methods("POST")
+	r.HandleFunc("/srem", a.srem).Methods("POST")
+	r.HandleFunc("/sismember/{key}/{member}", a.sismember).Methods("GET")
+	r.HandleFunc("/smembers/{key}", a.smembers).Methods("GET")
+	r.HandleFunc("/scard/{key}", a.scard).Methods("GET")
+	r.HandleFunc("/spop/{key}", a.spop).Methods("GET")
+	r.HandleFunc("/sinter", a.sinter).Methods("GET")
+	r.HandleFunc("/sunion", a.sunion).Methods("GET")
+	r.HandleFunc("/sdiff", a.sdiff).Methods("GET")
+	r.HandleFunc("/sinterstore", a.sinterstore).Methods("POST")
+	r.HandleFunc("/sunionstore", a.sunionstore).Methods("POST")
+	r.HandleFunc("/sdiffstore", a.sdiffstore).Methods("POST")
+	r.HandleFunc("/zadd", a.zadd).Methods("POST")
+	r.HandleFunc("/zrem", a.zrem).Methods("POST")
+	r.HandleFunc("/zscore/{key}/{member}", a.zscore).Methods("GET")
+	r.HandleFunc("/zrank/{key}/{member}", a.zrank).Methods("GET")
+	r.HandleFunc("/zrevrank/{key}/{member}", a.zrevrank).Methods("GET")
+	r.HandleFunc("/zrange/{key}/{start:-?[0-9]+}/{stop:-?[0-9]+}", a.zrange).Methods("GET")
+	r.HandleFunc("/zrangebyscore/{key}", a.zrangeByScore).Methods("GET")
+	r.HandleFunc("/zcount/{key}", a.zcount).Methods("GET")
+	r.HandleFunc("/zpopmin/{key}", a.zpopmin).Methods("GET")
+	r.HandleFunc("/zpopmax/{key}", a.zpopmax).Methods("GET")
+	r.HandleFunc("/stats", a.stats).Methods("GET")
+	r.HandleFunc("/dbsize", a.dbsize).Methods("GET")
+	r.HandleFunc("/flushdb", a.flushdb).Methods("POST")
+	r.HandleFunc("/move", a.move).Methods("POST")
+	r.HandleFunc("/tx", a.tx).Methods("POST")
+	r.HandleFunc("/publish", a.publish).Methods("POST")
+	r.HandleFunc("/subscribe", a.subscribe).Methods("GET")
+	r.Handle("/ws/subscribe", websocket.Server{Handler: a.wsSubscribe}).Methods("GET")
+	r.HandleFunc("/notifications", a.notifications).Methods("GET")
+	r.Handle("/ws/notifications", websocket.Server{Handler: a.wsNotifications}).Methods("GET")
 }
 
 func (a *App) Run(addr string) {
+	// Requests get a context that is cancelled on shutdown, so blocked
+	// pops don't hold it up.
+	ctx, cancel := context.WithCancel(context.Background())
+	server := &http.Server{
+		Addr:        addr,
+		Handler:     a.Router,
+		BaseContext: func(net.Listener) context.Context { return ctx },
+	}
+
+	go func() {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+		<-signals
+		cancel()
+		server.Shutdown(context.Background())
+	}()
+
+	if a.config.SnapshotPath != "" && a.config.SnapshotInterval > 0 {
+		go a.runSnapshots()
+	}
+
+	if a.config.RESPAddr != "" {
+		l, err := net.Listen("tcp", a.config.RESPAddr)
+		if err != nil {
+			log.Fatal(err)
+		}
+		go func() {
+			if err := a.serveRESP(ctx, l); err != nil {
+				log.Fatal(err)
+			}
+		}()
+	}
+
+	if a.config.GRPCAddr != "" {
+		l, err := net.Listen("tcp", a.config.GRPCAddr)
+		if err != nil {
+			log.Fatal(err)
+		}
+		rpc := a.newGRPCServer()
+		go func() {
+			<-ctx.Done()
+			rpc.Stop()
+		}()
+		go func() {
+			if err := rpc.Serve(l); err != nil {
+				log.Fatal(err)
+			}
+		}()
+	}
+
 	fmt.Println("run server")
-	log.Fatal(http.ListenAndServe(addr, a.Router))
+	if err := server.ListenAndServe(); err != http.ErrServerClosed {
+		log.Fatal(err)
+	}
+
+	a.Close()
+}
+
+// Close stops background jobs, flushes the append-only log and writes the
+// final snapshot.
+func (a *App) Close() {
+	close(a.stop)
+	if f := a.follower.Load(); f != nil {
+		f.cancel()
+		<-f.done
+	}
+	if a.raft != nil {
+		a.raft.stop()
+	}
+
+	for _, c := range a.dbs {
+		if err := c.disableAOF(); err != nil {
+			log.Println("aof:", err)
+		}
+	}
+
+	if a.config.SnapshotPath != "" {
+		a.saveSnapshots()
+	}
 }
 
 func (a *App) set(w http.ResponseWriter, r *http.Request) {
@@ -67,35 +597,74 @@ func (a *App) set(w http.ResponseWriter, r *http.Request) {
 	}
 	defer r.Body.Close()
 
-	a.cache.set(so.Key, so.Value, so.Expired)
+	set, version, err := a.db(r).setCond(so.Key, jsonValue(so.Value), so.Expired, setFlags{nx: so.NX, xx: so.XX})
+	if err != nil {
+		respondWithError(w, errorCode(err, http.StatusBadRequest), err.Error())
+		return
+	}
+	if !set {
+		respondWithJSON(w, http.StatusOK, map[string]interface{}{"result": "success", "set": false})
+		return
+	}
 
-	respondWithJSON(w, http.StatusCreated, map[string]string{"result": "success"})
+	respondWithJSON(w, http.StatusCreated, map[string]interface{}{"result": "success", "set": true, "version": version})
 }
 
-func (a *App) get(w http.ResponseWriter, r *http.Request) {
+// gets returns a value with its version for a later cas.
+func (a *App) gets(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
-	key := vars["key"]
-	object, err := a.cache.get(key)
+
+	object, version, err := a.db(r).gets(vars["key"])
 	if err != nil {
 		respondWithError(w, http.StatusNotFound, err.Error())
+		return
 	}
-	respondWithJSON(w, http.StatusOK, object)
+
+	respondWithJSON(w, http.StatusOK, map[string]interface{}{"value": object, "version": version})
 }
 
-func (a *App) keys(w http.ResponseWriter, r *http.Request) {
-	keys := a.cache.keys()
-	respondWithJSON(w, http.StatusOK, keys)
+// versions returns the versions of the keys given by the key query
+// parameters read at the same moment, 0 for missing keys.
+func (a *App) versions(w http.ResponseWriter, r *http.Request) {
+	respondWithJSON(w, http.StatusOK, a.db(r).versions(r.URL.Query()["key"]))
 }
 
-func (a *App) unset(w http.ResponseWriter, r *http.Request) {
+func (a *App) version(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
-	key := vars["key"]
-	a.cache.deleteItem(key)
 
-	respondWithJSON(w, http.StatusOK, map[string]string{"result": "success"})
+	version, err := a.db(r).version(vars["key"])
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, version)
 }
 
-func (a *App) rpush(w http.ResponseWriter, r *http.Request) {
+func (a *App) cas(w http.ResponseWriter, r *http.Request) {
+	var co casObject
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&co); err != nil {
+		fmt.Println(err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	version, err := a.db(r).cas(co.Key, jsonValue(co.Value), co.Expired, co.Version)
+	if err == errVersionMismatch {
+		respondWithError(w, http.StatusConflict, err.Error())
+		return
+	}
+	if err != nil {
+		respondWithError(w, errorCode(err, http.StatusBadRequest), err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, map[string]interface{}{"result": "success", "version": version})
+}
+
+func (a *App) getset(w http.ResponseWriter, r *http.Request) {
 	var so setObject
 	decoder := json.NewDecoder(r.Body)
 	if err := decoder.Decode(&so); err != nil {
@@ -105,111 +674,1244 @@ func (a *App) rpush(w http.ResponseWriter, r *http.Request) {
 	}
 	defer r.Body.Close()
 
-	_, err := a.cache.rpush(so.Key, so.Value, so.Expired)
+	old, version, err := a.db(r).getset(so.Key, jsonValue(so.Value), so.Expired)
 	if err != nil {
-		respondWithError(w, http.StatusBadRequest, err.Error())
+		respondWithError(w, errorCode(err, http.StatusBadRequest), err.Error())
+		return
 	}
 
-	respondWithJSON(w, http.StatusCreated, map[string]string{"result": "success"})
+	respondWithJSON(w, http.StatusCreated, map[string]interface{}{"result": "success", "value": old, "version": version})
 }
 
-func (a *App) lgetall(w http.ResponseWriter, r *http.Request) {
+func (a *App) get(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	key := vars["key"]
-
-	object, err := a.cache.lgetall(key)
-
+	object, err := a.db(r).get(key)
 	if err != nil {
 		respondWithError(w, http.StatusNotFound, err.Error())
+		return
 	}
-
 	respondWithJSON(w, http.StatusOK, object)
 }
 
-func (a *App) lget(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	key := vars["key"]
-	id, err := strconv.Atoi(vars["id"])
+func (a *App) incr(w http.ResponseWriter, r *http.Request) {
+	a.respondWithIncr(w, r, 1)
+}
+
+func (a *App) decr(w http.ResponseWriter, r *http.Request) {
+	a.respondWithIncr(w, r, -1)
+}
+
+// incrby adds the value of the request to the counter.
+func (a *App) incrby(w http.ResponseWriter, r *http.Request) {
+	a.respondWithIncr(w, r, 0)
+}
+
+// respondWithIncr adds sign to the counter, or the value of the request
+// when sign is zero.
+func (a *App) respondWithIncr(w http.ResponseWriter, r *http.Request, sign int64) {
+	var io incrObject
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&io); err != nil {
+		fmt.Println(err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	delta := io.Value
+	if sign != 0 {
+		delta = sign
+	}
 
+	value, err := a.db(r).incrby(io.Key, delta, io.Expired, io.Refresh)
 	if err != nil {
+		respondWithError(w, errorCode(err, http.StatusBadRequest), err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, map[string]interface{}{"result": "success", "value": value})
+}
+
+func (a *App) incrbyfloat(w http.ResponseWriter, r *http.Request) {
+	var io incrFloatObject
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&io); err != nil {
+		fmt.Println(err)
 		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	value, err := a.db(r).incrbyfloat(io.Key, io.Value, io.Expired, io.Refresh)
+	if err != nil {
+		respondWithError(w, errorCode(err, http.StatusBadRequest), err.Error())
+		return
 	}
 
-	object, err := a.cache.lget(key, id)
+	respondWithJSON(w, http.StatusCreated, map[string]interface{}{"result": "success", "value": value})
+}
+
+func (a *App) keys(w http.ResponseWriter, r *http.Request) {
+	keys := a.db(r).keysMatching(r.URL.Query().Get("pattern"))
+	respondWithJSON(w, http.StatusOK, keys)
+}
+
+// scan returns the next batch of keys after the cursor. An iteration starts
+// with cursor 0 and ends when cursor 0 is returned.
+func (a *App) scan(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
 
+	count := 0
+	if c := query.Get("count"); c != "" {
+		var err error
+		if count, err = strconv.Atoi(c); err != nil || count <= 0 {
+			respondWithError(w, http.StatusBadRequest, "count is not a positive number")
+			return
+		}
+	}
+
+	filter := scanFilter{match: query.Get("match"), typ: query.Get("type")}
+	cursor, keys, err := a.db(r).scan(query.Get("cursor"), count, filter)
 	if err != nil {
-		respondWithError(w, http.StatusNotFound, err.Error())
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
 	}
 
-	respondWithJSON(w, http.StatusOK, object)
+	respondWithJSON(w, http.StatusOK, map[string]interface{}{"cursor": cursor, "keys": keys})
 }
 
-func (a *App) pop(w http.ResponseWriter, r *http.Request) {
+func (a *App) unset(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	key := vars["key"]
+	a.db(r).deleteItem(key)
 
-	object, err := a.cache.pop(key)
+	respondWithJSON(w, http.StatusOK, map[string]string{"result": "success"})
+}
 
-	if err != nil {
+// expire sets the TTL of a key of any type. A non-positive TTL deletes the
+// key.
+func (a *App) expire(w http.ResponseWriter, r *http.Request) {
+	var eo expireObject
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&eo); err != nil {
+		fmt.Println(err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	ttl := time.Duration(eo.Seconds)*time.Second + time.Duration(eo.Milliseconds)*time.Millisecond
+	if err := a.db(r).expire(eo.Key, ttl); err != nil {
 		respondWithError(w, http.StatusNotFound, err.Error())
+		return
 	}
 
-	respondWithJSON(w, http.StatusOK, object)
+	respondWithJSON(w, http.StatusOK, map[string]string{"result": "success"})
 }
 
-func (a *App) hset(w http.ResponseWriter, r *http.Request) {
-	var sho setHObject
+// expireat sets the expiry of a key of any type to a unix time. A time in
+// the past deletes the key.
+func (a *App) expireat(w http.ResponseWriter, r *http.Request) {
+	var eo expireAtObject
 	decoder := json.NewDecoder(r.Body)
-	if err := decoder.Decode(&sho); err != nil {
+	if err := decoder.Decode(&eo); err != nil {
 		fmt.Println(err)
 		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
 		return
 	}
 	defer r.Body.Close()
-	fmt.Println(sho.Value)
-	if err := a.cache.hset(sho.Key, sho.Value, sho.Expired); err != nil {
-		respondWithError(w, http.StatusBadRequest, err.Error())
+
+	at := time.Unix(eo.Timestamp, 0)
+	if eo.TimestampMs != 0 {
+		at = time.UnixMilli(eo.TimestampMs)
+	}
+	if err := a.db(r).expireAt(eo.Key, at); err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
 	}
 
-	respondWithJSON(w, http.StatusCreated, map[string]string{"result": "success"})
+	respondWithJSON(w, http.StatusOK, map[string]string{"result": "success"})
 }
 
-func (a *App) hgetall(w http.ResponseWriter, r *http.Request) {
+// persist removes the expiry of a key and reports whether it had one.
+func (a *App) persist(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
-	key := vars["key"]
-
-	object, err := a.cache.hgetall(key)
 
+	removed, err := a.db(r).persist(vars["key"])
 	if err != nil {
 		respondWithError(w, http.StatusNotFound, err.Error())
+		return
 	}
 
-	respondWithJSON(w, http.StatusOK, object)
+	respondWithJSON(w, http.StatusOK, map[string]interface{}{"result": "success", "persisted": removed})
 }
 
-func (a *App) hget(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	key := vars["key"]
-	dictKey := vars["dictKey"]
+// ttl returns the seconds left until the key expires, -1 when the key
+// doesn't expire.
+func (a *App) ttl(w http.ResponseWriter, r *http.Request) {
+	a.respondWithTTL(w, r, time.Second)
+}
+
+// pttl returns the milliseconds left until the key expires, -1 when the key
+// doesn't expire.
+func (a *App) pttl(w http.ResponseWriter, r *http.Request) {
+	a.respondWithTTL(w, r, time.Millisecond)
+}
 
-	object, err := a.cache.hget(key, dictKey)
+func (a *App) respondWithTTL(w http.ResponseWriter, r *http.Request, unit time.Duration) {
+	vars := mux.Vars(r)
 
+	ttl, err := a.db(r).ttl(vars["key"])
 	if err != nil {
 		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	if ttl == noExpiry {
+		respondWithJSON(w, http.StatusOK, -1)
+		return
 	}
 
-	respondWithJSON(w, http.StatusOK, object)
+	respondWithJSON(w, http.StatusOK, int64((ttl+unit/2)/unit))
 }
 
-func respondWithError(w http.ResponseWriter, code int, message string) {
-	respondWithJSON(w, code, map[string]string{"error": message})
+func (a *App) rpush(w http.ResponseWriter, r *http.Request) {
+	a.respondWithPush(w, r, false)
 }
 
-func respondWithJSON(w http.ResponseWriter, code int, payload interface{}) {
-	response, _ := json.Marshal(payload)
+func (a *App) lpush(w http.ResponseWriter, r *http.Request) {
+	a.respondWithPush(w, r, true)
+}
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(code)
-	w.Write(response)
+// respondWithPush pushes the elements of "values", or the single "value"
+// when there are none, and returns the length of the list.
+func (a *App) respondWithPush(w http.ResponseWriter, r *http.Request, left bool) {
+	var po pushObject
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&po); err != nil {
+		fmt.Println(err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	length, err := a.db(r).push(po.Key, po.values(), left, po.Expired)
+	if err != nil {
+		respondWithError(w, errorCode(err, http.StatusBadRequest), err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, map[string]interface{}{"result": "success", "length": length})
+}
+
+func (a *App) lgetall(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	key := vars["key"]
+
+	object, err := a.db(r).lgetall(key)
+
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, object)
+}
+
+func (a *App) lget(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	key := vars["key"]
+	id, err := strconv.Atoi(vars["id"])
+
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	object, err := a.db(r).lget(key, id)
+
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, object)
+}
+
+func (a *App) pop(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	key := vars["key"]
+
+	object, err := a.db(r).pop(key)
+
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, object)
+}
+
+func (a *App) lpop(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	object, err := a.db(r).lpop(vars["key"])
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, object)
+}
+
+func (a *App) blpop(w http.ResponseWriter, r *http.Request) {
+	a.respondWithBlockingPop(w, r, true)
+}
+
+func (a *App) brpop(w http.ResponseWriter, r *http.Request) {
+	a.respondWithBlockingPop(w, r, false)
+}
+
+// respondWithBlockingPop waits for an element of any of the lists given by
+// the key query parameters for up to timeout seconds, e.g.
+// /blpop?key=a&key=b&timeout=1.5. A zero timeout waits until the client
+// goes away.
+func (a *App) respondWithBlockingPop(w http.ResponseWriter, r *http.Request, left bool) {
+	query := r.URL.Query()
+
+	var timeout float64
+	if t := query.Get("timeout"); t != "" {
+		var err error
+		if timeout, err = strconv.ParseFloat(t, 64); err != nil || timeout < 0 {
+			respondWithError(w, http.StatusBadRequest, "timeout is not a positive number")
+			return
+		}
+	}
+
+	result, err := a.db(r).bpop(r.Context(), query["key"], left, time.Duration(timeout*float64(time.Second)))
+	if err == errPopTimeout {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, result)
+}
+
+func (a *App) llen(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	length, err := a.db(r).llen(vars["key"])
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, length)
+}
+
+func (a *App) lrange(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	start, _ := strconv.Atoi(vars["start"])
+	stop, _ := strconv.Atoi(vars["stop"])
+
+	object, err := a.db(r).lrange(vars["key"], start, stop)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, object)
+}
+
+func (a *App) lset(w http.ResponseWriter, r *http.Request) {
+	var lo lsetObject
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&lo); err != nil {
+		fmt.Println(err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	if err := a.db(r).lset(lo.Key, lo.Index, jsonValue(lo.Value)); err != nil {
+		respondWithError(w, errorCode(err, http.StatusBadRequest), err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, map[string]string{"result": "success"})
+}
+
+func (a *App) lrem(w http.ResponseWriter, r *http.Request) {
+	var lo lremObject
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&lo); err != nil {
+		fmt.Println(err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	removed, err := a.db(r).lrem(lo.Key, lo.Count, jsonValue(lo.Value))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]interface{}{"result": "success", "removed": removed})
+}
+
+func (a *App) ltrim(w http.ResponseWriter, r *http.Request) {
+	var lo ltrimObject
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&lo); err != nil {
+		fmt.Println(err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	if err := a.db(r).ltrim(lo.Key, lo.Start, lo.Stop); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"result": "success"})
+}
+
+func (a *App) linsert(w http.ResponseWriter, r *http.Request) {
+	var lo linsertObject
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&lo); err != nil {
+		fmt.Println(err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	if lo.Position != "before" && lo.Position != "after" {
+		respondWithError(w, http.StatusBadRequest, "position must be before or after")
+		return
+	}
+
+	length, err := a.db(r).linsert(lo.Key, lo.Position == "before", jsonValue(lo.Pivot), jsonValue(lo.Value))
+	if err != nil {
+		respondWithError(w, errorCode(err, http.StatusBadRequest), err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, map[string]interface{}{"result": "success", "length": length})
+}
+
+func (a *App) hset(w http.ResponseWriter, r *http.Request) {
+	var sho setHObject
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&sho); err != nil {
+		fmt.Println(err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+	if err := a.db(r).hset(sho.Key, jsonFields(sho.Value), sho.Expired); err != nil {
+		respondWithError(w, errorCode(err, http.StatusBadRequest), err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, map[string]string{"result": "success"})
+}
+
+func (a *App) hgetall(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	key := vars["key"]
+
+	object, err := a.db(r).hgetall(key)
+
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, object)
+}
+
+func (a *App) hget(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	key := vars["key"]
+	dictKey := vars["dictKey"]
+
+	object, err := a.db(r).hget(key, dictKey)
+
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, object)
+}
+
+func (a *App) hdel(w http.ResponseWriter, r *http.Request) {
+	var ho hdelObject
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&ho); err != nil {
+		fmt.Println(err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	removed, err := a.db(r).hdel(ho.Key, ho.Fields)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]interface{}{"result": "success", "removed": removed})
+}
+
+func (a *App) hexists(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	found, err := a.db(r).hexists(vars["key"], vars["dictKey"])
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, found)
+}
+
+func (a *App) hlen(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	length, err := a.db(r).hlen(vars["key"])
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, length)
+}
+
+func (a *App) hkeys(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	fields, err := a.db(r).hkeys(vars["key"])
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, fields)
+}
+
+func (a *App) hvals(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	values, err := a.db(r).hvals(vars["key"])
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, values)
+}
+
+// hmget returns the values of the fields given by the field query
+// parameters, e.g. /hmget/user?field=name&field=age.
+func (a *App) hmget(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	values, err := a.db(r).hmget(vars["key"], r.URL.Query()["field"])
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, values)
+}
+
+func (a *App) hsetnx(w http.ResponseWriter, r *http.Request) {
+	var ho hsetnxObject
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&ho); err != nil {
+		fmt.Println(err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	set, err := a.db(r).hsetnx(ho.Key, ho.Field, jsonValue(ho.Value), ho.Expired)
+	if err != nil {
+		respondWithError(w, errorCode(err, http.StatusBadRequest), err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, map[string]interface{}{"result": "success", "set": set})
+}
+
+func (a *App) hincrby(w http.ResponseWriter, r *http.Request) {
+	var ho hincrbyObject
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&ho); err != nil {
+		fmt.Println(err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	value, err := a.db(r).hincrby(ho.Key, ho.Field, ho.Value)
+	if err != nil {
+		respondWithError(w, errorCode(err, http.StatusBadRequest), err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, map[string]interface{}{"result": "success", "value": value})
+}
+
+func (a *App) hincrbyfloat(w http.ResponseWriter, r *http.Request) {
+	var ho hincrbyfloatObject
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&ho); err != nil {
+		fmt.Println(err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	value, err := a.db(r).hincrbyfloat(ho.Key, ho.Field, ho.Value)
+	if err != nil {
+		respondWithError(w, errorCode(err, http.StatusBadRequest), err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, map[string]interface{}{"result": "success", "value": value})
+}
+
+func (a *App) sadd(w http.ResponseWriter, r *http.Request) {
+	var so saddObject
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&so); err != nil {
+		fmt.Println(err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	added, err := a.db(r).sadd(so.Key, so.Value, so.Expired)
+	if err != nil {
+		respondWithError(w, errorCode(err, http.StatusBadRequest), err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, map[string]interface{}{"result": "success", "added": added})
+}
+
+func (a *App) srem(w http.ResponseWriter, r *http.Request) {
+	var so sremObject
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&so); err != nil {
+		fmt.Println(err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	removed, err := a.db(r).srem(so.Key, so.Value)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]interface{}{"result": "success", "removed": removed})
+}
+
+func (a *App) sismember(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	found, err := a.db(r).sismember(vars["key"], vars["member"])
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, found)
+}
+
+func (a *App) smembers(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	members, err := a.db(r).smembers(vars["key"])
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, members)
+}
+
+func (a *App) scard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	count, err := a.db(r).scard(vars["key"])
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, count)
+}
+
+func (a *App) spop(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	count := 1
+	if c := r.URL.Query().Get("count"); c != "" {
+		var err error
+		if count, err = strconv.Atoi(c); err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+			return
+		}
+	}
+
+	members, err := a.db(r).spop(vars["key"], count)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, members)
+}
+
+func (a *App) sinter(w http.ResponseWriter, r *http.Request) {
+	a.respondWithSetOperation(w, r, setInter)
+}
+
+func (a *App) sunion(w http.ResponseWriter, r *http.Request) {
+	a.respondWithSetOperation(w, r, setUnion)
+}
+
+func (a *App) sdiff(w http.ResponseWriter, r *http.Request) {
+	a.respondWithSetOperation(w, r, setDiff)
+}
+
+// respondWithSetOperation combines the sets given by the key query
+// parameters, e.g. /sinter?key=a&key=b.
+func (a *App) respondWithSetOperation(w http.ResponseWriter, r *http.Request, op int) {
+	members, err := a.db(r).setOperation(op, r.URL.Query()["key"])
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, members)
+}
+
+func (a *App) sinterstore(w http.ResponseWriter, r *http.Request) {
+	a.respondWithSetOperationStore(w, r, setInter)
+}
+
+func (a *App) sunionstore(w http.ResponseWriter, r *http.Request) {
+	a.respondWithSetOperationStore(w, r, setUnion)
+}
+
+func (a *App) sdiffstore(w http.ResponseWriter, r *http.Request) {
+	a.respondWithSetOperationStore(w, r, setDiff)
+}
+
+func (a *App) respondWithSetOperationStore(w http.ResponseWriter, r *http.Request, op int) {
+	var so setStoreObject
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&so); err != nil {
+		fmt.Println(err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	count, err := a.db(r).setOperationStore(op, so.Destination, so.Keys)
+	if err != nil {
+		respondWithError(w, errorCode(err, http.StatusBadRequest), err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, map[string]interface{}{"result": "success", "count": count})
+}
+
+func (a *App) zadd(w http.ResponseWriter, r *http.Request) {
+	var zo zaddObject
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&zo); err != nil {
+		fmt.Println(err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	result, err := a.db(r).zadd(zo.Key, zo.Value, zaddFlags{nx: zo.NX, xx: zo.XX, incr: zo.Incr}, zo.Expired)
+	if err != nil {
+		respondWithError(w, errorCode(err, http.StatusBadRequest), err.Error())
+		return
+	}
+
+	if zo.Incr {
+		respondWithJSON(w, http.StatusCreated, map[string]interface{}{"result": "success", "score": result})
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, map[string]interface{}{"result": "success", "added": result})
+}
+
+func (a *App) zrem(w http.ResponseWriter, r *http.Request) {
+	var zo zremObject
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&zo); err != nil {
+		fmt.Println(err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	removed, err := a.db(r).zrem(zo.Key, zo.Value)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]interface{}{"result": "success", "removed": removed})
+}
+
+func (a *App) zscore(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	score, err := a.db(r).zscore(vars["key"], vars["member"])
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, score)
+}
+
+func (a *App) zrank(w http.ResponseWriter, r *http.Request) {
+	a.respondWithRank(w, r, false)
+}
+
+func (a *App) zrevrank(w http.ResponseWriter, r *http.Request) {
+	a.respondWithRank(w, r, true)
+}
+
+func (a *App) respondWithRank(w http.ResponseWriter, r *http.Request, reverse bool) {
+	vars := mux.Vars(r)
+
+	rank, err := a.db(r).zrank(vars["key"], vars["member"], reverse)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, rank)
+}
+
+func (a *App) zrange(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	start, err := strconv.Atoi(vars["start"])
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	stop, err := strconv.Atoi(vars["stop"])
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	reverse, _ := strconv.ParseBool(r.URL.Query().Get("rev"))
+
+	members, err := a.db(r).zrange(vars["key"], start, stop, reverse)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, members)
+}
+
+// queryScoreRange reads the min and max query parameters, the range is
+// unbounded by default.
+func queryScoreRange(r *http.Request) (scoreRange, error) {
+	query := r.URL.Query()
+	min, max := query.Get("min"), query.Get("max")
+	if min == "" {
+		min = "-inf"
+	}
+	if max == "" {
+		max = "+inf"
+	}
+
+	return parseScoreRange(min, max)
+}
+
+func (a *App) zrangeByScore(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	query := r.URL.Query()
+
+	sr, err := queryScoreRange(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	offset, count := 0, -1
+	if query.Get("offset") != "" {
+		if offset, err = strconv.Atoi(query.Get("offset")); err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+			return
+		}
+	}
+	if query.Get("count") != "" {
+		if count, err = strconv.Atoi(query.Get("count")); err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+			return
+		}
+	}
+	reverse, _ := strconv.ParseBool(query.Get("rev"))
+
+	members, err := a.db(r).zrangeByScore(vars["key"], sr, offset, count, reverse)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, members)
+}
+
+func (a *App) zcount(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	sr, err := queryScoreRange(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	count, err := a.db(r).zcount(vars["key"], sr)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, count)
+}
+
+func (a *App) zpopmin(w http.ResponseWriter, r *http.Request) {
+	a.respondWithPop(w, r, false)
+}
+
+func (a *App) zpopmax(w http.ResponseWriter, r *http.Request) {
+	a.respondWithPop(w, r, true)
+}
+
+func (a *App) respondWithPop(w http.ResponseWriter, r *http.Request, max bool) {
+	vars := mux.Vars(r)
+
+	count := 1
+	if c := r.URL.Query().Get("count"); c != "" {
+		var err error
+		if count, err = strconv.Atoi(c); err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+			return
+		}
+	}
+
+	members, err := a.db(r).zpop(vars["key"], count, max)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, members)
+}
+
+func (a *App) stats(w http.ResponseWriter, r *http.Request) {
+	respondWithJSON(w, http.StatusOK, a.db(r).stats())
+}
+
+// dbsize returns the number of keys in the database, including expired
+// keys that weren't removed yet.
+func (a *App) dbsize(w http.ResponseWriter, r *http.Request) {
+	respondWithJSON(w, http.StatusOK, a.db(r).count.Load())
+}
+
+func (a *App) flushdb(w http.ResponseWriter, r *http.Request) {
+	deleted := a.db(r).flush()
+
+	respondWithJSON(w, http.StatusOK, map[string]interface{}{"result": "success", "deleted": deleted})
+}
+
+// move moves a key to another database. It isn't moved when the other
+// database has the key already.
+func (a *App) move(w http.ResponseWriter, r *http.Request) {
+	var mo moveObject
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&mo); err != nil {
+		fmt.Println(err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	if mo.DB < 0 || mo.DB >= len(a.dbs) {
+		respondWithError(w, http.StatusBadRequest, errNoSuchDB.Error())
+		return
+	}
+
+	moved, err := a.moveKey(mo.Key, dbIndex(r), mo.DB)
+	if err != nil && isNotFound(err) {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	if err != nil {
+		respondWithError(w, errorCode(err, http.StatusBadRequest), err.Error())
+		return
+	}
+	if !moved {
+		respondWithJSON(w, http.StatusOK, map[string]interface{}{"result": "success", "moved": false})
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, map[string]interface{}{"result": "success", "moved": true})
+}
+
+// tx runs an array of commands atomically and returns their results in
+// order. A malformed command or a type error aborts the whole array. The
+// body is either the array or a txObject with watched keys.
+func (a *App) tx(w http.ResponseWriter, r *http.Request) {
+	var body json.RawMessage
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&body); err != nil {
+		fmt.Println(err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	var to txObject
+	var err error
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+		err = json.Unmarshal(body, &to.Commands)
+	} else {
+		err = json.Unmarshal(body, &to)
+	}
+	if err != nil {
+		fmt.Println(err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := a.txReadOnly(to.Commands); err != nil {
+		respondWithError(w, http.StatusForbidden, err.Error())
+		return
+	}
+
+	results, err := a.db(r).tx(to.Watch, to.Commands)
+	if err == errWatchConflict {
+		respondWithError(w, http.StatusConflict, err.Error())
+		return
+	}
+	if err != nil {
+		respondWithError(w, errorCode(err, http.StatusBadRequest), err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, results)
+}
+
+// errorCode returns the status for errors that have their own status and
+// code for the rest.
+func errorCode(err error, code int) int {
+	if err == errOutOfMemory {
+		return http.StatusInsufficientStorage
+	}
+
+	return code
+}
+
+func respondWithError(w http.ResponseWriter, code int, message string) {
+	respondWithJSON(w, code, map[string]string{"error": message})
+}
+
+func respondWithJSON(w http.ResponseWriter, code int, payload interface{}) {
+	response, _ := marshalJSON(payload)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(response)
+
+}
+
+// sseKeepAlive is how often an idle event stream gets a comment, so
+// proxies don't close it.
+const sseKeepAlive = 15 * time.Second
+
+func (a *App) publish(w http.ResponseWriter, r *http.Request) {
+	var po publishObject
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&po); err != nil {
+		fmt.Println(err)
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	receivers := a.cache.pubsub.publish(po.Channel, jsonValue(po.Message))
+
+	respondWithJSON(w, http.StatusCreated, map[string]interface{}{"result": "success", "receivers": receivers})
+}
+
+// subscribe streams the messages of the channels and patterns given by the
+// channel and pattern query parameters as server-sent events, e.g.
+// /subscribe?channel=orders&pattern=news.*. The stream ends with an error
+// event when the client doesn't keep up.
+func (a *App) subscribe(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if len(query["channel"])+len(query["pattern"]) == 0 {
+		respondWithError(w, http.StatusBadRequest, "no channels given")
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		respondWithError(w, http.StatusInternalServerError, "streaming unsupported")
+		return
+	}
+
+	sub := a.cache.pubsub.subscribe(query["channel"], query["pattern"])
+	defer a.cache.pubsub.unsubscribe(sub)
+
+	streamEvents(w, r, flusher, sub, func(m message) interface{} { return m })
+}
+
+// streamEvents sends the messages of the subscriber as server-sent events
+// until the client goes away or falls behind. payload picks what is sent of
+// a message.
+func streamEvents(w http.ResponseWriter, r *http.Request, flusher http.Flusher, sub *subscriber, payload func(message) interface{}) {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, ": subscribed\n\n")
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(sseKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case m := <-sub.messages:
+			b, err := json.Marshal(payload(m))
+			if err != nil {
+				continue
+			}
+			fmt.Fprintf(w, "data: %s\n\n", b)
+		case <-keepAlive.C:
+			fmt.Fprint(w, ": keep-alive\n\n")
+		case <-sub.dropped:
+			fmt.Fprint(w, "event: error\ndata: {\"error\":\"slow consumer\"}\n\n")
+			flusher.Flush()
+			return
+		case <-r.Context().Done():
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+// wsSubscribe is subscribe over a WebSocket. Every message is sent as a
+// JSON text frame, and {"error":"slow consumer"} is sent before the
+// connection is closed when the client doesn't keep up.
+func (a *App) wsSubscribe(ws *websocket.Conn) {
+	defer ws.Close()
+
+	query := ws.Request().URL.Query()
+	if len(query["channel"])+len(query["pattern"]) == 0 {
+		websocket.JSON.Send(ws, map[string]string{"error": "no channels given"})
+		return
+	}
+
+	sub := a.cache.pubsub.subscribe(query["channel"], query["pattern"])
+	defer a.cache.pubsub.unsubscribe(sub)
+
+	streamFrames(ws, sub, func(m message) interface{} { return m })
+}
+
+// streamFrames is streamEvents over a WebSocket, every message is sent as a
+// JSON text frame.
+func streamFrames(ws *websocket.Conn, sub *subscriber, payload func(message) interface{}) {
+	// Frames from the client are ignored, reading only notices when it
+	// goes away.
+	closed := make(chan struct{})
+	go func() {
+		io.Copy(io.Discard, ws)
+		close(closed)
+	}()
+
+	for {
+		select {
+		case m := <-sub.messages:
+			if err := websocket.JSON.Send(ws, payload(m)); err != nil {
+				return
+			}
+		case <-sub.dropped:
+			websocket.JSON.Send(ws, map[string]string{"error": "slow consumer"})
+			return
+		case <-closed:
+			return
+		case <-ws.Request().Context().Done():
+			return
+		}
+	}
+}
+
+// notifications streams keyspace events of the keys matching the pattern
+// query parameter as server-sent events, e.g.
+// /notifications?pattern=user:*&event=set&event=del. Without events all
+// of them are sent.
+func (a *App) notifications(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	patterns, err := keyspacePatterns(query.Get("pattern"), query["event"])
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		respondWithError(w, http.StatusInternalServerError, "streaming unsupported")
+		return
+	}
+
+	keyspace := a.db(r).keyspace
+	sub := keyspace.subscribe(nil, patterns)
+	defer keyspace.unsubscribe(sub)
+
+	streamEvents(w, r, flusher, sub, func(m message) interface{} { return m.Payload })
+}
+
+// wsNotifications is notifications over a WebSocket.
+func (a *App) wsNotifications(ws *websocket.Conn) {
+	defer ws.Close()
+
+	query := ws.Request().URL.Query()
+	patterns, err := keyspacePatterns(query.Get("pattern"), query["event"])
+	if err != nil {
+		websocket.JSON.Send(ws, map[string]string{"error": err.Error()})
+		return
+	}
+
+	keyspace := a.db(ws.Request()).keyspace
+	sub := keyspace.subscribe(nil, patterns)
+	defer keyspace.unsubscribe(sub)
 
+	streamFrames(ws, sub, func(m message) interface{} { return m.Payload })
 }
@@ -0,0 +1,154 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+var errPopTimeout = errors.New("timeout")
+
+type popResult struct {
+	Key   string      `json:"key"`
+	Value interface{} `json:"value"`
+}
+
+// waiter is a blocked pop waiting for any of its keys to get an element.
+type waiter struct {
+	keys   []string
+	left   bool
+	served bool
+	result chan popResult
+}
+
+// waiters keeps blocked pops in FIFO order for every key. Its lock is
+// taken after shard locks, never before.
+type waiters struct {
+	mu    sync.Mutex
+	queue map[string][]*waiter
+}
+
+func (ws *waiters) add(w *waiter) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	if ws.queue == nil {
+		ws.queue = map[string][]*waiter{}
+	}
+	for _, k := range w.keys {
+		ws.queue[k] = append(ws.queue[k], w)
+	}
+}
+
+// removeLocked drops the waiter from the queues of all its keys.
+func (ws *waiters) removeLocked(w *waiter) {
+	for _, k := range w.keys {
+		queue := ws.queue[k]
+		for i, x := range queue {
+			if x == w {
+				queue = append(queue[:i:i], queue[i+1:]...)
+				break
+			}
+		}
+		if len(queue) == 0 {
+			delete(ws.queue, k)
+		} else {
+			ws.queue[k] = queue
+		}
+	}
+}
+
+// cancel removes the waiter unless it was already served and reports
+// whether it was removed.
+func (ws *waiters) cancel(w *waiter) bool {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	if w.served {
+		return false
+	}
+	ws.removeLocked(w)
+
+	return true
+}
+
+// serveWaiters hands elements of the list at key to the blocked pops
+// waiting for it, oldest first. It is called with the shard lock held
+// after elements were pushed.
+func (s *shard) serveWaiters(key string) {
+	ws := &s.c.waiters
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	for len(ws.queue[key]) > 0 {
+		if li, _ := s.lookupList(key); li == nil {
+			return
+		}
+
+		w := ws.queue[key][0]
+		value, err := s.pop(key, w.left)
+		if err != nil {
+			return
+		}
+
+		ws.removeLocked(w)
+		w.served = true
+		w.result <- popResult{Key: key, Value: value}
+	}
+}
+
+// bpop pops an element from the first non-empty list of keys. When all of
+// them are empty it waits until an element is pushed to any of them, the
+// timeout passes or ctx is done. A zero timeout waits without a limit.
+func (c *cache) bpop(ctx context.Context, keys []string, left bool, timeout time.Duration) (popResult, error) {
+	if len(keys) == 0 {
+		return popResult{}, errors.New("no keys given")
+	}
+
+	w := &waiter{keys: keys, left: left, result: make(chan popResult, 1)}
+
+	shards := c.shardsOf(keys...)
+	lockShards(shards)
+	for _, k := range keys {
+		s := c.getShard(k)
+		li, err := s.lookupList(k)
+		if err != nil {
+			unlockShards(shards)
+			return popResult{}, err
+		}
+		if li != nil {
+			value, err := s.pop(k, left)
+			unlockShards(shards)
+			return popResult{Key: k, Value: value}, err
+		}
+	}
+	// The waiter is added under the shard locks, so no push can slip in
+	// between the check above and the registration.
+	c.waiters.add(w)
+	unlockShards(shards)
+
+	var expired <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	var err error
+	select {
+	case r := <-w.result:
+		return r, nil
+	case <-expired:
+		err = errPopTimeout
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	if c.waiters.cancel(w) {
+		return popResult{}, err
+	}
+
+	// The waiter was served at the same moment it gave up.
+	return <-w.result, nil
+}
@@ -0,0 +1,149 @@
+package app
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// waitForWaiters waits until n pops are blocked on key.
+func waitForWaiters(t *testing.T, c *cache, key string, n int) {
+	for i := 0; i < 100; i++ {
+		c.waiters.mu.Lock()
+		blocked := len(c.waiters.queue[key])
+		c.waiters.mu.Unlock()
+		if blocked == n {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("Pops aren't blocked on", key)
+}
+
+func TestCache_BpopImmediate(t *testing.T) {
+	tc := NewCache(0)
+	tc.push("b", []interface{}{"1", "2"}, false, 0)
+
+	r, err := tc.bpop(context.Background(), []string{"a", "b"}, true, time.Second)
+	if err != nil || r.Key != "b" || r.Value != "1" {
+		t.Error("Bpop doesn't pop from the first non-empty list", r, err)
+	}
+
+	tc.set("s", "string", 0)
+	if _, err := tc.bpop(context.Background(), []string{"s"}, true, time.Second); err == nil {
+		t.Error("Bpop of a string doesn't fail")
+	}
+}
+
+func TestCache_BpopWaits(t *testing.T) {
+	tc := NewCache(0)
+
+	results := make(chan popResult)
+	for i := 0; i < 2; i++ {
+		go func() {
+			r, _ := tc.bpop(context.Background(), []string{"a", "b"}, false, 0)
+			results <- r
+		}()
+		// Waiters must be queued in a known order.
+		waitForWaiters(t, tc, "b", i+1)
+	}
+
+	tc.push("b", []interface{}{"1"}, false, 0)
+	if r := <-results; r.Key != "b" || r.Value != "1" {
+		t.Error("Blocked pop doesn't get pushed value", r)
+	}
+
+	tc.push("a", []interface{}{"2", "3"}, false, 0)
+	if r := <-results; r.Key != "a" || r.Value != "3" {
+		t.Error("Second blocked pop doesn't get pushed value", r)
+	}
+
+	if list, _ := tc.lgetall("a"); len(list) != 1 || list[0] != "2" {
+		t.Error("Value that nobody waits for isn't left in the list", list)
+	}
+	if len(tc.waiters.queue) != 0 {
+		t.Error("Served waiters aren't removed", tc.waiters.queue)
+	}
+}
+
+func TestCache_BpopFIFO(t *testing.T) {
+	tc := NewCache(0)
+
+	var results []chan popResult
+	for i := 0; i < 5; i++ {
+		result := make(chan popResult, 1)
+		results = append(results, result)
+		go func() {
+			r, _ := tc.bpop(context.Background(), []string{"q"}, true, 0)
+			result <- r
+		}()
+		waitForWaiters(t, tc, "q", i+1)
+	}
+
+	tc.push("q", []interface{}{0, 1, 2, 3, 4}, false, 0)
+	for i, result := range results {
+		if r := <-result; r.Value != i {
+			t.Error("Waiters aren't served in FIFO order", i, r)
+		}
+	}
+}
+
+func TestCache_BpopTimeoutAndCancel(t *testing.T) {
+	tc := NewCache(0)
+
+	start := time.Now()
+	if _, err := tc.bpop(context.Background(), []string{"a"}, true, 20*time.Millisecond); err != errPopTimeout {
+		t.Error("Bpop doesn't time out", err)
+	}
+	if time.Since(start) < 20*time.Millisecond {
+		t.Error("Bpop returns before the timeout")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		_, err := tc.bpop(ctx, []string{"a", "b"}, true, 0)
+		done <- err
+	}()
+	waitForWaiters(t, tc, "a", 1)
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Error("Bpop isn't released on cancel", err)
+	}
+	if len(tc.waiters.queue) != 0 {
+		t.Error("Cancelled waiter isn't removed", tc.waiters.queue)
+	}
+
+	tc.push("a", []interface{}{"1"}, false, 0)
+	if length, _ := tc.llen("a"); length != 1 {
+		t.Error("Value is given to a cancelled waiter", length)
+	}
+}
+
+func TestCache_BpopPersistence(t *testing.T) {
+	aofPath := filepath.Join(t.TempDir(), "cache.aof")
+
+	tc := NewCache(0)
+	if err := tc.enableAOF(aofPath, FsyncNever, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	result := make(chan popResult)
+	go func() {
+		r, _ := tc.bpop(context.Background(), []string{"a"}, true, 0)
+		result <- r
+	}()
+	waitForWaiters(t, tc, "a", 1)
+	tc.push("a", []interface{}{"1", "2"}, false, 0)
+	<-result
+	tc.disableAOF()
+
+	lc := NewCache(0)
+	if err := lc.replayAOF(aofPath); err != nil {
+		t.Fatal(err)
+	}
+	if list, _ := lc.lgetall("a"); len(list) != 1 || list[0] != "2" {
+		t.Error("Value given to a blocked pop is restored", list)
+	}
+}
@@ -0,0 +1,165 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/gorilla/mux"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// defaultContentType is the content type of raw values sent without one.
+const defaultContentType = "application/octet-stream"
+
+// rawValue is a string value set from a raw request body, kept with the
+// content type it was sent with. Its JSON has the data in base64.
+type rawValue struct {
+	Data        []byte `json:"data"`
+	ContentType string `json:"content_type,omitempty"`
+}
+
+// getRaw returns a string value as bytes with its version. Values set as
+// JSON are formatted like RESP does and have no content type.
+func (c *cache) getRaw(key string) (*rawValue, int64, error) {
+	object, version, err := c.gets(key)
+	if err != nil {
+		return nil, 0, err
+	}
+	if rv, ok := object.(*rawValue); ok {
+		return rv, version, nil
+	}
+
+	return &rawValue{Data: []byte(respString(object))}, version, nil
+}
+
+// append adds data to the end of a string value and returns its length. A
+// missing key is created with contentType.
+func (c *cache) append(key string, data []byte, contentType string, duration int) (int, error) {
+	if err := c.ensureCapacity(key); err != nil {
+		return 0, err
+	}
+
+	e := c.expiration(duration)
+
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.append(key, data, contentType, e)
+}
+
+func (s *shard) append(key string, data []byte, contentType string, e int64) (int, error) {
+	i, found := s.items[key]
+	if found && s.isExpired(key, i) {
+		s.removeExpired(key)
+		found = false
+	}
+	if !found {
+		s.store(key, &simpleItem{header: header{expired: e}, object: &rawValue{Data: data, ContentType: contentType}})
+		s.c.feed("append", key, &rawValue{Data: data, ContentType: contentType}, e)
+		return len(data), nil
+	}
+
+	si, ok := i.(*simpleItem)
+	if !ok {
+		return 0, errors.New("wrong object type")
+	}
+
+	var rv *rawValue
+	switch v := si.object.(type) {
+	case *rawValue:
+		// The new bytes go after the end of the old value, so readers of
+		// the old one still see the same bytes.
+		rv = &rawValue{Data: append(v.Data, data...), ContentType: v.ContentType}
+	case json.RawMessage:
+		return 0, errors.New("wrong object type")
+	default:
+		rv = &rawValue{Data: append([]byte(respString(v)), data...)}
+	}
+	size := sizeOf(si.object)
+	si.object = rv
+	s.grow(si, sizeOf(rv)-size)
+	s.c.feed("append", key, &rawValue{Data: data, ContentType: contentType}, si.expired)
+
+	return len(rv.Data), nil
+}
+
+// readRaw reads the raw body of a request with the expired query parameter.
+func readRaw(w http.ResponseWriter, r *http.Request) (*rawValue, int, bool) {
+	expired := 0
+	if e := r.URL.Query().Get("expired"); e != "" {
+		var err error
+		if expired, err = strconv.Atoi(e); err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+			return nil, 0, false
+		}
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return nil, 0, false
+	}
+	defer r.Body.Close()
+
+	contentType := r.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = defaultContentType
+	}
+
+	return &rawValue{Data: data, ContentType: contentType}, expired, true
+}
+
+// setBytes stores the request body as it is with its content type.
+func (a *App) setBytes(w http.ResponseWriter, r *http.Request) {
+	rv, expired, ok := readRaw(w, r)
+	if !ok {
+		return
+	}
+
+	_, version, err := a.db(r).setCond(mux.Vars(r)["key"], rv, expired, setFlags{})
+	if err != nil {
+		respondWithError(w, errorCode(err, http.StatusBadRequest), err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, map[string]interface{}{"result": "success", "length": len(rv.Data), "version": version})
+}
+
+// getBytes returns a string value as the response body with the content
+// type it was stored with. The version is the ETag, so Range and If-Range
+// requests work as for a file.
+func (a *App) getBytes(w http.ResponseWriter, r *http.Request) {
+	rv, version, err := a.db(r).getRaw(mux.Vars(r)["key"])
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	contentType := rv.ContentType
+	if contentType == "" {
+		contentType = defaultContentType
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("ETag", `"`+strconv.FormatInt(version, 10)+`"`)
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(rv.Data))
+}
+
+// appendBytes adds the request body to the end of a string value.
+func (a *App) appendBytes(w http.ResponseWriter, r *http.Request) {
+	rv, expired, ok := readRaw(w, r)
+	if !ok {
+		return
+	}
+
+	length, err := a.db(r).append(mux.Vars(r)["key"], rv.Data, rv.ContentType, expired)
+	if err != nil {
+		respondWithError(w, errorCode(err, http.StatusBadRequest), err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, map[string]interface{}{"result": "success", "length": length})
+}
@@ -0,0 +1,140 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// rawRequest sends body with the content type and returns the response.
+func rawRequest(t *testing.T, method string, url string, contentType string, body []byte, header http.Header) (*http.Response, []byte) {
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return resp, b
+}
+
+func TestApp_RawValues(t *testing.T) {
+	_, server := newDBTestServer(t, Config{Shards: 4})
+
+	data := []byte{0x89, 'P', 'N', 'G', 0x00, 0xff, 0xfe, '\r', '\n'}
+	resp, body := rawRequest(t, "PUT", server.URL+"/setbytes/img", "image/png", data, nil)
+	if resp.StatusCode != http.StatusCreated || !strings.Contains(string(body), `"length":9`) {
+		t.Fatal("Raw value isn't set", resp.StatusCode, string(body))
+	}
+
+	resp, body = rawRequest(t, "GET", server.URL+"/getbytes/img", "", nil, nil)
+	if resp.StatusCode != http.StatusOK || !bytes.Equal(body, data) {
+		t.Error("Raw value doesn't read back as it was written", resp.StatusCode, body)
+	}
+	if resp.Header.Get("Content-Type") != "image/png" {
+		t.Error("Content type isn't echoed back", resp.Header.Get("Content-Type"))
+	}
+
+	resp, body = rawRequest(t, "GET", server.URL+"/getbytes/img", "", nil, http.Header{"Range": {"bytes=4-6"}})
+	if resp.StatusCode != http.StatusPartialContent || !bytes.Equal(body, data[4:7]) || resp.Header.Get("Content-Range") != "bytes 4-6/9" {
+		t.Error("Range isn't served", resp.StatusCode, body, resp.Header.Get("Content-Range"))
+	}
+	resp, _ = rawRequest(t, "GET", server.URL+"/getbytes/img", "", nil, http.Header{"Range": {"bytes=20-"}})
+	if resp.StatusCode != http.StatusRequestedRangeNotSatisfiable {
+		t.Error("Range after the end is served", resp.StatusCode)
+	}
+
+	resp, body = rawRequest(t, "POST", server.URL+"/append/img", "text/plain", []byte{0x01, 0x02}, nil)
+	if resp.StatusCode != http.StatusCreated || !strings.Contains(string(body), `"length":11`) {
+		t.Error("Data isn't appended", resp.StatusCode, string(body))
+	}
+	resp, body = rawRequest(t, "GET", server.URL+"/getbytes/img", "", nil, nil)
+	if !bytes.Equal(body, append(data, 0x01, 0x02)) || resp.Header.Get("Content-Type") != "image/png" {
+		t.Error("Appended value is wrong", body, resp.Header.Get("Content-Type"))
+	}
+
+	rawRequest(t, "POST", server.URL+"/append/new", "", []byte("abc"), nil)
+	resp, body = rawRequest(t, "GET", server.URL+"/getbytes/new", "", nil, nil)
+	if string(body) != "abc" || resp.Header.Get("Content-Type") != defaultContentType {
+		t.Error("Append doesn't create a missing key", string(body), resp.Header.Get("Content-Type"))
+	}
+
+	dbRequest(t, "POST", server.URL+"/set", "", `{"key":"s","value":"ab"}`, nil)
+	rawRequest(t, "POST", server.URL+"/append/s", "", []byte("c"), nil)
+	if _, body = rawRequest(t, "GET", server.URL+"/getbytes/s", "", nil, nil); string(body) != "abc" {
+		t.Error("Data isn't appended to a string value", string(body))
+	}
+
+	dbRequest(t, "POST", server.URL+"/hset", "", `{"key":"h","value":{"a":1}}`, nil)
+	if resp, _ = rawRequest(t, "POST", server.URL+"/append/h", "", []byte("x"), nil); resp.StatusCode != http.StatusBadRequest {
+		t.Error("Data is appended to a dict", resp.StatusCode)
+	}
+	if resp, _ = rawRequest(t, "GET", server.URL+"/getbytes/missing", "", nil, nil); resp.StatusCode != http.StatusNotFound {
+		t.Error("Missing key is found", resp.StatusCode)
+	}
+
+	var object rawValue
+	dbRequest(t, "GET", server.URL+"/get/new", "", "", &object)
+	if string(object.Data) != "abc" || object.ContentType != defaultContentType {
+		t.Error("JSON of a raw value is wrong", object)
+	}
+}
+
+func TestCache_RawValuePersistence(t *testing.T) {
+	dir := t.TempDir()
+	aofPath := filepath.Join(dir, "cache.aof")
+	snapshotPath := filepath.Join(dir, "dump.json")
+
+	tc := NewCache(0)
+	if err := tc.enableAOF(aofPath, FsyncNever, 0); err != nil {
+		t.Fatal(err)
+	}
+	tc.setCond("b", &rawValue{Data: []byte{0xff, 0x00}, ContentType: "application/x-protobuf"}, 0, setFlags{})
+	tc.append("b", []byte{0x01}, "", 0)
+	tc.append("n", []byte("1"), "text/plain", 0)
+	tc.append("n", []byte("2"), "", 0)
+	tc.saveSnapshot(snapshotPath)
+	tc.disableAOF()
+
+	for _, load := range []func(*cache) error{
+		func(c *cache) error { return c.replayAOF(aofPath) },
+		func(c *cache) error { return c.loadSnapshot(snapshotPath) },
+	} {
+		lc := NewCache(0)
+		if err := load(lc); err != nil {
+			t.Fatal(err)
+		}
+
+		rv, _, err := lc.getRaw("b")
+		if err != nil || !bytes.Equal(rv.Data, []byte{0xff, 0x00, 0x01}) || rv.ContentType != "application/x-protobuf" {
+			t.Error("Raw value isn't restored", rv, err)
+		}
+		if v, _ := lc.incrby("n", 1, 0, false); v != 13 {
+			t.Error("Appended counter isn't restored", v)
+		}
+	}
+
+	b, _ := json.Marshal(&rawValue{Data: []byte{0xff}})
+	if string(b) != `{"data":"/w=="}` {
+		t.Error("Raw value isn't encoded in base64", string(b))
+	}
+}
@@ -2,57 +2,126 @@ package app
 
 import (
 	"errors"
+	"math"
 	"runtime"
-	"sync"
+	"sync/atomic"
 	"time"
 )
 
 type item interface {
 	getExpired() int64
+	getHeader() *header
 }
 
-type simpleItem struct {
-	object  interface{}
+// header holds the fields every item type has besides its value.
+type header struct {
 	expired int64
+	// version is bumped on every change of the item, see cache.feed.
+	version int64
+	// size is the estimated memory used by the item and its key.
+	size int64
+	// atime and hits are updated under the read lock.
+	atime atomic.Int64
+	hits  atomic.Uint32
+	// expiryNotified is set once the expiry of the item was announced to
+	// keyspace subscribers, see cache.notify.
+	expiryNotified atomic.Bool
 }
 
-type listItem struct {
-	listObject []interface{}
-	expired    int64
+func (h *header) getExpired() int64 {
+	return h.expired
 }
 
-type dictItem struct {
-	dictObject map[string]interface{}
-	expired    int64
+func (h *header) getHeader() *header {
+	return h
 }
 
-func (si simpleItem) getExpired() int64 {
-	return si.expired
+func (h *header) touch() {
+	h.atime.Store(time.Now().UnixNano())
+	if h.hits.Load() < math.MaxUint32 {
+		h.hits.Add(1)
+	}
 }
 
-func (li listItem) getExpired() int64 {
-	return li.expired
+type simpleItem struct {
+	header
+	object interface{}
 }
 
-func (di dictItem) getExpired() int64 {
-	return di.expired
+type dictItem struct {
+	header
+	dictObject map[string]interface{}
 }
 
 type cache struct {
-	items                 map[string]item
-	mu                    sync.RWMutex
+	shards                []*shard
 	janitor               *janitor
+	aof                   *aof
 	ExpiredTimeMultiplier time.Duration
+
+	// MaxMemory and MaxKeys limit the estimated memory used by the items
+	// and the number of keys. Zero means no limit. When a limit is reached
+	// keys are evicted according to EvictionPolicy.
+	MaxMemory       int64
+	MaxKeys         int64
+	EvictionPolicy  string
+	EvictionSamples int
+
+	used           atomic.Int64
+	count          atomic.Int64
+	evicted        atomic.Int64
+	rejectedWrites atomic.Int64
+
+	// lastVersion is the last version given to an item. Versions grow across
+	// all keys, so a deleted and recreated key never gets an old version.
+	lastVersion atomic.Int64
+
+	waiters waiters
+	pubsub  *pubsub
+	// keyspace delivers keyspace events, see cache.notify.
+	keyspace *pubsub
+
+	// db is the index of the database and repl the replication backlog
+	// its mutations go to, see cache.log. A follower applies expirations
+	// of its leader, so it doesn't delete expired keys itself while
+	// following is set.
+	db        int
+	repl      *backlog
+	following atomic.Bool
+
+	// deterministic is set in raft mode, where every node must get the
+	// same result from a command: spop takes the smallest members instead
+	// of random ones, and keys are only deleted by the expired commands
+	// of the leader, see App.runRaftExpiry. batch records the commands of
+	// the write the leader resolves.
+	deterministic bool
+	batch         *raftBatch
 }
 
 func NewCache(interval time.Duration) *cache {
+	return NewShardedCache(interval, defaultShardCount)
+}
+
+// NewShardedCache creates a cache with the keyspace split into the given
+// number of shards.
+func NewShardedCache(interval time.Duration, shards int) *cache {
 	if interval == 0 {
 		interval = time.Duration(10 * time.Millisecond)
 	}
+	if shards <= 0 {
+		shards = defaultShardCount
+	}
 
 	c := &cache{
-		items: make(map[string]item),
+		shards:                make([]*shard, shards),
 		ExpiredTimeMultiplier: time.Second,
+		EvictionPolicy:        NoEviction,
+		EvictionSamples:       defaultEvictionSamples,
+		pubsub:                newPubsub(defaultSubscriberBuffer),
+		keyspace:              newPubsub(defaultSubscriberBuffer),
+	}
+	for i := range c.shards {
+		c.shards[i] = newShard(c)
 	}
 	runJanitor(c, interval)
 	runtime.SetFinalizer(c, stopJanitor)
@@ -60,204 +129,174 @@ func NewCache(interval time.Duration) *cache {
 	return c
 }
 
-func (c *cache) set(key string, value interface{}, duration int) bool {
-	var e int64
+func (c *cache) expiration(duration int) int64 {
 	if duration > 0 {
-		e = time.Now().Add(time.Duration(duration) * c.ExpiredTimeMultiplier).UnixNano()
+		return time.Now().Add(time.Duration(duration) * c.ExpiredTimeMultiplier).UnixNano()
 	}
 
-	c.mu.Lock()
-	c.items[key] = simpleItem{
-		object:  value,
-		expired: e,
-	}
-	c.mu.Unlock()
-
-	return true
+	return 0
 }
 
-func (c *cache) get(key string) (interface{}, error) {
-	c.mu.RLock()
-	item, found := c.items[key]
-
-	if !found {
-		c.mu.RUnlock()
-		return nil, errors.New("not found")
-	}
-
-	if item.getExpired() > 0 {
-		if time.Now().UnixNano() > item.getExpired() {
-			c.mu.RUnlock()
-			return nil, errors.New("not found")
-		}
-	}
-
-	si, ok := item.(simpleItem)
-	if !ok {
-		c.mu.RUnlock()
-		return nil, errors.New("wrong object type")
-	}
-
-	c.mu.RUnlock()
-	return si.object, nil
+func expired(i item) bool {
+	return i.getExpired() > 0 && time.Now().UnixNano() > i.getExpired()
 }
 
-func (c *cache) keys() []string {
-	c.mu.RLock()
-
-	var keys []string
-
-	for k := range c.items {
-		keys = append(keys, k)
+func (c *cache) set(key string, value interface{}, duration int) (bool, error) {
+	if err := c.ensureCapacity(key); err != nil {
+		return false, err
 	}
 
-	c.mu.RUnlock()
+	e := c.expiration(duration)
 
-	return keys
-}
+	s := c.getShard(key)
+	s.mu.Lock()
+	s.set(key, value, e)
+	s.mu.Unlock()
 
-func (c *cache) deleteItem(key string) {
-	delete(c.items, key)
+	return true, nil
 }
 
-func (c *cache) rpush(key string, value interface{}, duration int) (bool, error) {
-	c.mu.Lock()
-	item, found := c.items[key]
-	var e int64
-	if duration > 0 {
-		e = time.Now().Add(time.Duration(duration) * c.ExpiredTimeMultiplier).UnixNano()
-	}
-
-	if !found {
-		var object []interface{}
-		object = append(object, value)
+func (s *shard) set(key string, value interface{}, e int64) {
+	s.store(key, &simpleItem{
+		header: header{expired: e},
+		object: value,
+	})
+	s.c.feed("set", key, value, e)
+}
 
-		li := listItem{
-			expired:    e,
-			listObject: object,
-		}
+func (c *cache) get(key string) (interface{}, error) {
+	s := c.getShard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 
-		c.items[key] = li
+	return s.get(key)
+}
 
-		c.mu.Unlock()
+func (s *shard) get(key string) (interface{}, error) {
+	item, found := s.items[key]
 
-		return true, nil
+	if !found || s.isExpired(key, item) {
+		return nil, errors.New("not found")
 	}
 
-	li, ok := item.(listItem)
-
+	si, ok := item.(*simpleItem)
 	if !ok {
-		return false, errors.New("invalid object type")
-	}
-
-	li.listObject = append(li.listObject, value)
-	if duration > 0 {
-		li.expired = e
+		return nil, errors.New("wrong object type")
 	}
+	si.touch()
 
-	c.items[key] = li
-
-	c.mu.Unlock()
+	return si.object, nil
+}
 
-	return true, nil
+func (c *cache) keys() []string {
+	return c.keysMatching("")
 }
 
-func (c *cache) lgetall(key string) ([]interface{}, error) {
-	c.mu.RLock()
+// keysMatching returns the keys matching a glob pattern, all of them when
+// the pattern is empty.
+func (c *cache) keysMatching(pattern string) []string {
+	c.rlockAll()
+	defer c.runlockAll()
 
-	item, found := c.items[key]
+	return c.matchingKeys(pattern)
+}
 
-	if !found {
-		c.mu.RUnlock()
-		return nil, errors.New("not found")
-	}
+// matchingKeys is keysMatching with all shards locked.
+func (c *cache) matchingKeys(pattern string) []string {
+	var keys []string
 
-	if item.getExpired() > 0 {
-		if time.Now().UnixNano() > item.getExpired() {
-			c.mu.RUnlock()
-			return nil, errors.New("not found")
+	for _, s := range c.shards {
+		for k := range s.items {
+			if pattern == "" || globMatch(pattern, k) {
+				keys = append(keys, k)
+			}
 		}
 	}
 
-	li, ok := item.(listItem)
-
-	if !ok {
-		c.mu.RUnlock()
-		return nil, errors.New("wrong type")
-	}
-	c.mu.RUnlock()
-
-	return li.listObject, nil
+	return keys
 }
 
-func (c *cache) lget(key string, id int) (interface{}, error) {
-	c.mu.RLock()
-	item, found := c.items[key]
+func (c *cache) deleteItem(key string) {
+	s := c.getShard(key)
+	s.mu.Lock()
+	s.deleteItem(key)
+	s.mu.Unlock()
+}
 
-	if !found {
-		c.mu.RUnlock()
-		return nil, errors.New("not found")
+// deleteKeys deletes the keys and returns how many of them existed.
+func (c *cache) deleteKeys(keys []string) int {
+	shards := c.shardsOf(keys...)
+	lockShards(shards)
+	defer unlockShards(shards)
+
+	deleted := 0
+	for _, key := range keys {
+		s := c.getShard(key)
+		if s.lookupAny(key) != nil {
+			deleted++
+		}
+		s.deleteItem(key)
 	}
 
-	li, ok := item.(listItem)
+	return deleted
+}
 
-	if !ok {
-		c.mu.RUnlock()
-		return nil, errors.New("wrong type")
+func (s *shard) deleteItem(key string) {
+	if _, found := s.items[key]; found {
+		s.c.feed("del", key, nil, 0)
+		s.remove(key)
 	}
+}
 
-	if len(li.listObject) < id+1 {
-		c.mu.RUnlock()
-		return nil, errors.New("not found")
+func (c *cache) hset(key string, value map[string]interface{}, duration int) error {
+	if err := c.ensureCapacity(key); err != nil {
+		return err
 	}
 
-	value := li.listObject[id]
-
-	c.mu.RUnlock()
+	e := c.expiration(duration)
 
-	return value, nil
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
+	return s.hset(key, value, e)
 }
 
-func (c *cache) pop(key string) (interface{}, error) {
-	c.mu.Lock()
-	item, found := c.items[key]
-
-	if !found {
-		c.mu.Unlock()
-		return nil, errors.New("not found")
+// hsetCount is hset that returns the number of fields that were added.
+func (c *cache) hsetCount(key string, value map[string]interface{}, duration int) (int, error) {
+	if err := c.ensureCapacity(key); err != nil {
+		return 0, err
 	}
 
-	li, ok := item.(listItem)
+	e := c.expiration(duration)
 
-	if !ok {
-		c.mu.Unlock()
-		return nil, errors.New("wrong type")
-	}
-
-	var object interface{}
-	object, li.listObject = li.listObject[len(li.listObject)-1], li.listObject[:len(li.listObject)-1]
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	if len(li.listObject) == 0 {
-		c.mu.Unlock()
-		delete(c.items, key)
-		return object, nil
+	di, err := s.lookupDict(key)
+	if err != nil {
+		return 0, err
+	}
+	added := len(value)
+	if di != nil {
+		for k := range value {
+			if _, found := di.dictObject[k]; found {
+				added--
+			}
+		}
 	}
 
-	c.items[key] = li
-
-	c.mu.Unlock()
-
-	return object, nil
+	return added, s.hset(key, value, e)
 }
 
-func (c *cache) hset(key string, value map[string]interface{}, duration int) error {
-	c.mu.Lock()
-	item, found := c.items[key]
-
-	var e int64
-	if duration > 0 {
-		e = time.Now().Add(time.Duration(duration) * c.ExpiredTimeMultiplier).UnixNano()
+// hset merges value into the dict at key. A zero e keeps the current
+// expiry of the dict.
+func (s *shard) hset(key string, value map[string]interface{}, e int64) error {
+	item, found := s.items[key]
+	if found && s.isExpired(key, item) {
+		s.removeExpired(key)
+		found = false
 	}
 
 	if !found {
@@ -266,109 +305,120 @@ func (c *cache) hset(key string, value map[string]interface{}, duration int) err
 			object[k] = v
 		}
 
-		di := dictItem{
-			expired:    e,
+		di := &dictItem{
+			header:     header{expired: e},
 			dictObject: object,
 		}
-		c.items[key] = di
-
-		c.mu.Unlock()
+		s.store(key, di)
+		s.c.feed("hset", key, value, di.expired)
 
 		return nil
 	}
 
-	di, ok := item.(dictItem)
+	di, ok := item.(*dictItem)
 
 	if !ok {
 		return errors.New("invalid object type")
 	}
 
+	var delta int64
 	for k, v := range value {
+		if old, found := di.dictObject[k]; found {
+			delta -= sizeOf(old)
+		} else {
+			delta += sizeOf(k)
+		}
+		delta += sizeOf(v)
 		di.dictObject[k] = v
 	}
 
-	if duration > 0 {
+	if e > 0 {
 		di.expired = e
 	}
 
-	c.items[key] = di
-
-	c.mu.Unlock()
+	s.grow(di, delta)
+	di.touch()
+	s.c.feed("hset", key, value, di.expired)
 
 	return nil
 }
 
 func (c *cache) hgetall(key string) (map[string]interface{}, error) {
-	c.mu.RLock()
-	item, found := c.items[key]
+	s := c.getShard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 
-	if !found {
-		c.mu.RUnlock()
-		return nil, errors.New("not found")
-	}
+	return s.hgetall(key)
+}
 
-	if item.getExpired() > 0 {
-		if time.Now().UnixNano() > item.getExpired() {
-			c.mu.RUnlock()
-			return nil, errors.New("not found")
-		}
+func (s *shard) hgetall(key string) (map[string]interface{}, error) {
+	item, found := s.items[key]
+
+	if !found || s.isExpired(key, item) {
+		return nil, errors.New("not found")
 	}
 
-	di, ok := item.(dictItem)
+	di, ok := item.(*dictItem)
 
 	if !ok {
-		c.mu.RUnlock()
 		return nil, errors.New("wrong type")
 	}
-	c.mu.RUnlock()
+	di.touch()
+
+	object := make(map[string]interface{}, len(di.dictObject))
+	for k, v := range di.dictObject {
+		object[k] = v
+	}
 
-	return di.dictObject, nil
+	return object, nil
 }
 
 func (c *cache) hget(key string, dictKey string) (interface{}, error) {
-	c.mu.RLock()
-	item, found := c.items[key]
+	s := c.getShard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 
-	if !found {
-		c.mu.RUnlock()
-		return nil, errors.New("not found")
-	}
+	return s.hget(key, dictKey)
+}
 
-	if item.getExpired() > 0 {
-		if time.Now().UnixNano() > item.getExpired() {
-			c.mu.RUnlock()
-			return nil, errors.New("not found")
-		}
+func (s *shard) hget(key string, dictKey string) (interface{}, error) {
+	item, found := s.items[key]
+
+	if !found || s.isExpired(key, item) {
+		return nil, errors.New("not found")
 	}
 
-	di, ok := item.(dictItem)
+	di, ok := item.(*dictItem)
 
 	if !ok {
-		c.mu.RUnlock()
 		return nil, errors.New("wrong type")
 	}
 
 	value, ok := di.dictObject[dictKey]
 
 	if !ok {
-		c.mu.RUnlock()
 		return nil, errors.New("not found")
 	}
-
-	c.mu.RUnlock()
+	di.touch()
 
 	return value, nil
 }
 
+// DeleteExpired sweeps the shards one by one, so only one shard at a time
+// is locked.
 func (c *cache) DeleteExpired() {
-	now := time.Now().UnixNano()
-	c.mu.Lock()
-
-	for k, v := range c.items {
-		if v.getExpired() > 0 && now > v.getExpired() {
-			delete(c.items, k)
-		}
+	if c.following.Load() || c.deterministic {
+		return
 	}
 
-	c.mu.Unlock()
+	c.deleteExpired()
+}
+
+func (c *cache) deleteExpired() {
+	for _, s := range c.shards {
+		now := time.Now().UnixNano()
+		s.mu.Lock()
+		s.deleteExpired(now)
+		s.mu.Unlock()
+	}
 }
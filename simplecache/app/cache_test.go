@@ -1,6 +1,7 @@
 package app
 
 import (
+	"strconv"
 	"testing"
 	"time"
 )
@@ -283,3 +284,40 @@ func TestCache_Expired(t *testing.T) {
 		t.Error("Found d when it should have been automatically deleted (later than the default)")
 	}
 }
+
+func TestCache_Shards(t *testing.T) {
+	tc := NewShardedCache(time.Duration(5*time.Millisecond), 4)
+	tc.ExpiredTimeMultiplier = time.Millisecond
+
+	for i := 0; i < 100; i++ {
+		tc.set(strconv.Itoa(i), i, 0)
+	}
+	for i := 100; i < 200; i++ {
+		tc.set(strconv.Itoa(i), i, 10)
+	}
+
+	for i, s := range tc.shards {
+		if len(s.items) == 0 {
+			t.Error("Shard is empty", i)
+		}
+	}
+
+	keys := tc.keys()
+	if len(keys) != 200 {
+		t.Error("Length keys doesn't equals 200", len(keys))
+	}
+
+	<-time.After(30 * time.Millisecond)
+
+	keys = tc.keys()
+	if len(keys) != 100 {
+		t.Error("Expired keys weren't deleted from all shards", len(keys))
+	}
+
+	for i := 0; i < 100; i++ {
+		v, err := tc.get(strconv.Itoa(i))
+		if err != nil || v != i {
+			t.Error("Can't find value that should exist", i, v)
+		}
+	}
+}
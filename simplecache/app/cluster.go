@@ -0,0 +1,784 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gorilla/mux"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// clusterSlots is the number of hash slots the keyspace is split into.
+const clusterSlots = 16384
+
+// askingHeader marks a request redirected by ASK, which the node importing
+// the slot serves although it doesn't own it yet.
+const askingHeader = "X-Cache-Asking"
+
+// migrateBatch is the number of keys moved to the target node at once.
+const migrateBatch = 100
+
+var (
+	errCrossSlot = errors.New("CROSSSLOT keys in request don't hash to the same slot")
+	errTryAgain  = errors.New("TRYAGAIN some keys of the request are being migrated")
+)
+
+// keySlot returns the hash slot of key as Redis Cluster does: the CRC16 of
+// the key, or of the part between the first { and the next } when it isn't
+// empty, so related keys can be put in one slot.
+func keySlot(key string) int {
+	if start := strings.IndexByte(key, '{'); start >= 0 {
+		if end := strings.IndexByte(key[start+1:], '}'); end > 0 {
+			key = key[start+1 : start+1+end]
+		}
+	}
+
+	return int(crc16(key)) % clusterSlots
+}
+
+// crc16 is CRC-16/XMODEM.
+func crc16(s string) uint16 {
+	var crc uint16
+	for i := 0; i < len(s); i++ {
+		crc ^= uint16(s[i]) << 8
+		for j := 0; j < 8; j++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+
+	return crc
+}
+
+// clusterNode is a node of the cluster with the addresses clients reach
+// its APIs at. Addr is the URL of the HTTP API and identifies the node.
+type clusterNode struct {
+	Addr string `json:"addr"`
+	GRPC string `json:"grpc,omitempty"`
+	RESP string `json:"resp,omitempty"`
+}
+
+type slotRange struct {
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+	Node  string `json:"node"`
+}
+
+// clusterState is the slot map. Nodes adopt the state with the highest
+// epoch, every change of the slot map increments it.
+type clusterState struct {
+	Epoch int64         `json:"epoch"`
+	Nodes []clusterNode `json:"nodes"`
+	Slots []slotRange   `json:"slots"`
+}
+
+// slotsObject is the body of the admin requests on a range of slots.
+type slotsObject struct {
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+	Node  string `json:"node"`
+}
+
+// cluster is the view of the slot map of a node.
+type cluster struct {
+	self clusterNode
+
+	mu     sync.RWMutex
+	epoch  int64
+	nodes  map[string]clusterNode
+	owners []string
+	// migrating maps the slots the node moves away to their targets and
+	// importing the slots it receives to their sources.
+	migrating map[int]string
+	importing map[int]string
+
+	// inflight counts the requests being served per slot, so slots start
+	// migrating only once the requests routed before are done. migrateMu
+	// is held for reading by requests on migrating slots and for writing
+	// while keys are moved, so such a request sees a key either before or
+	// after its move.
+	inflight  []atomic.Int32
+	migrateMu sync.RWMutex
+}
+
+func newCluster(config Config) *cluster {
+	addr := strings.TrimSuffix(config.ClusterAddr, "/")
+	self := clusterNode{Addr: addr}
+	if u, err := url.Parse(addr); err == nil {
+		self.GRPC = advertisedAddr(u.Hostname(), config.GRPCAddr)
+		self.RESP = advertisedAddr(u.Hostname(), config.RESPAddr)
+	}
+
+	return &cluster{
+		self:      self,
+		nodes:     map[string]clusterNode{addr: self},
+		owners:    make([]string, clusterSlots),
+		migrating: map[int]string{},
+		importing: map[int]string{},
+		inflight:  make([]atomic.Int32, clusterSlots),
+	}
+}
+
+// advertisedAddr returns the address of a listener at listen for the host
+// clients know the node by, empty when the listener is disabled.
+func advertisedAddr(host string, listen string) string {
+	if listen == "" {
+		return ""
+	}
+	_, port, err := net.SplitHostPort(listen)
+	if err != nil {
+		return ""
+	}
+
+	return net.JoinHostPort(host, port)
+}
+
+// slotRedirect sends a request to the node serving its slot, permanently
+// with MOVED or for the request only with ASK.
+type slotRedirect struct {
+	ask  bool
+	slot int
+	node clusterNode
+}
+
+// message is the error of the redirect with addr, the address of the node
+// for the protocol of the request.
+func (r *slotRedirect) message(addr string) string {
+	kind := "MOVED"
+	if r.ask {
+		kind = "ASK"
+	}
+
+	return kind + " " + strconv.Itoa(r.slot) + " " + addr
+}
+
+// route decides whether the node serves a request on keys. It returns
+// either a redirect or a function releasing the request once it's done.
+// exists reports whether a key is in the database of the request.
+func (cl *cluster) route(keys []string, asking bool, exists func(string) bool) (*slotRedirect, func(), error) {
+	if len(keys) == 0 {
+		return nil, func() {}, nil
+	}
+
+	slot := keySlot(keys[0])
+	for _, key := range keys[1:] {
+		if keySlot(key) != slot {
+			return nil, nil, errCrossSlot
+		}
+	}
+
+	cl.inflight[slot].Add(1)
+	owner, target, importing := cl.slot(slot)
+	if owner == cl.self.Addr && target == "" || importing && asking {
+		return nil, func() { cl.inflight[slot].Add(-1) }, nil
+	}
+	cl.inflight[slot].Add(-1)
+
+	if owner == cl.self.Addr {
+		// Keys that weren't moved yet are still served here, missing
+		// ones are already on the target or created there.
+		cl.migrateMu.RLock()
+		owner, target, _ = cl.slot(slot)
+		found := 0
+		for _, key := range keys {
+			if exists(key) {
+				found++
+			}
+		}
+		if owner == cl.self.Addr && found == len(keys) {
+			return nil, cl.migrateMu.RUnlock, nil
+		}
+		cl.migrateMu.RUnlock()
+		if owner == cl.self.Addr && found > 0 {
+			return nil, nil, errTryAgain
+		}
+		if owner == cl.self.Addr {
+			return &slotRedirect{ask: true, slot: slot, node: cl.lookup(target)}, nil, nil
+		}
+	}
+
+	if owner == "" {
+		return nil, nil, fmt.Errorf("CLUSTERDOWN hash slot %d is not served", slot)
+	}
+
+	return &slotRedirect{slot: slot, node: cl.lookup(owner)}, nil, nil
+}
+
+// slot returns the owner of slot, the target it is migrating to and
+// whether it is being imported.
+func (cl *cluster) slot(slot int) (string, string, bool) {
+	cl.mu.RLock()
+	defer cl.mu.RUnlock()
+
+	_, importing := cl.importing[slot]
+
+	return cl.owners[slot], cl.migrating[slot], importing
+}
+
+// lookup returns the node at addr.
+func (cl *cluster) lookup(addr string) clusterNode {
+	cl.mu.RLock()
+	defer cl.mu.RUnlock()
+
+	if n, found := cl.nodes[addr]; found {
+		return n
+	}
+
+	return clusterNode{Addr: addr}
+}
+
+// state returns the slot map as ranges of consecutive slots.
+func (cl *cluster) state() clusterState {
+	cl.mu.RLock()
+	defer cl.mu.RUnlock()
+
+	st := clusterState{Epoch: cl.epoch}
+	for _, n := range cl.nodes {
+		st.Nodes = append(st.Nodes, n)
+	}
+	for slot, owner := range cl.owners {
+		if owner == "" {
+			continue
+		}
+		if last := len(st.Slots) - 1; last >= 0 && st.Slots[last].Node == owner && st.Slots[last].End == slot-1 {
+			st.Slots[last].End = slot
+			continue
+		}
+		st.Slots = append(st.Slots, slotRange{Start: slot, End: slot, Node: owner})
+	}
+
+	return st
+}
+
+// adopt replaces the slot map with st when it is newer. Migrations of
+// slots the node no longer owns and imports of slots it owns now end.
+func (cl *cluster) adopt(st clusterState) bool {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+
+	if st.Epoch <= cl.epoch {
+		return false
+	}
+
+	cl.epoch = st.Epoch
+	for _, n := range st.Nodes {
+		cl.nodes[n.Addr] = n
+	}
+	cl.nodes[cl.self.Addr] = cl.self
+	cl.owners = make([]string, clusterSlots)
+	for _, r := range st.Slots {
+		for slot := r.Start; slot <= r.End && slot < clusterSlots; slot++ {
+			cl.owners[slot] = r.Node
+		}
+	}
+	for slot := range cl.migrating {
+		if cl.owners[slot] != cl.self.Addr {
+			delete(cl.migrating, slot)
+		}
+	}
+	for slot := range cl.importing {
+		if cl.owners[slot] == cl.self.Addr {
+			delete(cl.importing, slot)
+		}
+	}
+
+	return true
+}
+
+// broadcast sends the slot map to every other node.
+func (cl *cluster) broadcast(ctx context.Context) {
+	st := cl.state()
+	b, _ := json.Marshal(st)
+	for _, n := range st.Nodes {
+		if n.Addr == cl.self.Addr {
+			continue
+		}
+		if err := clusterPost(ctx, n.Addr+"/cluster/state", b, nil); err != nil {
+			log.Println("cluster:", n.Addr, err)
+		}
+	}
+}
+
+// node returns the node at addr, asking it for its addresses when it's
+// unknown.
+func (cl *cluster) node(ctx context.Context, addr string) (clusterNode, error) {
+	addr = strings.TrimSuffix(addr, "/")
+	cl.mu.RLock()
+	n, found := cl.nodes[addr]
+	cl.mu.RUnlock()
+	if found {
+		return n, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", addr+"/cluster/node", nil)
+	if err != nil {
+		return n, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return n, err
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(resp.Body).Decode(&n); err != nil {
+		return n, err
+	}
+	if n.Addr != addr {
+		return n, fmt.Errorf("node at %s calls itself %s", addr, n.Addr)
+	}
+
+	cl.mu.Lock()
+	cl.nodes[addr] = n
+	cl.mu.Unlock()
+
+	return n, nil
+}
+
+// clusterPost sends a request of the cluster API to another node and
+// decodes the response into v unless it's nil.
+func clusterPost(ctx context.Context, url string, body []byte, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respErr := map[string]string{}
+		json.NewDecoder(resp.Body).Decode(&respErr)
+		return fmt.Errorf("%s: %s", resp.Status, respErr["error"])
+	}
+	if v != nil {
+		return json.NewDecoder(resp.Body).Decode(v)
+	}
+
+	return nil
+}
+
+// requestKeys returns the keys a request works on: the key of the path,
+// the key query parameters and the keys named in a JSON body.
+func requestKeys(r *http.Request) ([]string, error) {
+	var keys []string
+	if key, found := mux.Vars(r)["key"]; found {
+		keys = append(keys, key)
+	}
+	keys = append(keys, r.URL.Query()["key"]...)
+
+	if r.Body == nil || r.Method == "GET" {
+		return keys, nil
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	return append(keys, bodyKeys(body)...), nil
+}
+
+// bodyKeys returns the keys named in a JSON request body or in the args of
+// a command: key, keys and destination, and for transactions the keys of
+// the commands and the watched keys.
+func bodyKeys(body []byte) []string {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return nil
+	}
+
+	return argKeys(v)
+}
+
+func argKeys(v interface{}) []string {
+	var keys []string
+	switch v := v.(type) {
+	case []interface{}:
+		for _, cmd := range v {
+			if cmd, ok := cmd.(map[string]interface{}); ok {
+				keys = append(keys, argKeys(jsonField(cmd, "args"))...)
+			}
+		}
+	case map[string]interface{}:
+		for _, name := range []string{"key", "destination"} {
+			if key, ok := jsonField(v, name).(string); ok {
+				keys = append(keys, key)
+			}
+		}
+		if list, ok := jsonField(v, "keys").([]interface{}); ok {
+			for _, key := range list {
+				if key, ok := key.(string); ok {
+					keys = append(keys, key)
+				}
+			}
+		}
+		if watch, ok := jsonField(v, "watch").(map[string]interface{}); ok {
+			for key := range watch {
+				keys = append(keys, key)
+			}
+		}
+		if commands, ok := jsonField(v, "commands").([]interface{}); ok {
+			keys = append(keys, argKeys(commands)...)
+		}
+	}
+
+	return keys
+}
+
+// jsonField returns the field name of a JSON object, matched without case
+// like encoding/json does when it decodes the request.
+func jsonField(v map[string]interface{}, name string) interface{} {
+	if field, ok := v[name]; ok {
+		return field
+	}
+	for k, field := range v {
+		if strings.EqualFold(k, name) {
+			return field
+		}
+	}
+
+	return nil
+}
+
+// routeSlot redirects requests on keys of slots the node doesn't serve, see
+// cluster.route. MOVED answers 308 and ASK 307, both with the owner in
+// Location and the error.
+func (a *App) routeSlot(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if a.cluster == nil || strings.HasPrefix(r.URL.Path, "/cluster/") || strings.HasPrefix(r.URL.Path, "/replication") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		keys, err := requestKeys(r)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+			return
+		}
+
+		redirect, done, err := a.cluster.route(keys, r.Header.Get(askingHeader) != "", a.db(r).exists)
+		switch {
+		case err != nil:
+			code := http.StatusBadRequest
+			if err != errCrossSlot {
+				code = http.StatusServiceUnavailable
+			}
+			respondWithError(w, code, err.Error())
+		case redirect != nil:
+			code := http.StatusPermanentRedirect
+			if redirect.ask {
+				code = http.StatusTemporaryRedirect
+			}
+			w.Header().Set("Location", redirect.node.Addr+r.URL.RequestURI())
+			respondWithError(w, code, redirect.message(redirect.node.Addr))
+		default:
+			defer done()
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+func (a *App) clusterNode(w http.ResponseWriter, r *http.Request) {
+	respondWithJSON(w, http.StatusOK, a.cluster.self)
+}
+
+func (a *App) clusterSlots(w http.ResponseWriter, r *http.Request) {
+	respondWithJSON(w, http.StatusOK, a.cluster.state())
+}
+
+// clusterState adopts the slot map sent by another node.
+func (a *App) clusterState(w http.ResponseWriter, r *http.Request) {
+	var st clusterState
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&st); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	respondWithJSON(w, http.StatusOK, map[string]interface{}{"result": "success", "adopted": a.cluster.adopt(st)})
+}
+
+// decodeSlots decodes the body of an admin request on a range of slots.
+func decodeSlots(w http.ResponseWriter, r *http.Request) (slotsObject, bool) {
+	var so slotsObject
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&so); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return so, false
+	}
+	defer r.Body.Close()
+
+	if so.Start < 0 || so.End >= clusterSlots || so.Start > so.End {
+		respondWithError(w, http.StatusBadRequest, "slot range is out of range")
+		return so, false
+	}
+	so.Node = strings.TrimSuffix(so.Node, "/")
+
+	return so, true
+}
+
+// clusterAssign gives unassigned slots to a node and sends the new slot map
+// to every node.
+func (a *App) clusterAssign(w http.ResponseWriter, r *http.Request) {
+	so, ok := decodeSlots(w, r)
+	if !ok {
+		return
+	}
+
+	cl := a.cluster
+	if _, err := cl.node(r.Context(), so.Node); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cl.mu.Lock()
+	for slot := so.Start; slot <= so.End; slot++ {
+		if owner := cl.owners[slot]; owner != "" && owner != so.Node {
+			cl.mu.Unlock()
+			respondWithError(w, http.StatusConflict, fmt.Sprintf("slot %d is served by %s", slot, owner))
+			return
+		}
+	}
+	for slot := so.Start; slot <= so.End; slot++ {
+		cl.owners[slot] = so.Node
+	}
+	cl.epoch++
+	cl.mu.Unlock()
+
+	cl.broadcast(r.Context())
+	respondWithJSON(w, http.StatusOK, cl.state())
+}
+
+// clusterImport makes the node serve requests with the asking header for
+// slots the node in the body migrates to it.
+func (a *App) clusterImport(w http.ResponseWriter, r *http.Request) {
+	so, ok := decodeSlots(w, r)
+	if !ok {
+		return
+	}
+
+	cl := a.cluster
+	cl.mu.Lock()
+	for slot := so.Start; slot <= so.End; slot++ {
+		cl.importing[slot] = so.Node
+	}
+	cl.mu.Unlock()
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"result": "success"})
+}
+
+// clusterRestore stores keys migrated from another node.
+func (a *App) clusterRestore(w http.ResponseWriter, r *http.Request) {
+	var entries []replEntry
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&entries); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	for _, e := range entries {
+		if e.DB < 0 || e.DB >= len(a.dbs) {
+			respondWithError(w, http.StatusBadRequest, errNoSuchDB.Error())
+			return
+		}
+		if err := a.dbs[e.DB].restore(e.command); err != nil {
+			respondWithError(w, errorCode(err, http.StatusBadRequest), err.Error())
+			return
+		}
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]interface{}{"result": "success", "keys": len(entries)})
+}
+
+// restore stores a key sent as a restore command with a new version.
+func (c *cache) restore(cmd command) error {
+	i, err := decodeItem(snapshotRecord{Key: cmd.Key, Type: cmd.Type, Expired: cmd.Expired, Value: cmd.Value})
+	if err != nil {
+		return err
+	}
+	if err := c.ensureCapacity(cmd.Key); err != nil {
+		return err
+	}
+
+	s := c.getShard(cmd.Key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.store(cmd.Key, i)
+	c.feedItem("restore", cmd.Key, i)
+	if _, ok := i.(*listItem); ok {
+		s.serveWaiters(cmd.Key)
+	}
+
+	return nil
+}
+
+// clusterMigrate moves slots the node serves to another node.
+func (a *App) clusterMigrate(w http.ResponseWriter, r *http.Request) {
+	so, ok := decodeSlots(w, r)
+	if !ok {
+		return
+	}
+
+	moved, err := a.migrateSlots(r.Context(), so.Start, so.End, so.Node)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]interface{}{"result": "success", "keys": moved})
+}
+
+// migrateSlots moves the slots from start to end with their keys to the
+// node at target and returns the number of keys moved. While the keys
+// are moved, requests on keys still on the node are served here and the
+// others are sent to the target with ASK, so no write is lost. At the end
+// the target owns the slots and the new slot map is sent to every node.
+func (a *App) migrateSlots(ctx context.Context, start int, end int, target string) (int, error) {
+	cl := a.cluster
+	target = strings.TrimSuffix(target, "/")
+	if target == cl.self.Addr {
+		return 0, errors.New("slots are already served by the node")
+	}
+	if _, err := cl.node(ctx, target); err != nil {
+		return 0, err
+	}
+
+	cl.mu.RLock()
+	for slot := start; slot <= end; slot++ {
+		if owner := cl.owners[slot]; owner != cl.self.Addr {
+			cl.mu.RUnlock()
+			return 0, fmt.Errorf("slot %d isn't served by the node", slot)
+		}
+		if t, found := cl.migrating[slot]; found && t != target {
+			cl.mu.RUnlock()
+			return 0, fmt.Errorf("slot %d is migrating to %s", slot, t)
+		}
+	}
+	cl.mu.RUnlock()
+
+	// A node that just joined learns the slot map first.
+	b, _ := json.Marshal(cl.state())
+	if err := clusterPost(ctx, target+"/cluster/state", b, nil); err != nil {
+		return 0, err
+	}
+	b, _ = json.Marshal(slotsObject{Start: start, End: end, Node: cl.self.Addr})
+	if err := clusterPost(ctx, target+"/cluster/import", b, nil); err != nil {
+		return 0, err
+	}
+
+	cl.mu.Lock()
+	for slot := start; slot <= end; slot++ {
+		cl.migrating[slot] = target
+	}
+	cl.mu.Unlock()
+
+	// Requests that were routed before the slots were marked finish
+	// first. When the migration fails the slots stay migrating, which is
+	// consistent with the keys split between the nodes, and it can be run
+	// again.
+	for slot := start; slot <= end; slot++ {
+		for cl.inflight[slot].Load() > 0 {
+			select {
+			case <-time.After(10 * time.Millisecond):
+			case <-ctx.Done():
+				return 0, ctx.Err()
+			}
+		}
+	}
+
+	moved := 0
+	for db, c := range a.dbs {
+		for {
+			n, err := a.migrateKeys(ctx, db, c, start, end, target)
+			if err != nil {
+				return moved, err
+			}
+			if n == 0 {
+				break
+			}
+			moved += n
+		}
+	}
+
+	cl.mu.Lock()
+	for slot := start; slot <= end; slot++ {
+		cl.owners[slot] = target
+		delete(cl.migrating, slot)
+	}
+	cl.epoch++
+	cl.mu.Unlock()
+	cl.broadcast(ctx)
+
+	return moved, nil
+}
+
+// migrateKeys moves a batch of keys of the slots from the database db to
+// target and returns how many it moved, 0 when none are left.
+func (a *App) migrateKeys(ctx context.Context, db int, c *cache, start int, end int, target string) (int, error) {
+	cl := a.cluster
+	cl.migrateMu.Lock()
+	defer cl.migrateMu.Unlock()
+
+	var keys []string
+	var entries []replEntry
+	for _, s := range c.shards {
+		s.mu.RLock()
+		for k, i := range s.items {
+			if slot := keySlot(k); slot < start || slot > end {
+				continue
+			}
+			r, err := encodeItem(k, i)
+			if err != nil {
+				s.mu.RUnlock()
+				return 0, err
+			}
+			keys = append(keys, k)
+			entries = append(entries, replEntry{DB: db, command: command{Op: "restore", Key: k, Type: r.Type, Value: r.Value, Expired: r.Expired}})
+			if len(keys) == migrateBatch {
+				break
+			}
+		}
+		s.mu.RUnlock()
+		if len(keys) == migrateBatch {
+			break
+		}
+	}
+	if len(keys) == 0 {
+		return 0, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	b, _ := marshalJSON(entries)
+	if err := clusterPost(ctx, target+"/cluster/restore", b, nil); err != nil {
+		return 0, err
+	}
+
+	for _, k := range keys {
+		s := c.getShard(k)
+		s.mu.Lock()
+		s.deleteItem(k)
+		s.mu.Unlock()
+	}
+
+	return len(keys), nil
+}
@@ -0,0 +1,262 @@
+package app
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/iqOptionTest/simplecache/cachepb"
+	"google.golang.org/grpc/status"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// newClusterNode starts a node in cluster mode at the URL of its test
+// server.
+func newClusterNode(t *testing.T, config Config) (*App, string) {
+	var a *App
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.Router.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	config.ClusterAddr = server.URL
+	a, err := NewApp(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.Initialize()
+	t.Cleanup(a.Close)
+
+	return a, server.URL
+}
+
+var noRedirects = &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+
+// clusterRequest sends a request without following redirects and decodes
+// the response into v.
+func clusterRequest(t *testing.T, method string, url string, body string, asking bool, v interface{}) (int, string) {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if asking {
+		req.Header.Set(askingHeader, "1")
+	}
+
+	resp, err := noRedirects.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if v != nil {
+		json.NewDecoder(resp.Body).Decode(v)
+	}
+
+	return resp.StatusCode, resp.Header.Get("Location")
+}
+
+// followRedirects sends a request like a cluster client, following MOVED
+// and ASK redirects.
+func followRedirects(t *testing.T, method string, url string, body string) int {
+	asking := false
+	for i := 0; i < 5; i++ {
+		code, location := clusterRequest(t, method, url, body, asking, nil)
+		switch code {
+		case http.StatusPermanentRedirect:
+			url, asking = location, false
+		case http.StatusTemporaryRedirect:
+			url, asking = location, true
+		default:
+			return code
+		}
+	}
+
+	t.Error("Too many redirects", url)
+	return 0
+}
+
+func assignSlots(t *testing.T, node string, start int, end int, owner string) {
+	body := fmt.Sprintf(`{"start":%d,"end":%d,"node":%q}`, start, end, owner)
+	var respErr map[string]interface{}
+	if code, _ := clusterRequest(t, "POST", node+"/cluster/assign", body, false, &respErr); code != http.StatusOK {
+		t.Fatal("Slots aren't assigned", code, respErr)
+	}
+}
+
+func TestKeySlot(t *testing.T) {
+	if crc16("123456789") != 0x31c3 {
+		t.Error("CRC16 doesn't match XMODEM", crc16("123456789"))
+	}
+	if keySlot("foo") != 12182 || keySlot("bar") != 5061 {
+		t.Error("Slots don't match Redis Cluster", keySlot("foo"), keySlot("bar"))
+	}
+	if keySlot("{user1000}.following") != keySlot("{user1000}.followers") || keySlot("foo{bar}{zap}") != keySlot("bar") {
+		t.Error("Hash tags aren't hashed alone")
+	}
+	if keySlot("foo{}{bar}") != int(crc16("foo{}{bar}"))%clusterSlots || keySlot("foo{{bar}}zap") != keySlot("{bar") {
+		t.Error("Empty hash tags aren't ignored")
+	}
+}
+
+func TestCluster_Redirect(t *testing.T) {
+	a, aURL := newClusterNode(t, Config{Shards: 4})
+	b, bURL := newClusterNode(t, Config{Shards: 4})
+	assignSlots(t, aURL, 0, 8191, aURL)
+	assignSlots(t, aURL, 8192, clusterSlots-1, bURL)
+
+	if st := b.cluster.state(); st.Epoch != 2 || len(st.Slots) != 2 || st.Slots[1].Node != bURL {
+		t.Error("Slot map isn't sent to the other node", st)
+	}
+
+	// foo is in slot 12182 of b, bar in slot 5061 of a.
+	var respErr map[string]string
+	code, location := clusterRequest(t, "POST", aURL+"/set", `{"key":"foo","value":1}`, false, &respErr)
+	if code != http.StatusPermanentRedirect || location != bURL+"/set" || respErr["error"] != "MOVED 12182 "+bURL {
+		t.Error("Request for a slot of another node isn't redirected", code, location, respErr)
+	}
+	if followRedirects(t, "POST", aURL+"/set", `{"key":"foo","value":1}`) != http.StatusCreated {
+		t.Error("Redirected request fails")
+	}
+	if _, err := b.cache.get("foo"); err != nil {
+		t.Error("Key isn't set on the owner")
+	}
+	if code, _ := clusterRequest(t, "POST", aURL+"/set", `{"Key":"foo","value":1}`, false, nil); code != http.StatusPermanentRedirect {
+		t.Error("Key field isn't matched without case", code)
+	}
+	if code, _ := clusterRequest(t, "GET", bURL+"/db/0/get/bar", "", false, nil); code != http.StatusPermanentRedirect {
+		t.Error("Path key isn't routed", code)
+	}
+
+	if code, _ := clusterRequest(t, "GET", aURL+"/sinter?key=foo&key=bar", "", false, &respErr); code != http.StatusBadRequest || respErr["error"] != errCrossSlot.Error() {
+		t.Error("Keys of different slots aren't rejected", code, respErr)
+	}
+	if code, _ := clusterRequest(t, "POST", aURL+"/tx", `{"watch":{"bar":0},"commands":[{"op":"set","args":{"key":"{bar}x","value":1}}]}`, false, nil); code != http.StatusOK {
+		t.Error("Transaction on one slot isn't served", code)
+	}
+	if v, _ := a.cache.get("{bar}x"); v != json.Number("1") {
+		t.Error("Transaction isn't applied", v)
+	}
+}
+
+func TestCluster_MigrateWithoutLosingWrites(t *testing.T) {
+	a, aURL := newClusterNode(t, Config{Shards: 4, Databases: 2})
+	b, bURL := newClusterNode(t, Config{Shards: 4, Databases: 2})
+	assignSlots(t, aURL, 0, clusterSlots-1, aURL)
+
+	for i := 0; i < 250; i++ {
+		a.cache.set(fmt.Sprintf("{m}%d", i), i, 0)
+	}
+	a.dbs[1].rpush("{m}list", "x", 0)
+	a.cache.set("other", 1, 0)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			if code := followRedirects(t, "POST", aURL+"/incrby", `{"key":"{m}counter","value":1}`); code != http.StatusCreated {
+				t.Error("Write during migration fails", code)
+				return
+			}
+		}
+	}()
+
+	slot := keySlot("{m}")
+	var result map[string]interface{}
+	code, _ := clusterRequest(t, "POST", aURL+"/cluster/migrate", fmt.Sprintf(`{"start":%d,"end":%d,"node":%q}`, slot, slot, bURL), false, &result)
+	wg.Wait()
+	if code != http.StatusOK {
+		t.Fatal("Slot isn't migrated", code, result)
+	}
+
+	for i := 0; i < 100; i++ {
+		followRedirects(t, "POST", aURL+"/incrby", `{"key":"{m}counter","value":1}`)
+	}
+	if v, _ := b.cache.get("{m}counter"); v != int64(200) && v != 200.0 {
+		t.Error("Writes are lost during migration", v)
+	}
+	if b.cache.count.Load() != 251 || b.dbs[1].count.Load() != 1 {
+		t.Error("Keys aren't migrated", b.cache.count.Load(), b.dbs[1].count.Load())
+	}
+	if a.cache.count.Load() != 1 || a.dbs[1].count.Load() != 0 {
+		t.Error("Migrated keys are left on the source", a.cache.count.Load())
+	}
+	if st := b.cluster.state(); len(st.Slots) != 3 || st.Slots[1].Node != bURL {
+		t.Error("Migrated slot isn't assigned to the target", st)
+	}
+	if code, location := clusterRequest(t, "GET", aURL+"/get/{m}1", "", false, nil); code != http.StatusPermanentRedirect || location != bURL+"/get/%7Bm%7D1" {
+		t.Error("Source doesn't redirect the migrated slot", code, location)
+	}
+}
+
+func TestCluster_AskDuringMigration(t *testing.T) {
+	a, aURL := newClusterNode(t, Config{Shards: 4})
+	_, bURL := newClusterNode(t, Config{Shards: 4})
+	assignSlots(t, aURL, 0, clusterSlots-1, aURL)
+	a.cache.set("{m}old", 1, 0)
+
+	// The slot is marked as migrating, but no key is moved yet.
+	a.cluster.node(context.Background(), bURL)
+	a.cluster.broadcast(context.Background())
+	slot := keySlot("{m}")
+	clusterRequest(t, "POST", bURL+"/cluster/import", fmt.Sprintf(`{"start":%d,"end":%d,"node":%q}`, slot, slot, aURL), false, nil)
+	a.cluster.mu.Lock()
+	a.cluster.migrating[slot] = bURL
+	a.cluster.mu.Unlock()
+
+	if code, _ := clusterRequest(t, "GET", aURL+"/get/{m}old", "", false, nil); code != http.StatusOK {
+		t.Error("Key that isn't moved yet isn't served by the source", code)
+	}
+	var respErr map[string]string
+	code, location := clusterRequest(t, "POST", aURL+"/set", `{"key":"{m}new","value":1}`, false, &respErr)
+	if code != http.StatusTemporaryRedirect || location != bURL+"/set" || !strings.HasPrefix(respErr["error"], "ASK ") {
+		t.Error("Missing key isn't sent to the target with ASK", code, location, respErr)
+	}
+	if code, _ := clusterRequest(t, "POST", bURL+"/set", `{"key":"{m}new","value":1}`, false, nil); code != http.StatusPermanentRedirect {
+		t.Error("Target serves an importing slot without asking", code)
+	}
+	if code, _ := clusterRequest(t, "POST", bURL+"/set", `{"key":"{m}new","value":1}`, true, nil); code != http.StatusCreated {
+		t.Error("Target doesn't serve an asking request", code)
+	}
+	if code, _ := clusterRequest(t, "GET", aURL+"/sinter?key={m}old&key={m}new", "", false, nil); code != http.StatusServiceUnavailable {
+		t.Error("Request on keys split between nodes isn't retried", code)
+	}
+}
+
+func TestCluster_RESPAndGRPCRedirects(t *testing.T) {
+	a, aURL := newClusterNode(t, Config{Shards: 4})
+	_, bURL := newClusterNode(t, Config{Shards: 4, RESPAddr: ":7001", GRPCAddr: ":9013"})
+	assignSlots(t, aURL, 0, clusterSlots-1, bURL)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go a.serveRESP(ctx, l)
+	conn, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	c := &respClient{conn: conn, r: bufio.NewReader(conn)}
+
+	if reply, _ := c.do(t, "GET", "foo").(error); reply == nil || reply.Error() != "MOVED 12182 127.0.0.1:7001" {
+		t.Error("RESP request isn't redirected to the RESP address", reply)
+	}
+	if reply, _ := c.do(t, "DEL", "foo", "bar").(error); reply == nil || !strings.HasPrefix(reply.Error(), "CROSSSLOT") {
+		t.Error("RESP request on different slots isn't rejected", reply)
+	}
+
+	s := &grpcServer{a: a}
+	if _, err := s.Get(context.Background(), &cachepb.GetRequest{Key: "foo"}); status.Convert(err).Message() != "MOVED 12182 127.0.0.1:9013" {
+		t.Error("gRPC command isn't redirected to the gRPC address", err)
+	}
+}
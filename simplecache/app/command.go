@@ -0,0 +1,228 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// command is a mutation of the keyspace as it is written to the append-only
+// log. Expiry times are absolute, so replaying a command later gives the
+// same result.
+type command struct {
+	Op      string          `json:"op"`
+	Key     string          `json:"key"`
+	Type    string          `json:"type,omitempty"`
+	Value   json.RawMessage `json:"value,omitempty"`
+	Expired int64           `json:"expired,omitempty"`
+	Version int64           `json:"version,omitempty"`
+}
+
+// feed bumps the version of the changed key, announces the change to
+// keyspace subscribers and passes the mutation to the append-only log. It
+// must be called with the lock of the key's shard held, so the log order
+// matches the order mutations were applied in.
+func (c *cache) feed(op string, key string, value interface{}, expired int64) {
+	var version int64
+	s := c.getShard(key)
+	i, found := s.items[key]
+	if found {
+		version = c.lastVersion.Add(1)
+		i.getHeader().version = version
+	}
+	c.notify(op, key, i)
+
+	if s.unlogged || !c.logging() {
+		return
+	}
+
+	cmd := command{Op: op, Key: key, Expired: expired, Version: version}
+	if _, ok := value.(*rawValue); ok {
+		// Raw values are logged as objects, the type tells them from JSON.
+		cmd.Type = rawType
+	}
+	if value != nil {
+		b, err := marshalJSON(value)
+		if err != nil {
+			return
+		}
+		cmd.Value = b
+	}
+
+	c.log(cmd)
+}
+
+// logging reports whether mutations are written anywhere, so they aren't
+// encoded for nothing.
+func (c *cache) logging() bool {
+	return c.aof != nil || c.repl != nil && c.repl.enabled.Load() || c.batch != nil && c.batch.active()
+}
+
+// log passes a mutation to the append-only log and to followers.
+func (c *cache) log(cmd command) {
+	if c.aof != nil {
+		c.aof.append(cmd)
+	}
+	if c.repl != nil {
+		c.repl.append(c.db, cmd)
+	}
+	if c.batch != nil {
+		c.batch.add(c.db, cmd)
+	}
+}
+
+// feedItem is feed for a change that is logged with the whole item, like
+// the commands of a rewritten log.
+func (c *cache) feedItem(op string, key string, i item) {
+	version := c.lastVersion.Add(1)
+	i.getHeader().version = version
+	c.notify(op, key, i)
+
+	if !c.logging() {
+		return
+	}
+
+	r, err := encodeItem(key, i)
+	if err != nil {
+		return
+	}
+
+	c.log(command{Op: op, Key: key, Type: r.Type, Value: r.Value, Expired: r.Expired, Version: version})
+}
+
+// apply replays a logged command.
+func (c *cache) apply(cmd command) error {
+	return c.applyCommand(cmd, false)
+}
+
+// applyUnlogged is apply for a command the caller logs itself, like a
+// command of the leader on a follower. The mutations of the command
+// aren't logged again, so the log gets it once with the op and the
+// version it came with. flushdb is still logged by flush.
+func (c *cache) applyUnlogged(cmd command) error {
+	return c.applyCommand(cmd, true)
+}
+
+func (c *cache) applyCommand(cmd command, unlogged bool) error {
+	if cmd.Op == "flushdb" {
+		c.flush()
+		return nil
+	}
+
+	s := c.getShard(cmd.Key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// In raft mode the leader decided which keys are expired, so the
+	// clock of the node doesn't change the result.
+	s.replaying = c.deterministic
+	s.unlogged = unlogged
+	defer func() { s.replaying, s.unlogged = false, false }()
+
+	switch cmd.Op {
+	case "set":
+		if cmd.Type == rawType {
+			var rv rawValue
+			if err := json.Unmarshal(cmd.Value, &rv); err != nil {
+				return err
+			}
+			s.set(cmd.Key, &rv, cmd.Expired)
+			break
+		}
+		var object json.RawMessage
+		if err := json.Unmarshal(cmd.Value, &object); err != nil {
+			return err
+		}
+		s.set(cmd.Key, jsonValue(object), cmd.Expired)
+	case "append":
+		var rv rawValue
+		if err := json.Unmarshal(cmd.Value, &rv); err != nil {
+			return err
+		}
+		_, err := s.append(cmd.Key, rv.Data, rv.ContentType, cmd.Expired)
+		return err
+	case "rpush", "lpush":
+		var value json.RawMessage
+		if err := json.Unmarshal(cmd.Value, &value); err != nil {
+			return err
+		}
+		_, err := s.push(cmd.Key, []interface{}{jsonValue(value)}, cmd.Op == "lpush", cmd.Expired)
+		return err
+	case "pop", "lpop":
+		s.pop(cmd.Key, cmd.Op == "lpop")
+	case "lset", "lrem", "ltrim", "linsert":
+		var le listEdit
+		if err := json.Unmarshal(cmd.Value, &le); err != nil {
+			return err
+		}
+		switch cmd.Op {
+		case "lset":
+			return s.lset(cmd.Key, le.Index, jsonValue(le.Value))
+		case "lrem":
+			_, err := s.lrem(cmd.Key, le.Count, jsonValue(le.Value))
+			return err
+		case "ltrim":
+			return s.ltrim(cmd.Key, le.Start, le.Stop)
+		case "linsert":
+			_, err := s.linsert(cmd.Key, le.Before, jsonValue(le.Pivot), jsonValue(le.Value))
+			return err
+		}
+	case "hset":
+		value := map[string]json.RawMessage{}
+		if err := json.Unmarshal(cmd.Value, &value); err != nil {
+			return err
+		}
+		return s.hset(cmd.Key, jsonFields(value), cmd.Expired)
+	case "hdel":
+		var fields []string
+		if err := json.Unmarshal(cmd.Value, &fields); err != nil {
+			return err
+		}
+		s.hdel(cmd.Key, fields)
+	case "zadd":
+		var members []zmember
+		if err := json.Unmarshal(cmd.Value, &members); err != nil {
+			return err
+		}
+		_, err := s.zadd(cmd.Key, members, zaddFlags{}, cmd.Expired)
+		return err
+	case "zrem":
+		var members []string
+		if err := json.Unmarshal(cmd.Value, &members); err != nil {
+			return err
+		}
+		s.zrem(cmd.Key, members)
+	case "sadd":
+		var members []string
+		if err := json.Unmarshal(cmd.Value, &members); err != nil {
+			return err
+		}
+		_, err := s.sadd(cmd.Key, members, cmd.Expired)
+		return err
+	case "srem":
+		var members []string
+		if err := json.Unmarshal(cmd.Value, &members); err != nil {
+			return err
+		}
+		s.srem(cmd.Key, members)
+	case "expire":
+		if i, found := s.items[cmd.Key]; found {
+			i.getHeader().expired = cmd.Expired
+		}
+	case "del", "expired", "evicted", "move_from":
+		s.deleteItem(cmd.Key)
+	case "restore", "move_to":
+		i, err := decodeItem(snapshotRecord{Key: cmd.Key, Type: cmd.Type, Expired: cmd.Expired, Value: cmd.Value})
+		if err != nil {
+			return err
+		}
+		s.store(cmd.Key, i)
+		s.setVersion(cmd.Key, cmd.Version)
+	default:
+		return errors.New("unknown command " + cmd.Op)
+	}
+
+	if cmd.Version > 0 {
+		s.setVersion(cmd.Key, cmd.Version)
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+)
+
+// counter returns the string value at key for an increment, nil when there
+// is no such key.
+func (s *shard) counter(key string) (*simpleItem, error) {
+	item, found := s.items[key]
+	if found && s.isExpired(key, item) {
+		s.removeExpired(key)
+		found = false
+	}
+	if !found {
+		return nil, nil
+	}
+
+	si, ok := item.(*simpleItem)
+	if !ok {
+		return nil, errors.New("wrong type")
+	}
+
+	return si, nil
+}
+
+// setCounter writes the new value of a counter. A missing key is created
+// with expiry e. The expiry of an existing key is replaced with e only
+// when refresh is set.
+func (s *shard) setCounter(key string, si *simpleItem, value interface{}, e int64, refresh bool) {
+	if si == nil {
+		si = &simpleItem{header: header{expired: e}, object: value}
+		s.store(key, si)
+	} else {
+		if refresh && e > 0 {
+			si.expired = e
+		}
+		s.grow(si, sizeOf(value)-sizeOf(si.object))
+		si.object = value
+		si.touch()
+	}
+
+	s.c.feed("set", key, value, si.expired)
+}
+
+// incrby adds delta to the integer value at key and returns the result. A
+// missing key counts as zero and is created with the given duration. With
+// refresh set the duration is also applied to an existing key.
+func (c *cache) incrby(key string, delta int64, duration int, refresh bool) (int64, error) {
+	if err := c.ensureCapacity(key); err != nil {
+		return 0, err
+	}
+
+	e := c.expiration(duration)
+
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.incrby(key, delta, e, refresh)
+}
+
+func (s *shard) incrby(key string, delta int64, e int64, refresh bool) (int64, error) {
+	si, err := s.counter(key)
+	if err != nil {
+		return 0, err
+	}
+
+	var current int64
+	if si != nil {
+		var ok bool
+		if current, ok = toInt(si.object); !ok {
+			return 0, errors.New("value is not an integer or out of range")
+		}
+	}
+
+	if delta > 0 && current > math.MaxInt64-delta || delta < 0 && current < math.MinInt64-delta {
+		return 0, errors.New("increment or decrement would overflow")
+	}
+	current += delta
+	s.setCounter(key, si, current, e, refresh)
+
+	return current, nil
+}
+
+func (c *cache) incrbyfloat(key string, delta float64, duration int, refresh bool) (float64, error) {
+	if err := c.ensureCapacity(key); err != nil {
+		return 0, err
+	}
+
+	e := c.expiration(duration)
+
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.incrbyfloat(key, delta, e, refresh)
+}
+
+func (s *shard) incrbyfloat(key string, delta float64, e int64, refresh bool) (float64, error) {
+	si, err := s.counter(key)
+	if err != nil {
+		return 0, err
+	}
+
+	var current float64
+	if si != nil {
+		var ok bool
+		if current, ok = toFloat(si.object); !ok {
+			return 0, errors.New("value is not a valid float")
+		}
+	}
+
+	current += delta
+	if math.IsNaN(current) || math.IsInf(current, 0) {
+		return 0, errors.New("increment would produce NaN or Infinity")
+	}
+	s.setCounter(key, si, current, e, refresh)
+
+	return current, nil
+}
+
+// toInt converts a stored value to an integer. Whole floats are integers
+// too.
+func toInt(v interface{}) (int64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return n, true
+	case json.Number:
+		if i, err := n.Int64(); err == nil {
+			return i, true
+		}
+		f, err := n.Float64()
+		if err != nil {
+			return 0, false
+		}
+		return toInt(f)
+	case float64:
+		if n != math.Trunc(n) || n < math.MinInt64 || n >= math.MaxInt64 {
+			return 0, false
+		}
+		return int64(n), true
+	case string:
+		i, err := strconv.ParseInt(n, 10, 64)
+		return i, err == nil
+	case *rawValue:
+		return toInt(string(n.Data))
+	}
+
+	return 0, false
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil && !math.IsInf(f, 0)
+	case float64:
+		return n, true
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil && !math.IsNaN(f) && !math.IsInf(f, 0)
+	case *rawValue:
+		return toFloat(string(n.Data))
+	}
+
+	return 0, false
+}
@@ -0,0 +1,133 @@
+package app
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCache_Incrby(t *testing.T) {
+	tc := NewCache(0)
+
+	if v, err := tc.incrby("c", 1, 0, false); err != nil || v != 1 {
+		t.Error("Incr of missing key doesn't return 1", v, err)
+	}
+	if v, _ := tc.incrby("c", -5, 0, false); v != -4 {
+		t.Error("Decrby 5 doesn't return -4", v)
+	}
+	if v, _ := tc.get("c"); v != int64(-4) {
+		t.Error("Value of counter doesn't equals -4", v)
+	}
+
+	tc.set("n", 10.0, 0)
+	if v, _ := tc.incrby("n", 1, 0, false); v != 11 {
+		t.Error("Incr of a number doesn't return 11", v)
+	}
+	tc.set("s", "20", 0)
+	if v, _ := tc.incrby("s", 1, 0, false); v != 21 {
+		t.Error("Incr of a numeric string doesn't return 21", v)
+	}
+
+	tc.set("f", 1.5, 0)
+	if _, err := tc.incrby("f", 1, 0, false); err == nil {
+		t.Error("Incr of a float doesn't fail")
+	}
+	tc.set("t", "text", 0)
+	if _, err := tc.incrby("t", 1, 0, false); err == nil {
+		t.Error("Incr of a text doesn't fail")
+	}
+	tc.rpush("l", "a", 0)
+	if _, err := tc.incrby("l", 1, 0, false); err == nil {
+		t.Error("Incr of a list doesn't fail")
+	}
+
+	tc.incrby("max", 1<<62, 0, false)
+	if _, err := tc.incrby("max", 1<<62, 0, false); err == nil {
+		t.Error("Overflow of incr doesn't fail")
+	}
+}
+
+func TestCache_Incrbyfloat(t *testing.T) {
+	tc := NewCache(0)
+
+	if v, err := tc.incrbyfloat("c", 0.5, 0, false); err != nil || v != 0.5 {
+		t.Error("Incrbyfloat of missing key doesn't return 0.5", v, err)
+	}
+	tc.incrby("i", 2, 0, false)
+	if v, _ := tc.incrbyfloat("i", 0.25, 0, false); v != 2.25 {
+		t.Error("Incrbyfloat of an integer doesn't return 2.25", v)
+	}
+	tc.set("t", "text", 0)
+	if _, err := tc.incrbyfloat("t", 1, 0, false); err == nil {
+		t.Error("Incrbyfloat of a text doesn't fail")
+	}
+}
+
+func TestCache_IncrConcurrent(t *testing.T) {
+	tc := NewCache(0)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				tc.incrby("c", 1, 0, false)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if v, _ := tc.get("c"); v != int64(1000) {
+		t.Error("Concurrent increments are lost", v)
+	}
+}
+
+func TestCache_IncrTTL(t *testing.T) {
+	tc := NewCache(time.Duration(time.Hour))
+	tc.ExpiredTimeMultiplier = time.Millisecond
+
+	tc.incrby("once", 1, 30, false)
+	tc.incrby("every", 1, 30, true)
+	<-time.After(20 * time.Millisecond)
+	tc.incrby("once", 1, 30, false)
+	tc.incrby("every", 1, 30, true)
+	<-time.After(20 * time.Millisecond)
+
+	if _, err := tc.get("once"); err == nil {
+		t.Error("Increment without refresh changes TTL")
+	}
+	if v, _ := tc.get("every"); v != int64(2) {
+		t.Error("Increment with refresh doesn't extend TTL", v)
+	}
+
+	if v, _ := tc.incrby("once", 1, 0, false); v != 1 {
+		t.Error("Incr of expired counter doesn't start from 0", v)
+	}
+}
+
+func TestCache_IncrPersistence(t *testing.T) {
+	aofPath := filepath.Join(t.TempDir(), "cache.aof")
+
+	tc := NewCache(0)
+	if err := tc.enableAOF(aofPath, FsyncNever, 0); err != nil {
+		t.Fatal(err)
+	}
+	tc.incrby("c", 5, 0, false)
+	tc.incrby("c", -2, 0, false)
+	tc.incrbyfloat("f", 1.5, 0, false)
+	tc.disableAOF()
+
+	lc := NewCache(0)
+	if err := lc.replayAOF(aofPath); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := lc.incrby("c", 1, 0, false); v != 4 {
+		t.Error("Counter isn't restored", v)
+	}
+	if v, _ := lc.get("f"); v != json.Number("1.5") {
+		t.Error("Float counter isn't restored", v)
+	}
+}
@@ -0,0 +1,150 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"github.com/gorilla/mux"
+	"net/http"
+	"strconv"
+)
+
+// dbHeader selects the database of a request that has no /db/{db} path
+// prefix.
+const dbHeader = "X-Cache-DB"
+
+// dbPrefix is the path prefix that selects the database of a request.
+const dbPrefix = "/db/{db:[0-9]+}"
+
+var errNoSuchDB = errors.New("database index is out of range")
+
+type dbContextKey struct{}
+
+// dbPath returns the file of the database for a snapshot or log path. The
+// default database keeps the path itself.
+func dbPath(path string, db int) string {
+	if db == 0 {
+		return path
+	}
+
+	return path + "." + strconv.Itoa(db)
+}
+
+// parseDB returns the index of the database named s, the default database
+// when s is empty.
+func (a *App) parseDB(s string) (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	db, err := strconv.Atoi(s)
+	if err != nil || db < 0 || db >= len(a.dbs) {
+		return 0, errNoSuchDB
+	}
+
+	return db, nil
+}
+
+// selectDB passes the database chosen by the path prefix or the header on
+// in the request context.
+func (a *App) selectDB(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name, found := mux.Vars(r)["db"]
+		if !found {
+			name = r.Header.Get(dbHeader)
+		}
+
+		db, err := a.parseDB(name)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), dbContextKey{}, db)))
+	})
+}
+
+// dbIndex returns the index of the database of the request.
+func dbIndex(r *http.Request) int {
+	db, _ := r.Context().Value(dbContextKey{}).(int)
+
+	return db
+}
+
+// db returns the database of the request.
+func (a *App) db(r *http.Request) *cache {
+	return a.dbs[dbIndex(r)]
+}
+
+// flush removes every key of the cache and returns how many there were.
+func (c *cache) flush() int {
+	c.lockAll()
+	defer c.unlockAll()
+
+	return c.flushKeys()
+}
+
+// flushKeys is flush with all shards locked. Keyspace subscribers get a
+// del event for every key, while the log gets a single flushdb command.
+func (c *cache) flushKeys() int {
+	n := 0
+	for _, s := range c.shards {
+		for k, i := range s.items {
+			if !s.isExpired(k, i) {
+				c.notify("del", k, i)
+				n++
+			}
+			s.remove(k)
+		}
+	}
+
+	if c.logging() {
+		c.log(command{Op: "flushdb"})
+	}
+
+	return n
+}
+
+// moveKey moves key from the database src to dst and reports whether it
+// was moved, which it isn't when dst already has the key. The item keeps
+// its value and expiry but gets a new version in dst.
+func (a *App) moveKey(key string, src int, dst int) (bool, error) {
+	if src == dst {
+		return false, errors.New("source and destination databases are the same")
+	}
+
+	from, to := a.dbs[src], a.dbs[dst]
+	if err := to.ensureCapacity(key); err != nil {
+		return false, err
+	}
+
+	// Shards of two databases are locked in the order of the databases,
+	// so moves in opposite directions can't deadlock.
+	fs, ts := from.getShard(key), to.getShard(key)
+	if src < dst {
+		fs.mu.Lock()
+		ts.mu.Lock()
+	} else {
+		ts.mu.Lock()
+		fs.mu.Lock()
+	}
+	defer fs.mu.Unlock()
+	defer ts.mu.Unlock()
+
+	i := fs.lookupAny(key)
+	if i == nil {
+		return false, errors.New("not found")
+	}
+	if ts.lookupAny(key) != nil {
+		return false, nil
+	}
+
+	from.feed("move_from", key, nil, 0)
+	fs.remove(key)
+	ts.store(key, i)
+	to.feedItem("move_to", key, i)
+	if _, ok := i.(*listItem); ok {
+		ts.serveWaiters(key)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,230 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newDBTestServer(t *testing.T, config Config) (*App, *httptest.Server) {
+	a, err := NewApp(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.Initialize()
+
+	server := httptest.NewServer(a.Router)
+	t.Cleanup(server.Close)
+
+	return a, server
+}
+
+// dbRequest sends a request with the X-Cache-DB header set to db unless it
+// is empty and decodes the response into v.
+func dbRequest(t *testing.T, method string, url string, db string, body string, v interface{}) int {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if db != "" {
+		req.Header.Set(dbHeader, db)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if v != nil {
+		json.NewDecoder(resp.Body).Decode(v)
+	}
+
+	return resp.StatusCode
+}
+
+func TestApp_SelectDB(t *testing.T) {
+	a, server := newDBTestServer(t, Config{Shards: 4, Databases: 3})
+
+	dbRequest(t, "POST", server.URL+"/db/1/set", "", `{"key":"k","value":"one"}`, nil)
+	dbRequest(t, "POST", server.URL+"/set", "2", `{"key":"k","value":"two"}`, nil)
+
+	if _, err := a.cache.get("k"); err == nil {
+		t.Error("Key is set in the default database")
+	}
+
+	var value string
+	if code := dbRequest(t, "GET", server.URL+"/get/k", "1", "", &value); code != http.StatusOK || value != "one" {
+		t.Error("Key doesn't read by header", code, value)
+	}
+	if code := dbRequest(t, "GET", server.URL+"/db/2/get/k", "", "", &value); code != http.StatusOK || value != "two" {
+		t.Error("Key doesn't read by path prefix", code, value)
+	}
+	// The path prefix wins over the header.
+	if dbRequest(t, "GET", server.URL+"/db/0/get/k", "1", "", nil) != http.StatusNotFound {
+		t.Error("Path prefix doesn't override the header")
+	}
+
+	var respErr map[string]string
+	if code := dbRequest(t, "GET", server.URL+"/db/3/get/k", "", "", &respErr); code != http.StatusBadRequest || respErr["error"] != errNoSuchDB.Error() {
+		t.Error("Database out of range doesn't rejected", code, respErr)
+	}
+	if code := dbRequest(t, "GET", server.URL+"/get/k", "x", "", nil); code != http.StatusBadRequest {
+		t.Error("Invalid database header doesn't rejected", code)
+	}
+
+	var st stats
+	dbRequest(t, "GET", server.URL+"/db/1/stats", "", "", &st)
+	if st.Keys != 1 {
+		t.Error("Stats aren't per database", st)
+	}
+}
+
+func TestApp_FlushDBAndDBSize(t *testing.T) {
+	a, server := newDBTestServer(t, Config{Shards: 4, Databases: 2})
+
+	a.dbs[0].set("a", 1, 0)
+	a.dbs[1].set("a", 1, 0)
+	a.dbs[1].rpush("b", 1, 0)
+
+	var size int64
+	if dbRequest(t, "GET", server.URL+"/db/1/dbsize", "", "", &size); size != 2 {
+		t.Error("dbsize doesn't count the keys of the database", size)
+	}
+
+	var result map[string]interface{}
+	if code := dbRequest(t, "POST", server.URL+"/db/1/flushdb", "", "", &result); code != http.StatusOK || result["deleted"] != 2.0 {
+		t.Error("flushdb doesn't report the deleted keys", code, result)
+	}
+	if a.dbs[1].count.Load() != 0 || a.dbs[1].used.Load() != 0 {
+		t.Error("flushdb doesn't empty the database", a.dbs[1].stats())
+	}
+	if dbRequest(t, "GET", server.URL+"/dbsize", "", "", &size); size != 1 {
+		t.Error("flushdb changes another database", size)
+	}
+}
+
+func TestApp_Move(t *testing.T) {
+	a, server := newDBTestServer(t, Config{Shards: 4, Databases: 2})
+
+	a.dbs[0].hset("h", map[string]interface{}{"f": "v"}, 60)
+	_, version, _ := a.dbs[1].setCond("taken", "b", 0, setFlags{})
+	a.dbs[0].set("taken", "a", 0)
+
+	var result map[string]interface{}
+	if code := dbRequest(t, "POST", server.URL+"/move", "", `{"key":"h","db":1}`, &result); code != http.StatusCreated || result["moved"] != true {
+		t.Error("Key isn't moved", code, result)
+	}
+	if _, err := a.dbs[0].hgetall("h"); err == nil {
+		t.Error("Moved key is left in the source database")
+	}
+	if v, err := a.dbs[1].hget("h", "f"); err != nil || v != "v" {
+		t.Error("Moved key isn't in the destination database", v, err)
+	}
+	if ttl, _ := a.dbs[1].ttl("h"); ttl <= 0 {
+		t.Error("Moved key loses its expiry", ttl)
+	}
+
+	if code := dbRequest(t, "POST", server.URL+"/move", "", `{"key":"taken","db":1}`, &result); code != http.StatusOK || result["moved"] != false {
+		t.Error("Key is moved over an existing one", code, result)
+	}
+	if v, _ := a.dbs[1].get("taken"); v != "b" {
+		t.Error("Existing key is overwritten", v)
+	}
+	if v, _ := a.dbs[1].version("taken"); v != version {
+		t.Error("Existing key is changed", v, version)
+	}
+
+	if code := dbRequest(t, "POST", server.URL+"/move", "", `{"key":"missing","db":1}`, nil); code != http.StatusNotFound {
+		t.Error("Missing key doesn't return 404", code)
+	}
+	if code := dbRequest(t, "POST", server.URL+"/db/1/move", "", `{"key":"h","db":1}`, nil); code != http.StatusBadRequest {
+		t.Error("Move to the same database doesn't rejected", code)
+	}
+	if code := dbRequest(t, "POST", server.URL+"/move", "", `{"key":"h","db":5}`, nil); code != http.StatusBadRequest {
+		t.Error("Move to a missing database doesn't rejected", code)
+	}
+}
+
+func TestApp_MoveServesBlockedPop(t *testing.T) {
+	a, _ := newDBTestServer(t, Config{Shards: 4, Databases: 2})
+
+	a.dbs[0].rpush("jobs", "a", 0)
+	done := make(chan popResult)
+	go func() {
+		r, _ := a.dbs[1].bpop(context.Background(), []string{"jobs"}, true, time.Second)
+		done <- r
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	if moved, err := a.moveKey("jobs", 0, 1); err != nil || !moved {
+		t.Fatal("Key isn't moved", err)
+	}
+	if r := <-done; r.Value != "a" {
+		t.Error("Blocked pop isn't served by the moved list")
+	}
+}
+
+func TestApp_DBPersistence(t *testing.T) {
+	dir := t.TempDir()
+	config := Config{
+		Shards:       4,
+		Databases:    3,
+		SnapshotPath: filepath.Join(dir, "dump.json"),
+		AOFPath:      filepath.Join(dir, "cache.aof"),
+		AOFFsync:     FsyncAlways,
+	}
+
+	a, err := NewApp(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.dbs[0].set("s", "zero", 0)
+	a.dbs[1].set("s", "one", 0)
+	a.dbs[1].set("flushed", "one", 0)
+	a.dbs[1].flush()
+	a.dbs[2].rpush("l", "a", 0)
+	a.moveKey("l", 2, 1)
+	a.Close()
+
+	b, err := NewApp(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+
+	if v, _ := b.dbs[0].get("s"); v != "zero" {
+		t.Error("Default database isn't restored", v)
+	}
+	if _, err := b.dbs[1].get("s"); err == nil {
+		t.Error("Flushed key is restored")
+	}
+	if l, _ := b.dbs[1].lgetall("l"); len(l) != 1 {
+		t.Error("Moved key isn't restored in its new database", l)
+	}
+	if b.dbs[2].count.Load() != 0 {
+		t.Error("Moved key is restored in its old database")
+	}
+}
+
+func TestCache_TxFlushDB(t *testing.T) {
+	tc := NewCache(0)
+	tc.set("a", "string", 0)
+
+	results, err := tc.tx(nil, []txCommand{
+		{Op: "flushdb", Args: json.RawMessage(`{}`)},
+		{Op: "rpush", Args: json.RawMessage(`{"key":"a","value":1}`)},
+		{Op: "dbsize", Args: json.RawMessage(`{}`)},
+	})
+	if err != nil {
+		t.Fatal("Type check doesn't account for flushdb", err)
+	}
+	if results[2].Result != int64(1) {
+		t.Error("dbsize doesn't count after flushdb", results[2])
+	}
+}
@@ -0,0 +1,111 @@
+package app
+
+const dequeMinCapacity = 8
+
+// deque is a ring buffer of list values. Pushes and pops at both ends are
+// O(1) amortized and so is access by index.
+type deque struct {
+	buf    []interface{}
+	head   int
+	length int
+}
+
+func newDeque(values []interface{}) *deque {
+	d := &deque{}
+	d.resize(len(values))
+	copy(d.buf, values)
+	d.length = len(values)
+
+	return d
+}
+
+func (d *deque) len() int {
+	return d.length
+}
+
+func (d *deque) index(i int) int {
+	return (d.head + i) % len(d.buf)
+}
+
+func (d *deque) at(i int) interface{} {
+	return d.buf[d.index(i)]
+}
+
+func (d *deque) set(i int, value interface{}) {
+	d.buf[d.index(i)] = value
+}
+
+// resize moves the values to a new buffer that fits at least n of them.
+func (d *deque) resize(n int) {
+	size := dequeMinCapacity
+	for size < n {
+		size *= 2
+	}
+
+	buf := make([]interface{}, size)
+	for i := 0; i < d.length; i++ {
+		buf[i] = d.at(i)
+	}
+	d.buf = buf
+	d.head = 0
+}
+
+func (d *deque) grow() {
+	if d.length == len(d.buf) {
+		d.resize(d.length + 1)
+	}
+}
+
+// shrink releases the buffer when most of it is unused.
+func (d *deque) shrink() {
+	if len(d.buf) > dequeMinCapacity && d.length < len(d.buf)/4 {
+		d.resize(d.length)
+	}
+}
+
+func (d *deque) pushBack(value interface{}) {
+	d.grow()
+	d.buf[d.index(d.length)] = value
+	d.length++
+}
+
+func (d *deque) pushFront(value interface{}) {
+	d.grow()
+	d.head = (d.head - 1 + len(d.buf)) % len(d.buf)
+	d.buf[d.head] = value
+	d.length++
+}
+
+func (d *deque) popBack() interface{} {
+	i := d.index(d.length - 1)
+	value := d.buf[i]
+	d.buf[i] = nil
+	d.length--
+	d.shrink()
+
+	return value
+}
+
+func (d *deque) popFront() interface{} {
+	value := d.buf[d.head]
+	d.buf[d.head] = nil
+	d.head = (d.head + 1) % len(d.buf)
+	d.length--
+	d.shrink()
+
+	return value
+}
+
+// slice returns a copy of the values from start to stop exclusive.
+func (d *deque) slice(start int, stop int) []interface{} {
+	values := make([]interface{}, 0, stop-start)
+	for i := start; i < stop; i++ {
+		values = append(values, d.at(i))
+	}
+
+	return values
+}
+
+func (d *deque) values() []interface{} {
+	return d.slice(0, d.length)
+}
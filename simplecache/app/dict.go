@@ -0,0 +1,328 @@
+package app
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// lookupDict returns the dict at key, nil when there is no such key.
+func (s *shard) lookupDict(key string) (*dictItem, error) {
+	item, found := s.items[key]
+	if !found || s.isExpired(key, item) {
+		return nil, nil
+	}
+
+	di, ok := item.(*dictItem)
+	if !ok {
+		return nil, errors.New("wrong type")
+	}
+	di.touch()
+
+	return di, nil
+}
+
+// writableDict returns the dict at key for a field update, or a new empty
+// one with expiry e when there is no such key. The new dict isn't stored.
+func (s *shard) writableDict(key string, e int64) (*dictItem, bool, error) {
+	item, found := s.items[key]
+	if found && s.isExpired(key, item) {
+		s.removeExpired(key)
+		found = false
+	}
+
+	if !found {
+		return &dictItem{header: header{expired: e}, dictObject: map[string]interface{}{}}, false, nil
+	}
+
+	di, ok := item.(*dictItem)
+	if !ok {
+		return nil, false, errors.New("invalid object type")
+	}
+
+	return di, true, nil
+}
+
+// setField sets one field of the dict returned by writableDict, stores the
+// dict if it is new and logs the change. The expiry of an existing dict is
+// kept.
+func (s *shard) setField(key string, di *dictItem, found bool, field string, value interface{}) {
+	var delta int64
+	if old, exists := di.dictObject[field]; exists {
+		delta -= sizeOf(old)
+	} else {
+		delta += sizeOf(field)
+	}
+	delta += sizeOf(value)
+	di.dictObject[field] = value
+
+	if found {
+		s.grow(di, delta)
+		di.touch()
+	} else {
+		s.store(key, di)
+	}
+
+	s.c.feed("hset", key, map[string]interface{}{field: value}, di.expired)
+}
+
+func (c *cache) hdel(key string, fields []string) (int, error) {
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.hdel(key, fields)
+}
+
+// hdel removes the fields and returns how many of them existed. The key is
+// deleted with its last field.
+func (s *shard) hdel(key string, fields []string) (int, error) {
+	di, err := s.lookupDict(key)
+	if err != nil || di == nil {
+		return 0, err
+	}
+
+	var removed []string
+	var delta int64
+	for _, f := range fields {
+		if v, found := di.dictObject[f]; found {
+			delete(di.dictObject, f)
+			removed = append(removed, f)
+			delta -= sizeOf(f) + sizeOf(v)
+		}
+	}
+
+	if len(removed) == 0 {
+		return 0, nil
+	}
+	s.c.feed("hdel", key, removed, 0)
+
+	if len(di.dictObject) == 0 {
+		s.remove(key)
+	} else {
+		s.grow(di, delta)
+	}
+
+	return len(removed), nil
+}
+
+func (c *cache) hexists(key string, field string) (bool, error) {
+	s := c.getShard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.hexists(key, field)
+}
+
+func (s *shard) hexists(key string, field string) (bool, error) {
+	di, err := s.lookupDict(key)
+	if err != nil || di == nil {
+		return false, err
+	}
+
+	_, found := di.dictObject[field]
+
+	return found, nil
+}
+
+// hlen returns the number of fields, zero when there is no such key.
+func (c *cache) hlen(key string) (int, error) {
+	s := c.getShard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.hlen(key)
+}
+
+func (s *shard) hlen(key string) (int, error) {
+	di, err := s.lookupDict(key)
+	if err != nil || di == nil {
+		return 0, err
+	}
+
+	return len(di.dictObject), nil
+}
+
+func (s *shard) sortedFields(key string) (*dictItem, []string, error) {
+	di, err := s.lookupDict(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	if di == nil {
+		return nil, nil, errors.New("not found")
+	}
+
+	fields := make([]string, 0, len(di.dictObject))
+	for f := range di.dictObject {
+		fields = append(fields, f)
+	}
+	sort.Strings(fields)
+
+	return di, fields, nil
+}
+
+// hkeys returns the fields in sorted order.
+func (c *cache) hkeys(key string) ([]string, error) {
+	s := c.getShard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.hkeys(key)
+}
+
+func (s *shard) hkeys(key string) ([]string, error) {
+	_, fields, err := s.sortedFields(key)
+
+	return fields, err
+}
+
+// hvals returns the values ordered by their fields.
+func (c *cache) hvals(key string) ([]interface{}, error) {
+	s := c.getShard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.hvals(key)
+}
+
+func (s *shard) hvals(key string) ([]interface{}, error) {
+	di, fields, err := s.sortedFields(key)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]interface{}, len(fields))
+	for i, f := range fields {
+		values[i] = di.dictObject[f]
+	}
+
+	return values, nil
+}
+
+// hmget returns the values of the fields, nil for missing ones.
+func (c *cache) hmget(key string, fields []string) ([]interface{}, error) {
+	s := c.getShard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.hmget(key, fields)
+}
+
+func (s *shard) hmget(key string, fields []string) ([]interface{}, error) {
+	di, err := s.lookupDict(key)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]interface{}, len(fields))
+	if di == nil {
+		return values, nil
+	}
+	for i, f := range fields {
+		values[i] = di.dictObject[f]
+	}
+
+	return values, nil
+}
+
+// hsetnx sets the field only when it doesn't exist and reports whether it
+// was set. The duration is used only when the key is created.
+func (c *cache) hsetnx(key string, field string, value interface{}, duration int) (bool, error) {
+	if err := c.ensureCapacity(key); err != nil {
+		return false, err
+	}
+
+	e := c.expiration(duration)
+
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.hsetnx(key, field, value, e)
+}
+
+func (s *shard) hsetnx(key string, field string, value interface{}, e int64) (bool, error) {
+	di, found, err := s.writableDict(key, e)
+	if err != nil {
+		return false, err
+	}
+
+	if _, exists := di.dictObject[field]; exists {
+		return false, nil
+	}
+	s.setField(key, di, found, field, value)
+
+	return true, nil
+}
+
+func (c *cache) hincrby(key string, field string, delta int64) (int64, error) {
+	if err := c.ensureCapacity(key); err != nil {
+		return 0, err
+	}
+
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.hincrby(key, field, delta)
+}
+
+// hincrby adds delta to the integer value of the field. A missing field
+// or key counts as zero.
+func (s *shard) hincrby(key string, field string, delta int64) (int64, error) {
+	di, found, err := s.writableDict(key, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	var current int64
+	if v, exists := di.dictObject[field]; exists {
+		var ok bool
+		if current, ok = toInt(v); !ok {
+			return 0, errors.New("hash value is not an integer")
+		}
+	}
+
+	if delta > 0 && current > math.MaxInt64-delta || delta < 0 && current < math.MinInt64-delta {
+		return 0, errors.New("increment or decrement would overflow")
+	}
+	current += delta
+	s.setField(key, di, found, field, current)
+
+	return current, nil
+}
+
+func (c *cache) hincrbyfloat(key string, field string, delta float64) (float64, error) {
+	if err := c.ensureCapacity(key); err != nil {
+		return 0, err
+	}
+
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.hincrbyfloat(key, field, delta)
+}
+
+func (s *shard) hincrbyfloat(key string, field string, delta float64) (float64, error) {
+	di, found, err := s.writableDict(key, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	var current float64
+	if v, exists := di.dictObject[field]; exists {
+		var ok bool
+		if current, ok = toFloat(v); !ok {
+			return 0, errors.New("hash value is not a float")
+		}
+	}
+
+	current += delta
+	if math.IsNaN(current) || math.IsInf(current, 0) {
+		return 0, errors.New("increment would produce NaN or Infinity")
+	}
+	s.setField(key, di, found, field, current)
+
+	return current, nil
+}
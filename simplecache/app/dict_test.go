@@ -0,0 +1,166 @@
+package app
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCache_Hdel(t *testing.T) {
+	tc := NewCache(0)
+	key := "h"
+	tc.hset(key, map[string]interface{}{"a": 1.0, "b": "2", "c": true}, 0)
+
+	if removed, _ := tc.hdel(key, []string{"a", "b", "x"}); removed != 2 {
+		t.Error("Hdel doesn't remove 2 fields", removed)
+	}
+	if found, _ := tc.hexists(key, "a"); found {
+		t.Error("Deleted field still exists")
+	}
+	if found, _ := tc.hexists(key, "c"); !found {
+		t.Error("Hexists doesn't find c")
+	}
+	if length, _ := tc.hlen(key); length != 1 {
+		t.Error("Hlen doesn't equals 1", length)
+	}
+
+	tc.hdel(key, []string{"c"})
+	if _, err := tc.hgetall(key); err == nil {
+		t.Error("Empty dict wasn't deleted")
+	}
+	if tc.stats().UsedMemory != 0 {
+		t.Error("Memory of deleted dict isn't released", tc.stats().UsedMemory)
+	}
+
+	tc.set("s", "string", 0)
+	if _, err := tc.hdel("s", []string{"a"}); err == nil {
+		t.Error("Hdel of a string doesn't fail")
+	}
+}
+
+func TestCache_HkeysAndHvals(t *testing.T) {
+	tc := NewCache(0)
+	key := "h"
+	tc.hset(key, map[string]interface{}{"b": 2.0, "a": 1.0, "c": 3.0}, 0)
+
+	if fields, _ := tc.hkeys(key); !reflect.DeepEqual(fields, []string{"a", "b", "c"}) {
+		t.Error("Hkeys is wrong", fields)
+	}
+	if values, _ := tc.hvals(key); !reflect.DeepEqual(values, []interface{}{1.0, 2.0, 3.0}) {
+		t.Error("Hvals is wrong", values)
+	}
+	if values, _ := tc.hmget(key, []string{"c", "x", "a"}); !reflect.DeepEqual(values, []interface{}{3.0, nil, 1.0}) {
+		t.Error("Hmget is wrong", values)
+	}
+	if values, _ := tc.hmget("missing", []string{"a"}); !reflect.DeepEqual(values, []interface{}{nil}) {
+		t.Error("Hmget of missing key is wrong", values)
+	}
+	if _, err := tc.hkeys("missing"); err == nil {
+		t.Error("Hkeys of missing key doesn't fail")
+	}
+}
+
+func TestCache_Hsetnx(t *testing.T) {
+	tc := NewCache(0)
+	key := "h"
+
+	if set, _ := tc.hsetnx(key, "a", "1", 0); !set {
+		t.Error("Hsetnx doesn't create a dict")
+	}
+	if set, _ := tc.hsetnx(key, "a", "2", 0); set {
+		t.Error("Hsetnx overwrites existing field")
+	}
+	if v, _ := tc.hget(key, "a"); v != "1" {
+		t.Error("Value of a doesn't equals 1", v)
+	}
+}
+
+func TestCache_Hincrby(t *testing.T) {
+	tc := NewCache(0)
+	key := "h"
+	tc.hset(key, map[string]interface{}{"n": 10.0, "s": "5", "f": 1.5, "x": "text"}, 0)
+
+	if v, err := tc.hincrby(key, "n", 5); err != nil || v != 15 {
+		t.Error("Hincrby n 5 doesn't return 15", v, err)
+	}
+	if v, _ := tc.hincrby(key, "s", -10); v != -5 {
+		t.Error("Hincrby of a string number doesn't return -5", v)
+	}
+	if v, _ := tc.hincrby(key, "new", 3); v != 3 {
+		t.Error("Hincrby of missing field doesn't start from 0", v)
+	}
+	if _, err := tc.hincrby(key, "f", 1); err == nil {
+		t.Error("Hincrby of a float doesn't fail")
+	}
+	if _, err := tc.hincrby(key, "x", 1); err == nil {
+		t.Error("Hincrby of a text doesn't fail")
+	}
+
+	if v, err := tc.hincrbyfloat(key, "f", 0.25); err != nil || v != 1.75 {
+		t.Error("Hincrbyfloat f 0.25 doesn't return 1.75", v, err)
+	}
+	if v, _ := tc.hincrbyfloat(key, "n", 0.5); v != 15.5 {
+		t.Error("Hincrbyfloat of an integer doesn't return 15.5", v)
+	}
+
+	tc.hincrby(key, "max", 1<<62)
+	if _, err := tc.hincrby(key, "max", 1<<62); err == nil {
+		t.Error("Overflow of hincrby doesn't fail")
+	}
+}
+
+func TestCache_DictFieldsKeepTTL(t *testing.T) {
+	tc := NewCache(time.Duration(time.Hour))
+	tc.ExpiredTimeMultiplier = time.Millisecond
+	key := "h"
+	tc.hset(key, map[string]interface{}{"a": 1.0, "b": 2.0}, 30)
+
+	tc.hdel(key, []string{"a"})
+	tc.hsetnx(key, "c", 3.0, 0)
+	tc.hincrby(key, "b", 1)
+	tc.hincrbyfloat(key, "d", 1)
+
+	<-time.After(40 * time.Millisecond)
+
+	if _, err := tc.hgetall(key); err == nil {
+		t.Error("Field operations reset TTL of the dict")
+	}
+
+	if v, _ := tc.hincrby(key, "b", 1); v != 1 {
+		t.Error("Hincrby of expired dict doesn't start from 0", v)
+	}
+}
+
+func TestCache_DictPersistence(t *testing.T) {
+	dir := t.TempDir()
+	aofPath := filepath.Join(dir, "cache.aof")
+	snapshotPath := filepath.Join(dir, "dump.json")
+
+	tc := NewCache(0)
+	if err := tc.enableAOF(aofPath, FsyncNever, 0); err != nil {
+		t.Fatal(err)
+	}
+	tc.hset("h", map[string]interface{}{"a": "1", "b": "2"}, 0)
+	tc.hdel("h", []string{"a"})
+	tc.hsetnx("h", "c", "3", 0)
+	tc.hincrby("h", "n", 7)
+	tc.saveSnapshot(snapshotPath)
+	tc.disableAOF()
+
+	expected := map[string]interface{}{"b": "2", "c": "3", "n": json.Number("7")}
+	for _, load := range []func(*cache) error{
+		func(c *cache) error { return c.replayAOF(aofPath) },
+		func(c *cache) error { return c.loadSnapshot(snapshotPath) },
+	} {
+		lc := NewCache(0)
+		if err := load(lc); err != nil {
+			t.Fatal(err)
+		}
+
+		if object, _ := lc.hgetall("h"); !reflect.DeepEqual(object, expected) {
+			t.Error("Dict isn't restored", object)
+		}
+	}
+}
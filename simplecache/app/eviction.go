@@ -0,0 +1,201 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"math/rand"
+)
+
+const (
+	NoEviction    = "noeviction"
+	AllKeysLRU    = "allkeys-lru"
+	AllKeysLFU    = "allkeys-lfu"
+	AllKeysRandom = "allkeys-random"
+	VolatileLRU   = "volatile-lru"
+	VolatileTTL   = "volatile-ttl"
+)
+
+var defaultEvictionSamples = 5
+
+var errOutOfMemory = errors.New("memory limit is reached, write is rejected")
+
+// Rough sizes in bytes used to estimate the memory taken by items.
+const (
+	itemOverhead  = 64
+	valueOverhead = 16
+)
+
+func sizeOf(v interface{}) int64 {
+	switch o := v.(type) {
+	case nil:
+		return valueOverhead
+	case string:
+		return valueOverhead + int64(len(o))
+	case json.Number:
+		return valueOverhead + int64(len(o))
+	case json.RawMessage:
+		return valueOverhead + int64(len(o))
+	case *rawValue:
+		return valueOverhead + int64(len(o.Data)+len(o.ContentType))
+	case []interface{}:
+		size := int64(valueOverhead + 24)
+		for _, e := range o {
+			size += sizeOf(e)
+		}
+		return size
+	case map[string]interface{}:
+		size := int64(valueOverhead + 48)
+		for k, e := range o {
+			size += sizeOf(k) + sizeOf(e)
+		}
+		return size
+	}
+
+	return valueOverhead + 8
+}
+
+func itemSize(key string, i item) int64 {
+	size := int64(itemOverhead + len(key))
+	switch v := i.(type) {
+	case *simpleItem:
+		size += sizeOf(v.object)
+	case *listItem:
+		size += valueOverhead + 24
+		for i := 0; i < v.listObject.len(); i++ {
+			size += sizeOf(v.listObject.at(i))
+		}
+	case *dictItem:
+		size += sizeOf(v.dictObject)
+	case *zsetItem:
+		for m := range v.dict {
+			size += sizeOfZmember(m)
+		}
+	case *setItem:
+		for m := range v.setObject {
+			size += sizeOf(m)
+		}
+	}
+
+	return size
+}
+
+func (c *cache) overLimit(key string) bool {
+	if c.MaxMemory > 0 && c.used.Load() > c.MaxMemory {
+		return true
+	}
+
+	return c.MaxKeys > 0 && c.count.Load() >= c.MaxKeys && !c.exists(key)
+}
+
+func (c *cache) exists(key string) bool {
+	s := c.getShard(key)
+	s.mu.RLock()
+	_, found := s.items[key]
+	s.mu.RUnlock()
+
+	return found
+}
+
+// ensureCapacity evicts keys until a write to key fits into the limits.
+// It is called before the write without any lock held, so the limits are
+// approximate under concurrent writes. The key being written is never
+// evicted.
+func (c *cache) ensureCapacity(key string) error {
+	for c.overLimit(key) {
+		if c.EvictionPolicy == NoEviction || !c.evictOne(key) {
+			c.rejectedWrites.Add(1)
+			return errOutOfMemory
+		}
+	}
+
+	return nil
+}
+
+type evictionCandidate struct {
+	key  string
+	item item
+}
+
+// better reports whether a should be evicted before b.
+func (c *cache) better(a item, b item) bool {
+	ha, hb := a.getHeader(), b.getHeader()
+	switch c.EvictionPolicy {
+	case AllKeysLFU:
+		if ha.hits.Load() != hb.hits.Load() {
+			return ha.hits.Load() < hb.hits.Load()
+		}
+	case VolatileTTL:
+		return ha.expired < hb.expired
+	}
+
+	return ha.atime.Load() < hb.atime.Load()
+}
+
+// evictOne removes one key chosen by the eviction policy. Every shard is
+// sampled for up to EvictionSamples keys and the best of the samples is
+// evicted, so the choice is exact only for small shards.
+func (c *cache) evictOne(protect string) bool {
+	volatile := c.EvictionPolicy == VolatileLRU || c.EvictionPolicy == VolatileTTL
+
+	var best *evictionCandidate
+	start := rand.Intn(len(c.shards))
+	for n := range c.shards {
+		s := c.shards[(start+n)%len(c.shards)]
+		s.mu.RLock()
+		samples := 0
+		for k, v := range s.items {
+			if k == protect || volatile && v.getExpired() == 0 {
+				continue
+			}
+			if best == nil || c.EvictionPolicy != AllKeysRandom && c.better(v, best.item) {
+				best = &evictionCandidate{key: k, item: v}
+			}
+			samples++
+			if samples >= c.EvictionSamples || c.EvictionPolicy == AllKeysRandom {
+				break
+			}
+		}
+		s.mu.RUnlock()
+
+		if best != nil && c.EvictionPolicy == AllKeysRandom {
+			break
+		}
+	}
+
+	if best == nil {
+		return false
+	}
+
+	s := c.getShard(best.key)
+	s.mu.Lock()
+	if s.items[best.key] == best.item {
+		s.c.feed("evicted", best.key, nil, 0)
+		s.remove(best.key)
+		c.evicted.Add(1)
+	}
+	s.mu.Unlock()
+
+	return true
+}
+
+type stats struct {
+	Keys           int64  `json:"keys"`
+	UsedMemory     int64  `json:"used_memory"`
+	MaxMemory      int64  `json:"max_memory"`
+	MaxKeys        int64  `json:"max_keys"`
+	EvictionPolicy string `json:"eviction_policy"`
+	EvictedKeys    int64  `json:"evicted_keys"`
+	RejectedWrites int64  `json:"rejected_writes"`
+}
+
+func (c *cache) stats() stats {
+	return stats{
+		Keys:           c.count.Load(),
+		UsedMemory:     c.used.Load(),
+		MaxMemory:      c.MaxMemory,
+		MaxKeys:        c.MaxKeys,
+		EvictionPolicy: c.EvictionPolicy,
+		EvictedKeys:    c.evicted.Load(),
+		RejectedWrites: c.rejectedWrites.Load(),
+	}
+}
@@ -0,0 +1,192 @@
+package app
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func newLimitedCache(policy string) *cache {
+	tc := NewCache(0)
+	tc.MaxKeys = 3
+	tc.EvictionPolicy = policy
+	tc.EvictionSamples = 100
+
+	return tc
+}
+
+// access reads keys one by one, so every key gets its own access time.
+func access(tc *cache, keys ...string) {
+	for _, k := range keys {
+		<-time.After(time.Millisecond)
+		tc.get(k)
+	}
+}
+
+func checkKeys(t *testing.T, tc *cache, present []string, absent []string) {
+	for _, k := range present {
+		if _, err := tc.get(k); err != nil {
+			t.Error("Can't find key that should exist", k)
+		}
+	}
+	for _, k := range absent {
+		if _, err := tc.get(k); err == nil {
+			t.Error("Found key that should have been evicted", k)
+		}
+	}
+}
+
+func TestCache_EvictionLRU(t *testing.T) {
+	tc := newLimitedCache(AllKeysLRU)
+	tc.set("a", 1, 0)
+	tc.set("b", 2, 0)
+	tc.set("c", 3, 0)
+	access(tc, "a", "b", "c", "a")
+
+	if _, err := tc.set("d", 4, 0); err != nil {
+		t.Fatal("Can't set d", err)
+	}
+
+	checkKeys(t, tc, []string{"a", "c", "d"}, []string{"b"})
+
+	if tc.stats().EvictedKeys != 1 {
+		t.Error("Evicted keys counter doesn't equals 1", tc.stats().EvictedKeys)
+	}
+}
+
+func TestCache_EvictionLFU(t *testing.T) {
+	tc := newLimitedCache(AllKeysLFU)
+	tc.set("a", 1, 0)
+	tc.set("b", 2, 0)
+	tc.set("c", 3, 0)
+	access(tc, "a", "a", "a", "b", "b", "c")
+
+	tc.set("d", 4, 0)
+
+	checkKeys(t, tc, []string{"a", "b", "d"}, []string{"c"})
+}
+
+func TestCache_EvictionRandom(t *testing.T) {
+	tc := newLimitedCache(AllKeysRandom)
+	tc.set("a", 1, 0)
+	tc.set("b", 2, 0)
+	tc.set("c", 3, 0)
+
+	if _, err := tc.set("d", 4, 0); err != nil {
+		t.Fatal("Can't set d", err)
+	}
+
+	checkKeys(t, tc, []string{"d"}, nil)
+	if len(tc.keys()) != 3 {
+		t.Error("Length keys doesn't equals 3", tc.keys())
+	}
+	if tc.stats().EvictedKeys != 1 {
+		t.Error("Evicted keys counter doesn't equals 1", tc.stats().EvictedKeys)
+	}
+}
+
+func TestCache_EvictionVolatileLRU(t *testing.T) {
+	tc := newLimitedCache(VolatileLRU)
+	tc.set("a", 1, 0)
+	tc.set("b", 2, 1000)
+	tc.set("c", 3, 1000)
+	access(tc, "c", "b", "a")
+
+	tc.set("d", 4, 0)
+	checkKeys(t, tc, []string{"a", "b", "d"}, []string{"c"})
+
+	tc.set("e", 5, 0)
+	checkKeys(t, tc, []string{"a", "d", "e"}, []string{"b"})
+
+	if _, err := tc.set("f", 6, 0); err != errOutOfMemory {
+		t.Error("Set doesn't fail when there are no keys with expiry to evict", err)
+	}
+}
+
+func TestCache_EvictionVolatileTTL(t *testing.T) {
+	tc := newLimitedCache(VolatileTTL)
+	tc.set("a", 1, 0)
+	tc.set("b", 2, 1000)
+	tc.set("c", 3, 500)
+	access(tc, "c", "b")
+
+	tc.set("d", 4, 0)
+	checkKeys(t, tc, []string{"a", "b", "d"}, []string{"c"})
+}
+
+func TestCache_NoEviction(t *testing.T) {
+	tc := newLimitedCache(NoEviction)
+	tc.set("a", 1, 0)
+	tc.set("b", 2, 0)
+	tc.rpush("c", 3, 0)
+
+	if _, err := tc.set("d", 4, 0); err != errOutOfMemory {
+		t.Error("Set of a new key doesn't fail", err)
+	}
+	if _, err := tc.rpush("d", 4, 0); err != errOutOfMemory {
+		t.Error("Rpush of a new key doesn't fail", err)
+	}
+	if err := tc.hset("d", map[string]interface{}{"a": 4}, 0); err != errOutOfMemory {
+		t.Error("Hset of a new key doesn't fail", err)
+	}
+
+	if _, err := tc.set("a", 5, 0); err != nil {
+		t.Error("Overwriting an existing key fails", err)
+	}
+	if _, err := tc.rpush("c", 4, 0); err != nil {
+		t.Error("Pushing to an existing list fails", err)
+	}
+
+	checkKeys(t, tc, []string{"a", "b"}, []string{"d"})
+	if tc.stats().RejectedWrites != 3 {
+		t.Error("Rejected writes counter doesn't equals 3", tc.stats().RejectedWrites)
+	}
+}
+
+func TestCache_MaxMemory(t *testing.T) {
+	tc := NewCache(0)
+	tc.MaxMemory = 10000
+	tc.EvictionPolicy = AllKeysLRU
+	tc.EvictionSamples = 100
+
+	value := strings.Repeat("x", 1000)
+	for _, k := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l"} {
+		<-time.After(time.Millisecond)
+		if _, err := tc.set(k, value, 0); err != nil {
+			t.Fatal("Can't set", k, err)
+		}
+	}
+
+	used := tc.stats().UsedMemory
+	if used > tc.MaxMemory+2000 {
+		t.Error("Used memory is far above the limit", used)
+	}
+	checkKeys(t, tc, []string{"l"}, []string{"a"})
+
+	for _, k := range tc.keys() {
+		tc.deleteItem(k)
+	}
+	if tc.stats().UsedMemory != 0 || tc.stats().Keys != 0 {
+		t.Error("Memory isn't released when keys are deleted", tc.stats())
+	}
+}
+
+func TestCache_MemoryAccounting(t *testing.T) {
+	tc := NewCache(0)
+	tc.rpush("l", "aaaa", 0)
+	tc.rpush("l", "bbbb", 0)
+	tc.pop("l")
+	tc.hset("h", map[string]interface{}{"name": "Igor"}, 0)
+	tc.hset("h", map[string]interface{}{"name": "Igor Krasov"}, 0)
+
+	var expected int64
+	for _, s := range tc.shards {
+		for k, v := range s.items {
+			expected += itemSize(k, v)
+		}
+	}
+
+	if tc.stats().UsedMemory != expected {
+		t.Error("Used memory doesn't match the size of items", tc.stats().UsedMemory, expected)
+	}
+}
@@ -0,0 +1,115 @@
+package app
+
+import (
+	"unicode/utf8"
+)
+
+// globMatch reports whether name matches the glob pattern. It supports
+// `*`, `?`, character classes like `[abc]`, `[^a]` and `[a-z]`, and `\` to
+// escape a special character. Unlike path.Match, `*` also matches `/`.
+func globMatch(pattern string, name string) bool {
+	px, nx := 0, 0
+	// The last star and where in name it should end on the next retry, when
+	// the rest of the pattern doesn't match.
+	starPx, starNx := -1, -1
+
+	for px < len(pattern) || nx < len(name) {
+		if px < len(pattern) {
+			switch pattern[px] {
+			case '*':
+				starPx, starNx = px, nx+runeLen(name, nx)
+				px++
+				continue
+			case '?':
+				if nx < len(name) {
+					px++
+					nx += runeLen(name, nx)
+					continue
+				}
+			case '[':
+				if nx < len(name) {
+					r, n := utf8.DecodeRuneInString(name[nx:])
+					if matched, end := matchClass(pattern, px, r); matched {
+						px = end
+						nx += n
+						continue
+					}
+				}
+			case '\\':
+				if px+1 < len(pattern) && nx < len(name) && name[nx] == pattern[px+1] {
+					px += 2
+					nx++
+					continue
+				}
+			default:
+				if nx < len(name) && name[nx] == pattern[px] {
+					px++
+					nx++
+					continue
+				}
+			}
+		}
+
+		if starPx >= 0 && starNx <= len(name) {
+			px, nx = starPx+1, starNx
+			starNx += runeLen(name, starNx)
+			continue
+		}
+
+		return false
+	}
+
+	return true
+}
+
+func runeLen(s string, i int) int {
+	if i >= len(s) {
+		return 1
+	}
+	_, n := utf8.DecodeRuneInString(s[i:])
+
+	return n
+}
+
+// matchClass matches r against the class starting with `[` at px and
+// returns the position after the class. An unterminated class matches a
+// literal `[`.
+func matchClass(pattern string, px int, r rune) (bool, int) {
+	i := px + 1
+	negate := i < len(pattern) && pattern[i] == '^'
+	if negate {
+		i++
+	}
+
+	matched := false
+	for first := true; i < len(pattern); first = false {
+		if pattern[i] == ']' && !first {
+			return matched != negate, i + 1
+		}
+
+		lo, n := classRune(pattern, i)
+		i += n
+		hi := lo
+		if i+1 < len(pattern) && pattern[i] == '-' && pattern[i+1] != ']' {
+			hi, n = classRune(pattern, i+1)
+			i += 1 + n
+		}
+		if lo > hi {
+			lo, hi = hi, lo
+		}
+		if lo <= r && r <= hi {
+			matched = true
+		}
+	}
+
+	return r == '[', px + 1
+}
+
+func classRune(pattern string, i int) (rune, int) {
+	if pattern[i] == '\\' && i+1 < len(pattern) {
+		r, n := utf8.DecodeRuneInString(pattern[i+1:])
+		return r, n + 1
+	}
+
+	return utf8.DecodeRuneInString(pattern[i:])
+}
@@ -0,0 +1,477 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/iqOptionTest/simplecache/cachepb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"strconv"
+	"time"
+)
+
+// grpcServer serves the gRPC API, see cachepb/cache.proto. The request of
+// an operation is converted to the args of its command, see grpcOps, and
+// goes through the same parser as the commands of /tx.
+type grpcServer struct {
+	cachepb.UnimplementedCacheServer
+	a *App
+}
+
+func (a *App) newGRPCServer() *grpc.Server {
+	server := grpc.NewServer()
+	cachepb.RegisterCacheServer(server, &grpcServer{a: a})
+
+	return server
+}
+
+// db returns the database selected by the x-cache-db metadata of a call,
+// like the header of an HTTP request.
+func (s *grpcServer) db(ctx context.Context) (int, error) {
+	var name string
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(dbHeader); len(values) > 0 {
+		name = values[0]
+	}
+
+	db, err := s.a.parseDB(name)
+	if err != nil {
+		return 0, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return db, nil
+}
+
+// route applies the slot map of the cluster to a call on keys of c, see
+// cluster.route. A redirect is returned as an error with the address of
+// the gRPC API of the node. The x-cache-asking metadata marks a call
+// redirected by ASK.
+func (s *grpcServer) route(ctx context.Context, c *cache, keys []string) (func(), error) {
+	if s.a.cluster == nil {
+		return func() {}, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	redirect, done, err := s.a.cluster.route(keys, len(md.Get(askingHeader)) > 0, c.exists)
+	if err != nil {
+		return nil, err
+	}
+	if redirect != nil {
+		addr := redirect.node.GRPC
+		if addr == "" {
+			addr = redirect.node.Addr
+		}
+		return nil, errors.New(redirect.message(addr))
+	}
+
+	return done, nil
+}
+
+// call runs the operation op of a unary RPC in the database of the call.
+// The result has the response in the field named op.
+func (s *grpcServer) call(ctx context.Context, op string, req proto.Message) (*cachepb.Result, error) {
+	db, err := s.db(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.run(ctx, db, op, req)
+}
+
+func (s *grpcServer) Batch(stream cachepb.Cache_BatchServer) error {
+	db, err := s.db(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	for {
+		cmd, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		op, req := commandOp(cmd)
+		result, err := s.run(stream.Context(), db, op, req)
+		if err != nil {
+			st := status.Convert(err)
+			result = &cachepb.Result{Error: st.Message(), Code: int32(st.Code())}
+		}
+		if err := stream.Send(result); err != nil {
+			return err
+		}
+	}
+}
+
+// run runs the operation op in the database db. The errors are statuses.
+func (s *grpcServer) run(ctx context.Context, db int, op string, req proto.Message) (*cachepb.Result, error) {
+	o, found := grpcOps[op]
+	if !found || req == nil {
+		return nil, status.Error(codes.InvalidArgument, "unknown command "+op)
+	}
+	args, err := o.args(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cmd := txCommand{Op: op, args: args}
+
+	if err := s.a.readOnly(op); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	done, err := s.route(ctx, s.a.dbs[db], messageKeys(req))
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	defer done()
+
+	var result interface{}
+	if s.a.raft != nil && writeOps[op] {
+		if op == "blpop" || op == "brpop" {
+			return nil, status.Error(codes.FailedPrecondition, errRaftBlocking.Error())
+		}
+		reply, raftErr := s.a.resolveRaft(ctx, func() interface{} {
+			result, err := s.exec(ctx, db, cmd, req)
+			return grpcReply{result: result, err: err}
+		})
+		if raftErr != nil {
+			return nil, status.Error(codes.Unavailable, raftMessage(raftErr, grpcLeaderAddr))
+		}
+		result, err = reply.(grpcReply).result, reply.(grpcReply).err
+	} else {
+		result, err = s.exec(ctx, db, cmd, req)
+	}
+	if err != nil {
+		return nil, grpcError(err, codes.FailedPrecondition)
+	}
+
+	return opResult(op, o.response(result)), nil
+}
+
+// grpcReply is the result of a command resolved by the raft leader.
+type grpcReply struct {
+	result interface{}
+	err    error
+}
+
+// exec runs a command of the database db.
+func (s *grpcServer) exec(ctx context.Context, db int, cmd txCommand, req proto.Message) (interface{}, error) {
+	c := s.a.dbs[db]
+	switch cmd.Op {
+	case "move":
+		mo := cmd.args.(moveObject)
+		if mo.DB < 0 || mo.DB >= len(s.a.dbs) {
+			return nil, errNoSuchDB
+		}
+		moved, err := s.a.moveKey(mo.Key, db, mo.DB)
+		return txSuccess("moved", moved), err
+	case "blpop", "brpop":
+		// Unlike in a transaction, blocking pops wait here.
+		bo := req.(interface {
+			GetKeys() []string
+			GetTimeout() float64
+		})
+		if bo.GetTimeout() < 0 {
+			return nil, errors.New("timeout is not a positive number")
+		}
+		return c.bpop(ctx, bo.GetKeys(), cmd.Op == "blpop", time.Duration(bo.GetTimeout()*float64(time.Second)))
+	}
+
+	return c.do(cmd)
+}
+
+var (
+	commandOps   = (&cachepb.Command{}).ProtoReflect().Descriptor().Oneofs().ByName("op")
+	resultFields = (&cachepb.Result{}).ProtoReflect().Descriptor().Fields()
+)
+
+// commandOp returns the operation of a command of a batch or a
+// transaction and its request. The fields of the command are named like
+// the operations.
+func commandOp(cmd *cachepb.Command) (string, proto.Message) {
+	m := cmd.ProtoReflect()
+	field := m.WhichOneof(commandOps)
+	if field == nil {
+		return "", nil
+	}
+
+	return string(field.Name()), m.Get(field).Message().Interface()
+}
+
+// opResult returns the result with the response of the operation op.
+func opResult(op string, response proto.Message) *cachepb.Result {
+	result := &cachepb.Result{}
+	result.ProtoReflect().Set(resultFields.ByName(protoreflect.Name(op)), protoreflect.ValueOfMessage(response.ProtoReflect()))
+
+	return result
+}
+
+// messageKeys returns the keys named by a request: key, keys and
+// destination.
+func messageKeys(req proto.Message) []string {
+	var keys []string
+	if r, ok := req.(interface{ GetKey() string }); ok {
+		keys = append(keys, r.GetKey())
+	}
+	if r, ok := req.(interface{ GetDestination() string }); ok {
+		keys = append(keys, r.GetDestination())
+	}
+	if r, ok := req.(interface{ GetKeys() []string }); ok {
+		keys = append(keys, r.GetKeys()...)
+	}
+
+	return keys
+}
+
+func (s *grpcServer) Tx(ctx context.Context, req *cachepb.TxRequest) (*cachepb.TxResponse, error) {
+	commands := make([]txCommand, len(req.Commands))
+	ops := make([]grpcOp, len(req.Commands))
+	var keys []string
+	for key := range req.Watch {
+		keys = append(keys, key)
+	}
+	for i, cmd := range req.Commands {
+		op, r := commandOp(cmd)
+		o, found := grpcOps[op]
+		if !found {
+			return nil, status.Error(codes.FailedPrecondition, "command "+strconv.Itoa(i)+": unknown command "+op)
+		}
+		args, err := o.args(r)
+		if err != nil {
+			return nil, status.Error(codes.FailedPrecondition, "command "+strconv.Itoa(i)+": "+err.Error())
+		}
+		commands[i], ops[i] = txCommand{Op: op, args: args}, o
+		keys = append(keys, messageKeys(r)...)
+	}
+
+	db, err := s.db(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.a.txReadOnly(commands); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	done, err := s.route(ctx, s.a.dbs[db], keys)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	defer done()
+
+	var results []txResult
+	if s.a.raft != nil {
+		result, raftErr := s.a.resolveRaft(ctx, func() interface{} {
+			results, err := s.a.dbs[db].tx(req.Watch, commands)
+			return raftTxResult{results: results, err: err}
+		})
+		if raftErr != nil {
+			return nil, status.Error(codes.Unavailable, raftMessage(raftErr, grpcLeaderAddr))
+		}
+		tr := result.(raftTxResult)
+		results, err = tr.results, tr.err
+	} else {
+		results, err = s.a.dbs[db].tx(req.Watch, commands)
+	}
+	switch {
+	case err == errWatchConflict:
+		return nil, status.Error(codes.Aborted, err.Error())
+	case err != nil:
+		return nil, grpcError(err, codes.FailedPrecondition)
+	}
+
+	response := &cachepb.TxResponse{Results: make([]*cachepb.Result, len(results))}
+	for i, result := range results {
+		if result.Error != "" {
+			response.Results[i] = &cachepb.Result{Error: result.Error, Code: int32(grpcCode(errors.New(result.Error), codes.FailedPrecondition))}
+		} else {
+			response.Results[i] = opResult(commands[i].Op, ops[i].response(result.Result))
+		}
+	}
+
+	return response, nil
+}
+
+// grpcError returns the status of an error, with code for the errors
+// without a code of their own.
+func grpcError(err error, code codes.Code) error {
+	return status.Error(grpcCode(err, code), err.Error())
+}
+
+func grpcCode(err error, code codes.Code) codes.Code {
+	switch {
+	case err == errOutOfMemory:
+		return codes.ResourceExhausted
+	case err == errVersionMismatch || err == errWatchConflict:
+		return codes.Aborted
+	case err == errNoSuchDB:
+		return codes.InvalidArgument
+	case err == errPopTimeout || isNotFound(err):
+		return codes.NotFound
+	}
+
+	return code
+}
+
+func (s *grpcServer) Versions(ctx context.Context, req *cachepb.VersionsRequest) (*cachepb.VersionsResponse, error) {
+	if len(req.Keys) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no keys given")
+	}
+
+	db, err := s.db(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cachepb.VersionsResponse{Versions: s.a.dbs[db].versions(req.Keys)}, nil
+}
+
+func (s *grpcServer) Publish(ctx context.Context, req *cachepb.PublishRequest) (*cachepb.PublishResponse, error) {
+	message, err := valueJSON(req.Message)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	receivers := s.a.cache.pubsub.publish(req.Channel, jsonValue(message))
+
+	return &cachepb.PublishResponse{Receivers: int64(receivers)}, nil
+}
+
+func (s *grpcServer) Subscribe(req *cachepb.SubscribeRequest, stream cachepb.Cache_SubscribeServer) error {
+	if len(req.Channels)+len(req.Patterns) == 0 {
+		return status.Error(codes.InvalidArgument, "no channels given")
+	}
+
+	sub := s.a.cache.pubsub.subscribe(req.Channels, req.Patterns)
+	defer s.a.cache.pubsub.unsubscribe(sub)
+
+	return streamMessages(stream, sub, func(m message) error {
+		return stream.Send(&cachepb.Message{Channel: m.Channel, Pattern: m.Pattern, Payload: protoValue(m.Payload)})
+	})
+}
+
+func (s *grpcServer) Notifications(req *cachepb.NotificationsRequest, stream cachepb.Cache_NotificationsServer) error {
+	patterns, err := keyspacePatterns(req.Pattern, req.Events)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	db, err := s.db(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	keyspace := s.a.dbs[db].keyspace
+	sub := keyspace.subscribe(nil, patterns)
+	defer keyspace.unsubscribe(sub)
+
+	return streamMessages(stream, sub, func(m message) error {
+		e := m.Payload.(keyspaceEvent)
+		return stream.Send(&cachepb.KeyspaceEvent{
+			Key:       e.Key,
+			Op:        e.Op,
+			Type:      e.Type,
+			Timestamp: timestamppb.New(e.Timestamp),
+		})
+	})
+}
+
+// streamMessages is streamEvents for gRPC streams. The headers are sent
+// at once, so clients know when they are subscribed.
+func streamMessages(stream grpc.ServerStream, sub *subscriber, send func(message) error) error {
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	for {
+		select {
+		case m := <-sub.messages:
+			if err := send(m); err != nil {
+				return err
+			}
+		case <-sub.dropped:
+			return status.Error(codes.ResourceExhausted, "slow consumer")
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// protoValue converts a stored value, see jsonValue, to a value of the
+// gRPC API.
+func protoValue(v interface{}) *cachepb.Value {
+	switch v := v.(type) {
+	case nil:
+		return &cachepb.Value{Kind: &cachepb.Value_NullValue{NullValue: true}}
+	case bool:
+		return &cachepb.Value{Kind: &cachepb.Value_BoolValue{BoolValue: v}}
+	case string:
+		return &cachepb.Value{Kind: &cachepb.Value_StringValue{StringValue: v}}
+	case json.Number:
+		return &cachepb.Value{Kind: &cachepb.Value_NumberValue{NumberValue: string(v)}}
+	case int64:
+		return &cachepb.Value{Kind: &cachepb.Value_NumberValue{NumberValue: strconv.FormatInt(v, 10)}}
+	case json.RawMessage:
+		return &cachepb.Value{Kind: &cachepb.Value_JsonValue{JsonValue: v}}
+	}
+
+	// Floats, raw values and values of other types read back as they are
+	// encoded by the HTTP API.
+	return protoValue(jsonValue(encodeValue(v)))
+}
+
+func protoValues(values []interface{}) []*cachepb.Value {
+	result := make([]*cachepb.Value, len(values))
+	for i, v := range values {
+		result[i] = protoValue(v)
+	}
+
+	return result
+}
+
+// valueJSON returns the JSON of a value of the gRPC API, which jsonValue
+// converts back to the value.
+func valueJSON(v *cachepb.Value) (json.RawMessage, error) {
+	switch k := v.GetKind().(type) {
+	case *cachepb.Value_BoolValue:
+		return json.RawMessage(strconv.FormatBool(k.BoolValue)), nil
+	case *cachepb.Value_StringValue:
+		return json.Marshal(k.StringValue)
+	case *cachepb.Value_NumberValue:
+		raw := json.RawMessage(k.NumberValue)
+		if _, ok := jsonValue(raw).(json.Number); !ok || !json.Valid(raw) {
+			return nil, errors.New("value is not a number: " + k.NumberValue)
+		}
+		return raw, nil
+	case *cachepb.Value_JsonValue:
+		var raw json.RawMessage
+		if err := json.Unmarshal(k.JsonValue, &raw); err != nil {
+			return nil, errors.New("value is not JSON: " + err.Error())
+		}
+		return raw, nil
+	}
+
+	return json.RawMessage("null"), nil
+}
+
+func valuesJSON(values []*cachepb.Value) ([]json.RawMessage, error) {
+	raw := make([]json.RawMessage, len(values))
+	for i, v := range values {
+		var err error
+		if raw[i], err = valueJSON(v); err != nil {
+			return nil, err
+		}
+	}
+
+	return raw, nil
+}
@@ -0,0 +1,245 @@
+package app
+
+import (
+	"context"
+	"github.com/iqOptionTest/simplecache/cachepb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func newGRPCTestServer(t *testing.T) (*App, cachepb.CacheClient) {
+	a, err := NewApp(Config{Shards: 4, Databases: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l := bufconn.Listen(1024 * 1024)
+	server := a.newGRPCServer()
+	go server.Serve(l)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return l.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return a, cachepb.NewCacheClient(conn)
+}
+
+func stringValue(s string) *cachepb.Value {
+	return &cachepb.Value{Kind: &cachepb.Value_StringValue{StringValue: s}}
+}
+
+func numberValue(n string) *cachepb.Value {
+	return &cachepb.Value{Kind: &cachepb.Value_NumberValue{NumberValue: n}}
+}
+
+func TestGRPC_Ops(t *testing.T) {
+	ops := (&cachepb.Command{}).ProtoReflect().Descriptor().Oneofs().ByName("op").Fields()
+	for i := 0; i < ops.Len(); i++ {
+		op := string(ops.Get(i).Name())
+		if _, found := grpcOps[op]; !found {
+			t.Error("Command has no operation", op)
+		}
+		if resultFields.ByName(ops.Get(i).Name()) == nil {
+			t.Error("Command has no result", op)
+		}
+	}
+	if len(grpcOps) != ops.Len() {
+		t.Error("Operation isn't a command", len(grpcOps), ops.Len())
+	}
+}
+
+func TestGRPC_Typed(t *testing.T) {
+	_, c := newGRPCTestServer(t)
+	ctx := context.Background()
+
+	r, err := c.Rpush(ctx, &cachepb.RpushRequest{Key: "l", Values: []*cachepb.Value{stringValue("a"), stringValue("b")}})
+	if err != nil || r.Length != 2 {
+		t.Fatal("Rpush doesn't return length 2", r, err)
+	}
+	lrange, err := c.Lrange(ctx, &cachepb.LrangeRequest{Key: "l", Start: 0, Stop: -1})
+	if err != nil || len(lrange.Values) != 2 || lrange.Values[0].GetStringValue() != "a" || lrange.Values[1].GetStringValue() != "b" {
+		t.Error("Lrange is wrong", lrange, err)
+	}
+
+	if _, err := c.Get(ctx, &cachepb.GetRequest{Key: "l"}); status.Code(err) != codes.FailedPrecondition || status.Convert(err).Message() != "wrong object type" {
+		t.Error("Get of a list doesn't fail like the endpoint", err)
+	}
+	if _, err := c.Get(ctx, &cachepb.GetRequest{Key: "missing"}); status.Code(err) != codes.NotFound {
+		t.Error("Get of missing key doesn't fail", err)
+	}
+	if _, err := c.Blpop(ctx, &cachepb.BlpopRequest{Keys: []string{"empty"}, Timeout: 0.01}); status.Code(err) != codes.NotFound {
+		t.Error("Blpop doesn't time out", err)
+	}
+
+	// Numbers are kept as their text, like in JSON bodies.
+	if _, err := c.Set(ctx, &cachepb.SetRequest{Key: "n", Value: numberValue("9007199254740993")}); err != nil {
+		t.Fatal(err)
+	}
+	if g, err := c.Get(ctx, &cachepb.GetRequest{Key: "n"}); err != nil || g.Value.GetNumberValue() != "9007199254740993" {
+		t.Error("Number isn't kept exactly", g, err)
+	}
+	if _, err := c.Set(ctx, &cachepb.SetRequest{Key: "n", Value: numberValue("1x")}); status.Code(err) != codes.InvalidArgument {
+		t.Error("Invalid number is accepted", err)
+	}
+
+	object := &cachepb.Value{Kind: &cachepb.Value_JsonValue{JsonValue: []byte(`{"a":[1,2]}`)}}
+	if _, err := c.Set(ctx, &cachepb.SetRequest{Key: "o", Value: object}); err != nil {
+		t.Fatal(err)
+	}
+	if g, err := c.Get(ctx, &cachepb.GetRequest{Key: "o"}); err != nil || string(g.Value.GetJsonValue()) != `{"a":[1,2]}` {
+		t.Error("Object isn't kept", g, err)
+	}
+
+	if z, err := c.Zadd(ctx, &cachepb.ZaddRequest{Key: "z", Members: []*cachepb.ZMember{{Member: "a", Score: 1.5}}, Incr: true}); err != nil || z.Score == nil || *z.Score != 1.5 {
+		t.Error("Zadd incr doesn't return the score", z, err)
+	}
+	if z, err := c.Zrange(ctx, &cachepb.ZrangeRequest{Key: "z", Stop: -1}); err != nil || len(z.Members) != 1 || z.Members[0].Member != "a" || z.Members[0].Score != 1.5 {
+		t.Error("Zrange is wrong", z, err)
+	}
+}
+
+func TestGRPC_Batch(t *testing.T) {
+	a, c := newGRPCTestServer(t)
+
+	stream, err := c.Batch(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		for i := 0; i < 100; i++ {
+			stream.Send(&cachepb.Command{Op: &cachepb.Command_Incr{Incr: &cachepb.IncrRequest{Key: "n"}}})
+		}
+		stream.Send(&cachepb.Command{Op: &cachepb.Command_Get{Get: &cachepb.GetRequest{Key: "missing"}}})
+		stream.CloseSend()
+	}()
+
+	for i := 1; i <= 100; i++ {
+		r, err := stream.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v := r.GetIncr().GetValue(); v != int64(i) {
+			t.Fatal("Result "+strconv.Itoa(i)+" of batch is out of order", r)
+		}
+	}
+	r, err := stream.Recv()
+	if err != nil || r.Error != "not found" || codes.Code(r.Code) != codes.NotFound {
+		t.Error("Failed command of batch is wrong", r, err)
+	}
+	if v, _ := a.cache.get("n"); v != int64(100) {
+		t.Error("Batch isn't applied", v)
+	}
+}
+
+func TestGRPC_Tx(t *testing.T) {
+	a, c := newGRPCTestServer(t)
+	ctx := context.Background()
+	a.cache.set("balance", int64(10), 0)
+
+	versions, err := c.Versions(ctx, &cachepb.VersionsRequest{Keys: []string{"balance"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	decr := &cachepb.Command{Op: &cachepb.Command_Decr{Decr: &cachepb.DecrRequest{Key: "balance"}}}
+	withdraw := &cachepb.TxRequest{Watch: versions.Versions, Commands: []*cachepb.Command{decr}}
+
+	if r, err := c.Tx(ctx, withdraw); err != nil || r.Results[0].GetDecr().GetValue() != 9 {
+		t.Error("Tx with unchanged watched key fails", r, err)
+	}
+	if _, err := c.Tx(ctx, withdraw); status.Code(err) != codes.Aborted {
+		t.Error("Tx with changed watched key isn't aborted", err)
+	}
+
+	rpush := &cachepb.Command{Op: &cachepb.Command_Rpush{Rpush: &cachepb.RpushRequest{Key: "balance", Values: []*cachepb.Value{numberValue("1")}}}}
+	_, err = c.Tx(ctx, &cachepb.TxRequest{Commands: []*cachepb.Command{rpush}})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Error("Tx with a type error doesn't fail", err)
+	}
+	if _, err := c.Tx(ctx, &cachepb.TxRequest{Commands: []*cachepb.Command{{}}}); status.Code(err) != codes.FailedPrecondition {
+		t.Error("Tx with an empty command doesn't fail", err)
+	}
+}
+
+func TestGRPC_Streams(t *testing.T) {
+	a, c := newGRPCTestServer(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	messages, err := c.Subscribe(ctx, &cachepb.SubscribeRequest{Patterns: []string{"news.*"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, err := c.Notifications(ctx, &cachepb.NotificationsRequest{Pattern: "user:*", Events: []string{"set"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitForSubscribers(t, a, 1)
+	for i := 0; a.cache.keyspace.count() < 1; i++ {
+		if i == 100 {
+			t.Fatal("Keyspace subscriber isn't registered")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if r, err := c.Publish(ctx, &cachepb.PublishRequest{Channel: "news.sport", Message: stringValue("goal")}); err != nil || r.Receivers != 1 {
+		t.Error("Publish doesn't reach the subscriber", r, err)
+	}
+	m, err := messages.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Channel != "news.sport" || m.Pattern != "news.*" || m.Payload.GetStringValue() != "goal" {
+		t.Error("Message is wrong", m)
+	}
+
+	c.Set(ctx, &cachepb.SetRequest{Key: "user:1", Value: stringValue("a")})
+	e, err := events.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Key != "user:1" || e.Op != "set" || e.Type != simpleType || e.Timestamp.AsTime().IsZero() {
+		t.Error("Keyspace event is wrong", e)
+	}
+
+	stream, _ := c.Notifications(ctx, &cachepb.NotificationsRequest{Events: []string{"unknown"}})
+	if _, err := stream.Recv(); status.Code(err) != codes.InvalidArgument {
+		t.Error("Unknown event is accepted", err)
+	}
+}
+
+func TestGRPC_SelectDB(t *testing.T) {
+	a, c := newGRPCTestServer(t)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), dbHeader, "1")
+
+	if _, err := c.Set(ctx, &cachepb.SetRequest{Key: "k", Value: numberValue("1")}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := a.dbs[1].get("k"); err != nil {
+		t.Error("Key isn't set in the selected database", err)
+	}
+	if a.dbs[0].count.Load() != 0 {
+		t.Error("Key is set in the default database")
+	}
+
+	if r, err := c.Move(ctx, &cachepb.MoveRequest{Key: "k", Db: 0}); err != nil || !r.Moved {
+		t.Error("Key isn't moved", r, err)
+	}
+
+	bad := metadata.AppendToOutgoingContext(context.Background(), dbHeader, "2")
+	if _, err := c.DbSize(bad, &cachepb.DbSizeRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Error("Database out of range is accepted", err)
+	}
+}
@@ -0,0 +1,828 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/iqOptionTest/simplecache/cachepb"
+	"google.golang.org/protobuf/proto"
+)
+
+// grpcOp is an operation of the gRPC API. args converts its request to
+// the args of its command, the object of the request body of its endpoint
+// or txArgs, and response converts the result of the command, the
+// response body of the endpoint, to its response.
+type grpcOp struct {
+	args     func(req proto.Message) (interface{}, error)
+	response func(result interface{}) proto.Message
+}
+
+// grpcOps are the operations of the gRPC API by the name of their
+// endpoint, which is also the name of their field in Command and Result.
+var grpcOps = map[string]grpcOp{
+	"get": {keyArgs, func(r interface{}) proto.Message {
+		return &cachepb.GetResponse{Value: protoValue(r)}
+	}},
+	"gets": {keyArgs, func(r interface{}) proto.Message {
+		return &cachepb.GetsResponse{Value: protoValue(field(r, "value")), Version: field(r, "version").(int64)}
+	}},
+	"version": {keyArgs, func(r interface{}) proto.Message {
+		return &cachepb.VersionResponse{Version: r.(int64)}
+	}},
+	"set": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.SetRequest)
+		value, err := valueJSON(req.Value)
+		return setObject{Key: req.Key, Expired: int(req.Expired), Value: value, NX: req.Nx, XX: req.Xx}, err
+	}, func(r interface{}) proto.Message {
+		version, _ := field(r, "version").(int64)
+		return &cachepb.SetResponse{Set: field(r, "set").(bool), Version: version}
+	}},
+	"getset": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.GetSetRequest)
+		value, err := valueJSON(req.Value)
+		return setObject{Key: req.Key, Expired: int(req.Expired), Value: value}, err
+	}, func(r interface{}) proto.Message {
+		return &cachepb.GetSetResponse{Value: protoValue(field(r, "value")), Version: field(r, "version").(int64)}
+	}},
+	"cas": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.CasRequest)
+		value, err := valueJSON(req.Value)
+		return casObject{Key: req.Key, Expired: int(req.Expired), Value: value, Version: req.Version}, err
+	}, func(r interface{}) proto.Message {
+		return &cachepb.CasResponse{Version: field(r, "version").(int64)}
+	}},
+	"incr": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.IncrRequest)
+		return incrObject{Key: req.Key, Expired: int(req.Expired), Refresh: req.Refresh}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.IncrResponse{Value: field(r, "value").(int64)}
+	}},
+	"decr": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.DecrRequest)
+		return incrObject{Key: req.Key, Expired: int(req.Expired), Refresh: req.Refresh}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.DecrResponse{Value: field(r, "value").(int64)}
+	}},
+	"incrby": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.IncrByRequest)
+		return incrObject{Key: req.Key, Expired: int(req.Expired), Refresh: req.Refresh, Value: req.Value}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.IncrByResponse{Value: field(r, "value").(int64)}
+	}},
+	"incrbyfloat": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.IncrByFloatRequest)
+		return incrFloatObject{Key: req.Key, Expired: int(req.Expired), Refresh: req.Refresh, Value: req.Value}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.IncrByFloatResponse{Value: field(r, "value").(float64)}
+	}},
+	"unset": {keyArgs, func(r interface{}) proto.Message {
+		return &cachepb.UnsetResponse{}
+	}},
+	"expire": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.ExpireRequest)
+		return expireObject{Key: req.Key, Seconds: req.Seconds, Milliseconds: req.Milliseconds}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.ExpireResponse{}
+	}},
+	"expireat": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.ExpireAtRequest)
+		return expireAtObject{Key: req.Key, Timestamp: req.Timestamp, TimestampMs: req.TimestampMs}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.ExpireAtResponse{}
+	}},
+	"persist": {keyArgs, func(r interface{}) proto.Message {
+		return &cachepb.PersistResponse{Persisted: field(r, "persisted").(bool)}
+	}},
+	"ttl": {keyArgs, func(r interface{}) proto.Message {
+		return &cachepb.TtlResponse{Ttl: resultInt(r)}
+	}},
+	"pttl": {keyArgs, func(r interface{}) proto.Message {
+		return &cachepb.PttlResponse{Ttl: resultInt(r)}
+	}},
+	"keys": {func(m proto.Message) (interface{}, error) {
+		return txArgs{Pattern: m.(*cachepb.KeysRequest).Pattern}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.KeysResponse{Keys: r.([]string)}
+	}},
+	"scan": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.ScanRequest)
+		count := int(req.Count)
+		return txArgs{Cursor: req.Cursor, Count: &count, Match: req.Match, Type: req.Type}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.ScanResponse{Cursor: field(r, "cursor").(string), Keys: field(r, "keys").([]string)}
+	}},
+
+	"rpush": {pushArgs, func(r interface{}) proto.Message {
+		return &cachepb.RpushResponse{Length: resultInt(field(r, "length"))}
+	}},
+	"lpush": {pushArgs, func(r interface{}) proto.Message {
+		return &cachepb.LpushResponse{Length: resultInt(field(r, "length"))}
+	}},
+	"pop": {keyArgs, func(r interface{}) proto.Message {
+		return &cachepb.PopResponse{Value: protoValue(r)}
+	}},
+	"lpop": {keyArgs, func(r interface{}) proto.Message {
+		return &cachepb.LpopResponse{Value: protoValue(r)}
+	}},
+	"blpop": {keysArgs, func(r interface{}) proto.Message {
+		pr := r.(popResult)
+		return &cachepb.BlpopResponse{Key: pr.Key, Value: protoValue(pr.Value)}
+	}},
+	"brpop": {keysArgs, func(r interface{}) proto.Message {
+		pr := r.(popResult)
+		return &cachepb.BrpopResponse{Key: pr.Key, Value: protoValue(pr.Value)}
+	}},
+	"lgetall": {keyArgs, func(r interface{}) proto.Message {
+		return &cachepb.LgetallResponse{Values: protoValues(r.([]interface{}))}
+	}},
+	"lget": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.LgetRequest)
+		return txArgs{Key: req.Key, Index: int(req.Index)}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.LgetResponse{Value: protoValue(r)}
+	}},
+	"llen": {keyArgs, func(r interface{}) proto.Message {
+		return &cachepb.LlenResponse{Length: resultInt(r)}
+	}},
+	"lrange": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.LrangeRequest)
+		return txArgs{Key: req.Key, Start: int(req.Start), Stop: int(req.Stop)}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.LrangeResponse{Values: protoValues(r.([]interface{}))}
+	}},
+	"lset": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.LsetRequest)
+		value, err := valueJSON(req.Value)
+		return lsetObject{Key: req.Key, Index: int(req.Index), Value: value}, err
+	}, func(r interface{}) proto.Message {
+		return &cachepb.LsetResponse{}
+	}},
+	"lrem": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.LremRequest)
+		value, err := valueJSON(req.Value)
+		return lremObject{Key: req.Key, Count: int(req.Count), Value: value}, err
+	}, func(r interface{}) proto.Message {
+		return &cachepb.LremResponse{Removed: resultInt(field(r, "removed"))}
+	}},
+	"ltrim": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.LtrimRequest)
+		return ltrimObject{Key: req.Key, Start: int(req.Start), Stop: int(req.Stop)}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.LtrimResponse{}
+	}},
+	"linsert": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.LinsertRequest)
+		position := "after"
+		if req.Before {
+			position = "before"
+		}
+		pivot, err := valueJSON(req.Pivot)
+		if err != nil {
+			return nil, err
+		}
+		value, err := valueJSON(req.Value)
+		return linsertObject{Key: req.Key, Position: position, Pivot: pivot, Value: value}, err
+	}, func(r interface{}) proto.Message {
+		return &cachepb.LinsertResponse{Length: resultInt(field(r, "length"))}
+	}},
+
+	"hset": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.HsetRequest)
+		if len(req.Fields) == 0 {
+			return nil, errors.New("no fields given")
+		}
+		fields := make(map[string]json.RawMessage, len(req.Fields))
+		for k, v := range req.Fields {
+			var err error
+			if fields[k], err = valueJSON(v); err != nil {
+				return nil, err
+			}
+		}
+		return setHObject{Key: req.Key, Expired: int(req.Expired), Value: fields}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.HsetResponse{}
+	}},
+	"hgetall": {keyArgs, func(r interface{}) proto.Message {
+		fields := r.(map[string]interface{})
+		response := &cachepb.HgetallResponse{Fields: make(map[string]*cachepb.Value, len(fields))}
+		for k, v := range fields {
+			response.Fields[k] = protoValue(v)
+		}
+		return response
+	}},
+	"hget": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.HgetRequest)
+		return txArgs{Key: req.Key, Field: req.Field}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.HgetResponse{Value: protoValue(r)}
+	}},
+	"hdel": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.HdelRequest)
+		return hdelObject{Key: req.Key, Fields: req.Fields}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.HdelResponse{Removed: resultInt(field(r, "removed"))}
+	}},
+	"hexists": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.HexistsRequest)
+		return txArgs{Key: req.Key, Field: req.Field}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.HexistsResponse{Exists: r.(bool)}
+	}},
+	"hlen": {keyArgs, func(r interface{}) proto.Message {
+		return &cachepb.HlenResponse{Length: resultInt(r)}
+	}},
+	"hkeys": {keyArgs, func(r interface{}) proto.Message {
+		return &cachepb.HkeysResponse{Fields: r.([]string)}
+	}},
+	"hvals": {keyArgs, func(r interface{}) proto.Message {
+		return &cachepb.HvalsResponse{Values: protoValues(r.([]interface{}))}
+	}},
+	"hmget": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.HmgetRequest)
+		return txArgs{Key: req.Key, Fields: req.Fields}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.HmgetResponse{Values: protoValues(r.([]interface{}))}
+	}},
+	"hsetnx": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.HsetnxRequest)
+		value, err := valueJSON(req.Value)
+		return hsetnxObject{Key: req.Key, Expired: int(req.Expired), Field: req.Field, Value: value}, err
+	}, func(r interface{}) proto.Message {
+		return &cachepb.HsetnxResponse{Set: field(r, "set").(bool)}
+	}},
+	"hincrby": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.HincrbyRequest)
+		return hincrbyObject{Key: req.Key, Field: req.Field, Value: req.Value}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.HincrbyResponse{Value: field(r, "value").(int64)}
+	}},
+	"hincrbyfloat": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.HincrbyFloatRequest)
+		return hincrbyfloatObject{Key: req.Key, Field: req.Field, Value: req.Value}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.HincrbyFloatResponse{Value: field(r, "value").(float64)}
+	}},
+
+	"sadd": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.SaddRequest)
+		return saddObject{Key: req.Key, Expired: int(req.Expired), Value: req.Members}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.SaddResponse{Added: resultInt(field(r, "added"))}
+	}},
+	"srem": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.SremRequest)
+		return sremObject{Key: req.Key, Value: req.Members}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.SremResponse{Removed: resultInt(field(r, "removed"))}
+	}},
+	"sismember": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.SismemberRequest)
+		return txArgs{Key: req.Key, Member: req.Member}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.SismemberResponse{Member: r.(bool)}
+	}},
+	"smembers": {keyArgs, func(r interface{}) proto.Message {
+		return &cachepb.SmembersResponse{Members: r.([]string)}
+	}},
+	"scard": {keyArgs, func(r interface{}) proto.Message {
+		return &cachepb.ScardResponse{Count: resultInt(r)}
+	}},
+	"spop": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.SpopRequest)
+		return txArgs{Key: req.Key, Count: optionalInt(req.Count)}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.SpopResponse{Members: r.([]string)}
+	}},
+	"sinter": {keysArgs, func(r interface{}) proto.Message {
+		return &cachepb.SinterResponse{Members: r.([]string)}
+	}},
+	"sunion": {keysArgs, func(r interface{}) proto.Message {
+		return &cachepb.SunionResponse{Members: r.([]string)}
+	}},
+	"sdiff": {keysArgs, func(r interface{}) proto.Message {
+		return &cachepb.SdiffResponse{Members: r.([]string)}
+	}},
+	"sinterstore": {storeArgs, func(r interface{}) proto.Message {
+		return &cachepb.SinterStoreResponse{Count: resultInt(field(r, "count"))}
+	}},
+	"sunionstore": {storeArgs, func(r interface{}) proto.Message {
+		return &cachepb.SunionStoreResponse{Count: resultInt(field(r, "count"))}
+	}},
+	"sdiffstore": {storeArgs, func(r interface{}) proto.Message {
+		return &cachepb.SdiffStoreResponse{Count: resultInt(field(r, "count"))}
+	}},
+
+	"zadd": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.ZaddRequest)
+		members := make([]zmember, len(req.Members))
+		for i, zm := range req.Members {
+			members[i] = zmember{Member: zm.Member, Score: zm.Score}
+		}
+		return zaddObject{Key: req.Key, Expired: int(req.Expired), Value: members, NX: req.Nx, XX: req.Xx, Incr: req.Incr}, nil
+	}, func(r interface{}) proto.Message {
+		response := &cachepb.ZaddResponse{Added: resultInt(field(r, "added"))}
+		if score, ok := field(r, "score").(float64); ok {
+			response.Score = &score
+		}
+		return response
+	}},
+	"zrem": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.ZremRequest)
+		return zremObject{Key: req.Key, Value: req.Members}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.ZremResponse{Removed: resultInt(field(r, "removed"))}
+	}},
+	"zscore": {memberArgs, func(r interface{}) proto.Message {
+		return &cachepb.ZscoreResponse{Score: r.(float64)}
+	}},
+	"zrank": {memberArgs, func(r interface{}) proto.Message {
+		return &cachepb.ZrankResponse{Rank: resultInt(r)}
+	}},
+	"zrevrank": {memberArgs, func(r interface{}) proto.Message {
+		return &cachepb.ZrevrankResponse{Rank: resultInt(r)}
+	}},
+	"zrange": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.ZrangeRequest)
+		return txArgs{Key: req.Key, Start: int(req.Start), Stop: int(req.Stop), Rev: req.Rev}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.ZrangeResponse{Members: protoMembers(r)}
+	}},
+	"zrangebyscore": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.ZrangeByScoreRequest)
+		return txArgs{Key: req.Key, Min: req.Min, Max: req.Max, Offset: int(req.Offset), Count: optionalInt(req.Count), Rev: req.Rev}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.ZrangeByScoreResponse{Members: protoMembers(r)}
+	}},
+	"zcount": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.ZcountRequest)
+		return txArgs{Key: req.Key, Min: req.Min, Max: req.Max}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.ZcountResponse{Count: resultInt(r)}
+	}},
+	"zpopmin": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.ZpopMinRequest)
+		return txArgs{Key: req.Key, Count: optionalInt(req.Count)}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.ZpopMinResponse{Members: protoMembers(r)}
+	}},
+	"zpopmax": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.ZpopMaxRequest)
+		return txArgs{Key: req.Key, Count: optionalInt(req.Count)}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.ZpopMaxResponse{Members: protoMembers(r)}
+	}},
+
+	"stats": {noArgs, func(r interface{}) proto.Message {
+		st := r.(stats)
+		return &cachepb.StatsResponse{
+			Keys:           st.Keys,
+			UsedMemory:     st.UsedMemory,
+			MaxMemory:      st.MaxMemory,
+			MaxKeys:        st.MaxKeys,
+			EvictionPolicy: st.EvictionPolicy,
+			EvictedKeys:    st.EvictedKeys,
+			RejectedWrites: st.RejectedWrites,
+		}
+	}},
+	"dbsize": {noArgs, func(r interface{}) proto.Message {
+		return &cachepb.DbSizeResponse{Size: r.(int64)}
+	}},
+	"flushdb": {noArgs, func(r interface{}) proto.Message {
+		return &cachepb.FlushDbResponse{Deleted: resultInt(field(r, "deleted"))}
+	}},
+	"move": {func(m proto.Message) (interface{}, error) {
+		req := m.(*cachepb.MoveRequest)
+		return moveObject{Key: req.Key, DB: int(req.Db)}, nil
+	}, func(r interface{}) proto.Message {
+		return &cachepb.MoveResponse{Moved: field(r, "moved").(bool)}
+	}},
+}
+
+// keyArgs are the args of the requests that only have a key.
+func keyArgs(req proto.Message) (interface{}, error) {
+	return txArgs{Key: req.(interface{ GetKey() string }).GetKey()}, nil
+}
+
+func keysArgs(req proto.Message) (interface{}, error) {
+	return txArgs{Keys: req.(interface{ GetKeys() []string }).GetKeys()}, nil
+}
+
+func memberArgs(req proto.Message) (interface{}, error) {
+	r := req.(interface {
+		GetKey() string
+		GetMember() string
+	})
+	return txArgs{Key: r.GetKey(), Member: r.GetMember()}, nil
+}
+
+func noArgs(proto.Message) (interface{}, error) {
+	return txArgs{}, nil
+}
+
+func pushArgs(req proto.Message) (interface{}, error) {
+	r := req.(interface {
+		GetKey() string
+		GetValues() []*cachepb.Value
+		GetExpired() int64
+	})
+	if len(r.GetValues()) == 0 {
+		return nil, errors.New("no values given")
+	}
+	values, err := valuesJSON(r.GetValues())
+	return pushObject{Key: r.GetKey(), Expired: int(r.GetExpired()), Values: values}, err
+}
+
+func storeArgs(req proto.Message) (interface{}, error) {
+	r := req.(interface {
+		GetDestination() string
+		GetKeys() []string
+	})
+	return setStoreObject{Destination: r.GetDestination(), Keys: r.GetKeys()}, nil
+}
+
+// field returns a field of the body of a write, see txSuccess.
+func field(result interface{}, name string) interface{} {
+	return result.(map[string]interface{})[name]
+}
+
+// resultInt converts the lengths and counts of the results, which are int
+// or int64.
+func resultInt(v interface{}) int64 {
+	switch v := v.(type) {
+	case int:
+		return int64(v)
+	case int64:
+		return v
+	}
+
+	return 0
+}
+
+func optionalInt(n *int64) *int {
+	if n == nil {
+		return nil
+	}
+
+	v := int(*n)
+	return &v
+}
+
+func protoMembers(r interface{}) []*cachepb.ZMember {
+	members := r.([]zmember)
+	result := make([]*cachepb.ZMember, len(members))
+	for i, zm := range members {
+		result[i] = &cachepb.ZMember{Member: zm.Member, Score: zm.Score}
+	}
+
+	return result
+}
+
+func (s *grpcServer) Get(ctx context.Context, req *cachepb.GetRequest) (*cachepb.GetResponse, error) {
+	r, err := s.call(ctx, "get", req)
+	return r.GetGet(), err
+}
+
+func (s *grpcServer) Gets(ctx context.Context, req *cachepb.GetsRequest) (*cachepb.GetsResponse, error) {
+	r, err := s.call(ctx, "gets", req)
+	return r.GetGets(), err
+}
+
+func (s *grpcServer) Version(ctx context.Context, req *cachepb.VersionRequest) (*cachepb.VersionResponse, error) {
+	r, err := s.call(ctx, "version", req)
+	return r.GetVersion(), err
+}
+
+func (s *grpcServer) Set(ctx context.Context, req *cachepb.SetRequest) (*cachepb.SetResponse, error) {
+	r, err := s.call(ctx, "set", req)
+	return r.GetSet(), err
+}
+
+func (s *grpcServer) GetSet(ctx context.Context, req *cachepb.GetSetRequest) (*cachepb.GetSetResponse, error) {
+	r, err := s.call(ctx, "getset", req)
+	return r.GetGetset(), err
+}
+
+func (s *grpcServer) Cas(ctx context.Context, req *cachepb.CasRequest) (*cachepb.CasResponse, error) {
+	r, err := s.call(ctx, "cas", req)
+	return r.GetCas(), err
+}
+
+func (s *grpcServer) Incr(ctx context.Context, req *cachepb.IncrRequest) (*cachepb.IncrResponse, error) {
+	r, err := s.call(ctx, "incr", req)
+	return r.GetIncr(), err
+}
+
+func (s *grpcServer) Decr(ctx context.Context, req *cachepb.DecrRequest) (*cachepb.DecrResponse, error) {
+	r, err := s.call(ctx, "decr", req)
+	return r.GetDecr(), err
+}
+
+func (s *grpcServer) IncrBy(ctx context.Context, req *cachepb.IncrByRequest) (*cachepb.IncrByResponse, error) {
+	r, err := s.call(ctx, "incrby", req)
+	return r.GetIncrby(), err
+}
+
+func (s *grpcServer) IncrByFloat(ctx context.Context, req *cachepb.IncrByFloatRequest) (*cachepb.IncrByFloatResponse, error) {
+	r, err := s.call(ctx, "incrbyfloat", req)
+	return r.GetIncrbyfloat(), err
+}
+
+func (s *grpcServer) Unset(ctx context.Context, req *cachepb.UnsetRequest) (*cachepb.UnsetResponse, error) {
+	r, err := s.call(ctx, "unset", req)
+	return r.GetUnset(), err
+}
+
+func (s *grpcServer) Expire(ctx context.Context, req *cachepb.ExpireRequest) (*cachepb.ExpireResponse, error) {
+	r, err := s.call(ctx, "expire", req)
+	return r.GetExpire(), err
+}
+
+func (s *grpcServer) ExpireAt(ctx context.Context, req *cachepb.ExpireAtRequest) (*cachepb.ExpireAtResponse, error) {
+	r, err := s.call(ctx, "expireat", req)
+	return r.GetExpireat(), err
+}
+
+func (s *grpcServer) Persist(ctx context.Context, req *cachepb.PersistRequest) (*cachepb.PersistResponse, error) {
+	r, err := s.call(ctx, "persist", req)
+	return r.GetPersist(), err
+}
+
+func (s *grpcServer) Ttl(ctx context.Context, req *cachepb.TtlRequest) (*cachepb.TtlResponse, error) {
+	r, err := s.call(ctx, "ttl", req)
+	return r.GetTtl(), err
+}
+
+func (s *grpcServer) Pttl(ctx context.Context, req *cachepb.PttlRequest) (*cachepb.PttlResponse, error) {
+	r, err := s.call(ctx, "pttl", req)
+	return r.GetPttl(), err
+}
+
+func (s *grpcServer) Keys(ctx context.Context, req *cachepb.KeysRequest) (*cachepb.KeysResponse, error) {
+	r, err := s.call(ctx, "keys", req)
+	return r.GetKeys(), err
+}
+
+func (s *grpcServer) Scan(ctx context.Context, req *cachepb.ScanRequest) (*cachepb.ScanResponse, error) {
+	r, err := s.call(ctx, "scan", req)
+	return r.GetScan(), err
+}
+
+func (s *grpcServer) Rpush(ctx context.Context, req *cachepb.RpushRequest) (*cachepb.RpushResponse, error) {
+	r, err := s.call(ctx, "rpush", req)
+	return r.GetRpush(), err
+}
+
+func (s *grpcServer) Lpush(ctx context.Context, req *cachepb.LpushRequest) (*cachepb.LpushResponse, error) {
+	r, err := s.call(ctx, "lpush", req)
+	return r.GetLpush(), err
+}
+
+func (s *grpcServer) Pop(ctx context.Context, req *cachepb.PopRequest) (*cachepb.PopResponse, error) {
+	r, err := s.call(ctx, "pop", req)
+	return r.GetPop(), err
+}
+
+func (s *grpcServer) Lpop(ctx context.Context, req *cachepb.LpopRequest) (*cachepb.LpopResponse, error) {
+	r, err := s.call(ctx, "lpop", req)
+	return r.GetLpop(), err
+}
+
+func (s *grpcServer) Blpop(ctx context.Context, req *cachepb.BlpopRequest) (*cachepb.BlpopResponse, error) {
+	r, err := s.call(ctx, "blpop", req)
+	return r.GetBlpop(), err
+}
+
+func (s *grpcServer) Brpop(ctx context.Context, req *cachepb.BrpopRequest) (*cachepb.BrpopResponse, error) {
+	r, err := s.call(ctx, "brpop", req)
+	return r.GetBrpop(), err
+}
+
+func (s *grpcServer) Lgetall(ctx context.Context, req *cachepb.LgetallRequest) (*cachepb.LgetallResponse, error) {
+	r, err := s.call(ctx, "lgetall", req)
+	return r.GetLgetall(), err
+}
+
+func (s *grpcServer) Lget(ctx context.Context, req *cachepb.LgetRequest) (*cachepb.LgetResponse, error) {
+	r, err := s.call(ctx, "lget", req)
+	return r.GetLget(), err
+}
+
+func (s *grpcServer) Llen(ctx context.Context, req *cachepb.LlenRequest) (*cachepb.LlenResponse, error) {
+	r, err := s.call(ctx, "llen", req)
+	return r.GetLlen(), err
+}
+
+func (s *grpcServer) Lrange(ctx context.Context, req *cachepb.LrangeRequest) (*cachepb.LrangeResponse, error) {
+	r, err := s.call(ctx, "lrange", req)
+	return r.GetLrange(), err
+}
+
+func (s *grpcServer) Lset(ctx context.Context, req *cachepb.LsetRequest) (*cachepb.LsetResponse, error) {
+	r, err := s.call(ctx, "lset", req)
+	return r.GetLset(), err
+}
+
+func (s *grpcServer) Lrem(ctx context.Context, req *cachepb.LremRequest) (*cachepb.LremResponse, error) {
+	r, err := s.call(ctx, "lrem", req)
+	return r.GetLrem(), err
+}
+
+func (s *grpcServer) Ltrim(ctx context.Context, req *cachepb.LtrimRequest) (*cachepb.LtrimResponse, error) {
+	r, err := s.call(ctx, "ltrim", req)
+	return r.GetLtrim(), err
+}
+
+func (s *grpcServer) Linsert(ctx context.Context, req *cachepb.LinsertRequest) (*cachepb.LinsertResponse, error) {
+	r, err := s.call(ctx, "linsert", req)
+	return r.GetLinsert(), err
+}
+
+func (s *grpcServer) Hset(ctx context.Context, req *cachepb.HsetRequest) (*cachepb.HsetResponse, error) {
+	r, err := s.call(ctx, "hset", req)
+	return r.GetHset(), err
+}
+
+func (s *grpcServer) Hgetall(ctx context.Context, req *cachepb.HgetallRequest) (*cachepb.HgetallResponse, error) {
+	r, err := s.call(ctx, "hgetall", req)
+	return r.GetHgetall(), err
+}
+
+func (s *grpcServer) Hget(ctx context.Context, req *cachepb.HgetRequest) (*cachepb.HgetResponse, error) {
+	r, err := s.call(ctx, "hget", req)
+	return r.GetHget(), err
+}
+
+func (s *grpcServer) Hdel(ctx context.Context, req *cachepb.HdelRequest) (*cachepb.HdelResponse, error) {
+	r, err := s.call(ctx, "hdel", req)
+	return r.GetHdel(), err
+}
+
+func (s *grpcServer) Hexists(ctx context.Context, req *cachepb.HexistsRequest) (*cachepb.HexistsResponse, error) {
+	r, err := s.call(ctx, "hexists", req)
+	return r.GetHexists(), err
+}
+
+func (s *grpcServer) Hlen(ctx context.Context, req *cachepb.HlenRequest) (*cachepb.HlenResponse, error) {
+	r, err := s.call(ctx, "hlen", req)
+	return r.GetHlen(), err
+}
+
+func (s *grpcServer) Hkeys(ctx context.Context, req *cachepb.HkeysRequest) (*cachepb.HkeysResponse, error) {
+	r, err := s.call(ctx, "hkeys", req)
+	return r.GetHkeys(), err
+}
+
+func (s *grpcServer) Hvals(ctx context.Context, req *cachepb.HvalsRequest) (*cachepb.HvalsResponse, error) {
+	r, err := s.call(ctx, "hvals", req)
+	return r.GetHvals(), err
+}
+
+func (s *grpcServer) Hmget(ctx context.Context, req *cachepb.HmgetRequest) (*cachepb.HmgetResponse, error) {
+	r, err := s.call(ctx, "hmget", req)
+	return r.GetHmget(), err
+}
+
+func (s *grpcServer) Hsetnx(ctx context.Context, req *cachepb.HsetnxRequest) (*cachepb.HsetnxResponse, error) {
+	r, err := s.call(ctx, "hsetnx", req)
+	return r.GetHsetnx(), err
+}
+
+func (s *grpcServer) Hincrby(ctx context.Context, req *cachepb.HincrbyRequest) (*cachepb.HincrbyResponse, error) {
+	r, err := s.call(ctx, "hincrby", req)
+	return r.GetHincrby(), err
+}
+
+func (s *grpcServer) HincrbyFloat(ctx context.Context, req *cachepb.HincrbyFloatRequest) (*cachepb.HincrbyFloatResponse, error) {
+	r, err := s.call(ctx, "hincrbyfloat", req)
+	return r.GetHincrbyfloat(), err
+}
+
+func (s *grpcServer) Sadd(ctx context.Context, req *cachepb.SaddRequest) (*cachepb.SaddResponse, error) {
+	r, err := s.call(ctx, "sadd", req)
+	return r.GetSadd(), err
+}
+
+func (s *grpcServer) Srem(ctx context.Context, req *cachepb.SremRequest) (*cachepb.SremResponse, error) {
+	r, err := s.call(ctx, "srem", req)
+	return r.GetSrem(), err
+}
+
+func (s *grpcServer) Sismember(ctx context.Context, req *cachepb.SismemberRequest) (*cachepb.SismemberResponse, error) {
+	r, err := s.call(ctx, "sismember", req)
+	return r.GetSismember(), err
+}
+
+func (s *grpcServer) Smembers(ctx context.Context, req *cachepb.SmembersRequest) (*cachepb.SmembersResponse, error) {
+	r, err := s.call(ctx, "smembers", req)
+	return r.GetSmembers(), err
+}
+
+func (s *grpcServer) Scard(ctx context.Context, req *cachepb.ScardRequest) (*cachepb.ScardResponse, error) {
+	r, err := s.call(ctx, "scard", req)
+	return r.GetScard(), err
+}
+
+func (s *grpcServer) Spop(ctx context.Context, req *cachepb.SpopRequest) (*cachepb.SpopResponse, error) {
+	r, err := s.call(ctx, "spop", req)
+	return r.GetSpop(), err
+}
+
+func (s *grpcServer) Sinter(ctx context.Context, req *cachepb.SinterRequest) (*cachepb.SinterResponse, error) {
+	r, err := s.call(ctx, "sinter", req)
+	return r.GetSinter(), err
+}
+
+func (s *grpcServer) Sunion(ctx context.Context, req *cachepb.SunionRequest) (*cachepb.SunionResponse, error) {
+	r, err := s.call(ctx, "sunion", req)
+	return r.GetSunion(), err
+}
+
+func (s *grpcServer) Sdiff(ctx context.Context, req *cachepb.SdiffRequest) (*cachepb.SdiffResponse, error) {
+	r, err := s.call(ctx, "sdiff", req)
+	return r.GetSdiff(), err
+}
+
+func (s *grpcServer) SinterStore(ctx context.Context, req *cachepb.SinterStoreRequest) (*cachepb.SinterStoreResponse, error) {
+	r, err := s.call(ctx, "sinterstore", req)
+	return r.GetSinterstore(), err
+}
+
+func (s *grpcServer) SunionStore(ctx context.Context, req *cachepb.SunionStoreRequest) (*cachepb.SunionStoreResponse, error) {
+	r, err := s.call(ctx, "sunionstore", req)
+	return r.GetSunionstore(), err
+}
+
+func (s *grpcServer) SdiffStore(ctx context.Context, req *cachepb.SdiffStoreRequest) (*cachepb.SdiffStoreResponse, error) {
+	r, err := s.call(ctx, "sdiffstore", req)
+	return r.GetSdiffstore(), err
+}
+
+func (s *grpcServer) Zadd(ctx context.Context, req *cachepb.ZaddRequest) (*cachepb.ZaddResponse, error) {
+	r, err := s.call(ctx, "zadd", req)
+	return r.GetZadd(), err
+}
+
+func (s *grpcServer) Zrem(ctx context.Context, req *cachepb.ZremRequest) (*cachepb.ZremResponse, error) {
+	r, err := s.call(ctx, "zrem", req)
+	return r.GetZrem(), err
+}
+
+func (s *grpcServer) Zscore(ctx context.Context, req *cachepb.ZscoreRequest) (*cachepb.ZscoreResponse, error) {
+	r, err := s.call(ctx, "zscore", req)
+	return r.GetZscore(), err
+}
+
+func (s *grpcServer) Zrank(ctx context.Context, req *cachepb.ZrankRequest) (*cachepb.ZrankResponse, error) {
+	r, err := s.call(ctx, "zrank", req)
+	return r.GetZrank(), err
+}
+
+func (s *grpcServer) Zrevrank(ctx context.Context, req *cachepb.ZrevrankRequest) (*cachepb.ZrevrankResponse, error) {
+	r, err := s.call(ctx, "zrevrank", req)
+	return r.GetZrevrank(), err
+}
+
+func (s *grpcServer) Zrange(ctx context.Context, req *cachepb.ZrangeRequest) (*cachepb.ZrangeResponse, error) {
+	r, err := s.call(ctx, "zrange", req)
+	return r.GetZrange(), err
+}
+
+func (s *grpcServer) ZrangeByScore(ctx context.Context, req *cachepb.ZrangeByScoreRequest) (*cachepb.ZrangeByScoreResponse, error) {
+	r, err := s.call(ctx, "zrangebyscore", req)
+	return r.GetZrangebyscore(), err
+}
+
+func (s *grpcServer) Zcount(ctx context.Context, req *cachepb.ZcountRequest) (*cachepb.ZcountResponse, error) {
+	r, err := s.call(ctx, "zcount", req)
+	return r.GetZcount(), err
+}
+
+func (s *grpcServer) ZpopMin(ctx context.Context, req *cachepb.ZpopMinRequest) (*cachepb.ZpopMinResponse, error) {
+	r, err := s.call(ctx, "zpopmin", req)
+	return r.GetZpopmin(), err
+}
+
+func (s *grpcServer) ZpopMax(ctx context.Context, req *cachepb.ZpopMaxRequest) (*cachepb.ZpopMaxResponse, error) {
+	r, err := s.call(ctx, "zpopmax", req)
+	return r.GetZpopmax(), err
+}
+
+func (s *grpcServer) Stats(ctx context.Context, req *cachepb.StatsRequest) (*cachepb.StatsResponse, error) {
+	r, err := s.call(ctx, "stats", req)
+	return r.GetStats(), err
+}
+
+func (s *grpcServer) DbSize(ctx context.Context, req *cachepb.DbSizeRequest) (*cachepb.DbSizeResponse, error) {
+	r, err := s.call(ctx, "dbsize", req)
+	return r.GetDbsize(), err
+}
+
+func (s *grpcServer) FlushDb(ctx context.Context, req *cachepb.FlushDbRequest) (*cachepb.FlushDbResponse, error) {
+	r, err := s.call(ctx, "flushdb", req)
+	return r.GetFlushdb(), err
+}
+
+func (s *grpcServer) Move(ctx context.Context, req *cachepb.MoveRequest) (*cachepb.MoveResponse, error) {
+	r, err := s.call(ctx, "move", req)
+	return r.GetMove(), err
+}
@@ -0,0 +1,469 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+)
+
+type listItem struct {
+	header
+	listObject *deque
+}
+
+func newListItem(e int64) *listItem {
+	return &listItem{
+		header:     header{expired: e},
+		listObject: newDeque(nil),
+	}
+}
+
+// listEdit holds the arguments of list commands in the append-only log.
+// Pivot and Value are kept as JSON and read back with jsonValue, so they
+// replay exactly as they were written.
+type listEdit struct {
+	Index  int             `json:"index,omitempty"`
+	Count  int             `json:"count,omitempty"`
+	Start  int             `json:"start,omitempty"`
+	Stop   int             `json:"stop,omitempty"`
+	Before bool            `json:"before,omitempty"`
+	Pivot  json.RawMessage `json:"pivot,omitempty"`
+	Value  json.RawMessage `json:"value,omitempty"`
+}
+
+// lookupList returns the list at key, nil when there is no such key.
+func (s *shard) lookupList(key string) (*listItem, error) {
+	item, found := s.items[key]
+	if !found || s.isExpired(key, item) {
+		return nil, nil
+	}
+
+	li, ok := item.(*listItem)
+	if !ok {
+		return nil, errors.New("wrong type")
+	}
+	li.touch()
+
+	return li, nil
+}
+
+// normalizeRange turns start and stop, where negative indexes count from
+// the end, into indexes within length. The range is empty when start is
+// greater than stop.
+func normalizeRange(start int, stop int, length int) (int, int) {
+	if start < 0 {
+		start += length
+	}
+	if stop < 0 {
+		stop += length
+	}
+	if start < 0 {
+		start = 0
+	}
+	if stop >= length {
+		stop = length - 1
+	}
+
+	return start, stop
+}
+
+func (c *cache) rpush(key string, value interface{}, duration int) (bool, error) {
+	if _, err := c.push(key, []interface{}{value}, false, duration); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// push adds values to the head of the list at key when left is set and to
+// the tail otherwise, and returns the length of the list.
+func (c *cache) push(key string, values []interface{}, left bool, duration int) (int, error) {
+	if err := c.ensureCapacity(key); err != nil {
+		return 0, err
+	}
+
+	e := c.expiration(duration)
+
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.push(key, values, left, e)
+}
+
+// push is the body of cache.push. A zero e keeps the current expiry of the
+// list.
+func (s *shard) push(key string, values []interface{}, left bool, e int64) (int, error) {
+	item, found := s.items[key]
+	if found && s.isExpired(key, item) {
+		s.removeExpired(key)
+		found = false
+	}
+
+	var li *listItem
+	if found {
+		var ok bool
+		if li, ok = item.(*listItem); !ok {
+			return 0, errors.New("invalid object type")
+		}
+	} else {
+		if len(values) == 0 {
+			return 0, nil
+		}
+		li = newListItem(e)
+	}
+
+	var delta int64
+	for _, v := range values {
+		if left {
+			li.listObject.pushFront(v)
+		} else {
+			li.listObject.pushBack(v)
+		}
+		delta += sizeOf(v)
+	}
+
+	if !found {
+		s.store(key, li)
+	} else {
+		if e > 0 {
+			li.expired = e
+		}
+		s.grow(li, delta)
+		li.touch()
+	}
+
+	op := "rpush"
+	if left {
+		op = "lpush"
+	}
+	for _, v := range values {
+		s.c.feed(op, key, v, li.expired)
+	}
+	length := li.listObject.len()
+	s.serveWaiters(key)
+
+	return length, nil
+}
+
+func (c *cache) lgetall(key string) ([]interface{}, error) {
+	s := c.getShard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.lgetall(key)
+}
+
+func (s *shard) lgetall(key string) ([]interface{}, error) {
+	li, err := s.lookupList(key)
+	if err != nil {
+		return nil, err
+	}
+	if li == nil {
+		return nil, errors.New("not found")
+	}
+
+	return li.listObject.values(), nil
+}
+
+// lget returns the element at index id, a negative id counts from the end.
+func (c *cache) lget(key string, id int) (interface{}, error) {
+	s := c.getShard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.lget(key, id)
+}
+
+func (s *shard) lget(key string, id int) (interface{}, error) {
+	li, err := s.lookupList(key)
+	if err != nil {
+		return nil, err
+	}
+	if li == nil {
+		return nil, errors.New("not found")
+	}
+
+	if id < 0 {
+		id += li.listObject.len()
+	}
+	if id < 0 || id >= li.listObject.len() {
+		return nil, errors.New("not found")
+	}
+
+	return li.listObject.at(id), nil
+}
+
+// pop removes and returns the last element of the list.
+func (c *cache) pop(key string) (interface{}, error) {
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.pop(key, false)
+}
+
+// lpop removes and returns the first element of the list.
+func (c *cache) lpop(key string) (interface{}, error) {
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.pop(key, true)
+}
+
+func (s *shard) pop(key string, left bool) (interface{}, error) {
+	li, err := s.lookupList(key)
+	if err != nil {
+		return nil, err
+	}
+	if li == nil {
+		return nil, errors.New("not found")
+	}
+
+	var object interface{}
+	if left {
+		object = li.listObject.popFront()
+		s.c.feed("lpop", key, nil, 0)
+	} else {
+		object = li.listObject.popBack()
+		s.c.feed("pop", key, nil, 0)
+	}
+
+	if li.listObject.len() == 0 {
+		s.remove(key)
+		return object, nil
+	}
+
+	s.grow(li, -sizeOf(object))
+
+	return object, nil
+}
+
+// llen returns the length of the list, zero when there is no such key.
+func (c *cache) llen(key string) (int, error) {
+	s := c.getShard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.llen(key)
+}
+
+func (s *shard) llen(key string) (int, error) {
+	li, err := s.lookupList(key)
+	if err != nil || li == nil {
+		return 0, err
+	}
+
+	return li.listObject.len(), nil
+}
+
+// lrange returns the elements from start to stop inclusive. Negative
+// indexes count from the end.
+func (c *cache) lrange(key string, start int, stop int) ([]interface{}, error) {
+	s := c.getShard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.lrange(key, start, stop)
+}
+
+func (s *shard) lrange(key string, start int, stop int) ([]interface{}, error) {
+	li, err := s.lookupList(key)
+	if err != nil {
+		return nil, err
+	}
+	if li == nil {
+		return nil, errors.New("not found")
+	}
+
+	start, stop = normalizeRange(start, stop, li.listObject.len())
+	if start > stop {
+		return []interface{}{}, nil
+	}
+
+	return li.listObject.slice(start, stop+1), nil
+}
+
+func (c *cache) lset(key string, index int, value interface{}) error {
+	if err := c.ensureCapacity(key); err != nil {
+		return err
+	}
+
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.lset(key, index, value)
+}
+
+// lset replaces the element at index, a negative index counts from the
+// end.
+func (s *shard) lset(key string, index int, value interface{}) error {
+	li, err := s.lookupList(key)
+	if err != nil {
+		return err
+	}
+	if li == nil {
+		return errors.New("not found")
+	}
+
+	if index < 0 {
+		index += li.listObject.len()
+	}
+	if index < 0 || index >= li.listObject.len() {
+		return errors.New("index out of range")
+	}
+
+	old := li.listObject.at(index)
+	li.listObject.set(index, value)
+	s.grow(li, sizeOf(value)-sizeOf(old))
+	s.c.feed("lset", key, listEdit{Index: index, Value: encodeValue(value)}, 0)
+
+	return nil
+}
+
+func (c *cache) lrem(key string, count int, value interface{}) (int, error) {
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.lrem(key, count, value)
+}
+
+// lrem removes elements equal to value and returns how many were removed.
+// A positive count removes up to count elements from the head, a negative
+// one from the tail and zero removes all of them.
+func (s *shard) lrem(key string, count int, value interface{}) (int, error) {
+	li, err := s.lookupList(key)
+	if err != nil || li == nil {
+		return 0, err
+	}
+
+	limit := count
+	if limit < 0 {
+		limit = -limit
+	}
+
+	values := li.listObject.values()
+	removed := make([]bool, len(values))
+	n := 0
+	var delta int64
+	for j := 0; j < len(values) && (limit == 0 || n < limit); j++ {
+		i := j
+		if count < 0 {
+			i = len(values) - 1 - j
+		}
+		if reflect.DeepEqual(values[i], value) {
+			removed[i] = true
+			n++
+			delta += sizeOf(values[i])
+		}
+	}
+
+	if n == 0 {
+		return 0, nil
+	}
+
+	kept := values[:0]
+	for i, v := range values {
+		if !removed[i] {
+			kept = append(kept, v)
+		}
+	}
+	li.listObject = newDeque(kept)
+	s.c.feed("lrem", key, listEdit{Count: count, Value: encodeValue(value)}, 0)
+
+	if li.listObject.len() == 0 {
+		s.remove(key)
+	} else {
+		s.grow(li, -delta)
+	}
+
+	return n, nil
+}
+
+func (c *cache) ltrim(key string, start int, stop int) error {
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.ltrim(key, start, stop)
+}
+
+// ltrim keeps only the elements from start to stop inclusive. The key is
+// deleted when nothing is left.
+func (s *shard) ltrim(key string, start int, stop int) error {
+	li, err := s.lookupList(key)
+	if err != nil || li == nil {
+		return err
+	}
+
+	length := li.listObject.len()
+	start, stop = normalizeRange(start, stop, length)
+	s.c.feed("ltrim", key, listEdit{Start: start, Stop: stop}, 0)
+
+	if start > stop {
+		s.remove(key)
+		return nil
+	}
+
+	var delta int64
+	for i := 0; i < length; i++ {
+		if i < start || i > stop {
+			delta += sizeOf(li.listObject.at(i))
+		}
+	}
+
+	li.listObject = newDeque(li.listObject.slice(start, stop+1))
+	s.grow(li, -delta)
+
+	return nil
+}
+
+func (c *cache) linsert(key string, before bool, pivot interface{}, value interface{}) (int, error) {
+	if err := c.ensureCapacity(key); err != nil {
+		return 0, err
+	}
+
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.linsert(key, before, pivot, value)
+}
+
+// linsert inserts value before or after the first element equal to pivot
+// and returns the length of the list. It returns -1 when there is no such
+// element and 0 when there is no such key.
+func (s *shard) linsert(key string, before bool, pivot interface{}, value interface{}) (int, error) {
+	li, err := s.lookupList(key)
+	if err != nil || li == nil {
+		return 0, err
+	}
+
+	values := li.listObject.values()
+	at := -1
+	for i, v := range values {
+		if reflect.DeepEqual(v, pivot) {
+			at = i
+			break
+		}
+	}
+	if at < 0 {
+		return -1, nil
+	}
+	if !before {
+		at++
+	}
+
+	values = append(values, nil)
+	copy(values[at+1:], values[at:])
+	values[at] = value
+	li.listObject = newDeque(values)
+
+	s.grow(li, sizeOf(value))
+	s.c.feed("linsert", key, listEdit{Before: before, Pivot: encodeValue(pivot), Value: encodeValue(value)}, 0)
+
+	return li.listObject.len(), nil
+}
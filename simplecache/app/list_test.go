@@ -0,0 +1,192 @@
+package app
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestDeque(t *testing.T) {
+	d := newDeque(nil)
+	var expected []interface{}
+	for i := 0; i < 100; i++ {
+		if i%3 == 0 {
+			d.pushFront(i)
+			expected = append([]interface{}{i}, expected...)
+		} else {
+			d.pushBack(i)
+			expected = append(expected, i)
+		}
+	}
+
+	if !reflect.DeepEqual(d.values(), expected) {
+		t.Fatal("Values of deque don't match", d.values())
+	}
+
+	for i := 0; i < 95; i++ {
+		if i%2 == 0 {
+			if v := d.popFront(); v != expected[0] {
+				t.Fatal("Wrong front value", v, expected[0])
+			}
+			expected = expected[1:]
+		} else {
+			if v := d.popBack(); v != expected[len(expected)-1] {
+				t.Fatal("Wrong back value", v, expected[len(expected)-1])
+			}
+			expected = expected[:len(expected)-1]
+		}
+	}
+
+	if !reflect.DeepEqual(d.values(), expected) {
+		t.Error("Values of deque after pops don't match", d.values(), expected)
+	}
+	if len(d.buf) > 4*dequeMinCapacity {
+		t.Error("Buffer of deque isn't shrunk", len(d.buf))
+	}
+}
+
+func TestCache_Push(t *testing.T) {
+	tc := NewCache(0)
+	key := "l"
+
+	length, err := tc.push(key, []interface{}{"b", "c"}, false, 0)
+	if err != nil || length != 2 {
+		t.Error("Push doesn't add 2 values", length, err)
+	}
+
+	length, _ = tc.push(key, []interface{}{"a", "z"}, true, 0)
+	if length != 4 {
+		t.Error("Length after lpush doesn't equals 4", length)
+	}
+
+	if list, _ := tc.lgetall(key); !reflect.DeepEqual(list, []interface{}{"z", "a", "b", "c"}) {
+		t.Error("Lpush doesn't add values to the head one by one", list)
+	}
+
+	if v, _ := tc.lpop(key); v != "z" {
+		t.Error("Lpop doesn't return z", v)
+	}
+	if v, _ := tc.pop(key); v != "c" {
+		t.Error("Pop doesn't return c", v)
+	}
+	if length, _ := tc.llen(key); length != 2 {
+		t.Error("Llen doesn't equals 2", length)
+	}
+	if length, _ := tc.llen("missing"); length != 0 {
+		t.Error("Llen of missing key doesn't equals 0", length)
+	}
+	if v, _ := tc.lget(key, -1); v != "b" {
+		t.Error("Lget -1 doesn't return the last value", v)
+	}
+
+	tc.set("s", "string", 0)
+	if _, err := tc.push("s", []interface{}{"a"}, true, 0); err == nil {
+		t.Error("Lpush to a string doesn't fail")
+	}
+	if _, err := tc.llen("s"); err == nil {
+		t.Error("Llen of a string doesn't fail")
+	}
+}
+
+func TestCache_Lrange(t *testing.T) {
+	tc := NewCache(0)
+	key := "l"
+	tc.push(key, []interface{}{"a", "b", "c", "d", "e"}, false, 0)
+
+	if list, _ := tc.lrange(key, 0, -1); len(list) != 5 {
+		t.Error("Lrange 0 -1 doesn't return all values", list)
+	}
+	if list, _ := tc.lrange(key, -2, 10); !reflect.DeepEqual(list, []interface{}{"d", "e"}) {
+		t.Error("Lrange -2 10 is wrong", list)
+	}
+	if list, _ := tc.lrange(key, 3, 1); len(list) != 0 {
+		t.Error("Lrange 3 1 isn't empty", list)
+	}
+	if _, err := tc.lrange("missing", 0, -1); err == nil {
+		t.Error("Lrange of missing key doesn't fail")
+	}
+}
+
+func TestCache_ListEdits(t *testing.T) {
+	tc := NewCache(0)
+	key := "l"
+	tc.push(key, []interface{}{"a", "x", "b", "x", "c", "x"}, false, 0)
+
+	if err := tc.lset(key, -1, "y"); err != nil {
+		t.Error("Lset -1 fails", err)
+	}
+	if err := tc.lset(key, 6, "y"); err == nil {
+		t.Error("Lset out of range doesn't fail")
+	}
+
+	if removed, _ := tc.lrem(key, -1, "x"); removed != 1 {
+		t.Error("Lrem -1 doesn't remove 1 value", removed)
+	}
+	if list, _ := tc.lgetall(key); !reflect.DeepEqual(list, []interface{}{"a", "x", "b", "c", "y"}) {
+		t.Error("Lrem -1 doesn't remove the last x", list)
+	}
+	if removed, _ := tc.lrem(key, 0, "x"); removed != 1 {
+		t.Error("Lrem 0 doesn't remove all x", removed)
+	}
+
+	if length, _ := tc.linsert(key, true, "b", "before"); length != 5 {
+		t.Error("Linsert before b doesn't return 5", length)
+	}
+	if length, _ := tc.linsert(key, false, "y", "after"); length != 6 {
+		t.Error("Linsert after y doesn't return 6", length)
+	}
+	if length, _ := tc.linsert(key, false, "missing", "v"); length != -1 {
+		t.Error("Linsert with missing pivot doesn't return -1", length)
+	}
+	if list, _ := tc.lgetall(key); !reflect.DeepEqual(list, []interface{}{"a", "before", "b", "c", "y", "after"}) {
+		t.Error("Linsert is wrong", list)
+	}
+
+	tc.ltrim(key, 1, -2)
+	if list, _ := tc.lgetall(key); !reflect.DeepEqual(list, []interface{}{"before", "b", "c", "y"}) {
+		t.Error("Ltrim 1 -2 is wrong", list)
+	}
+
+	tc.ltrim(key, 5, 10)
+	if _, err := tc.lgetall(key); err == nil {
+		t.Error("Empty list after ltrim wasn't deleted")
+	}
+	if tc.stats().UsedMemory != 0 {
+		t.Error("Memory of deleted list isn't released", tc.stats().UsedMemory)
+	}
+}
+
+func TestCache_ListPersistence(t *testing.T) {
+	dir := t.TempDir()
+	aofPath := filepath.Join(dir, "cache.aof")
+	snapshotPath := filepath.Join(dir, "dump.json")
+
+	tc := NewCache(0)
+	if err := tc.enableAOF(aofPath, FsyncNever, 0); err != nil {
+		t.Fatal(err)
+	}
+	tc.push("l", []interface{}{"b", "c", "d", "c"}, false, 0)
+	tc.push("l", []interface{}{"a"}, true, 0)
+	tc.lset("l", 0, "A")
+	tc.lrem("l", 1, "c")
+	tc.linsert("l", false, "b", "x")
+	tc.ltrim("l", 0, -2)
+	tc.lpop("l")
+	tc.saveSnapshot(snapshotPath)
+	tc.disableAOF()
+
+	expected := []interface{}{"b", "x", "d"}
+	for _, load := range []func(*cache) error{
+		func(c *cache) error { return c.replayAOF(aofPath) },
+		func(c *cache) error { return c.loadSnapshot(snapshotPath) },
+	} {
+		lc := NewCache(0)
+		if err := load(lc); err != nil {
+			t.Fatal(err)
+		}
+
+		if list, _ := lc.lgetall("l"); !reflect.DeepEqual(list, expected) {
+			t.Error("List isn't restored", list)
+		}
+	}
+}
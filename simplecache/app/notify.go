@@ -0,0 +1,87 @@
+package app
+
+import (
+	"errors"
+	"time"
+)
+
+// keyspaceEvent tells keyspace subscribers that a key was changed.
+type keyspaceEvent struct {
+	Key       string    `json:"key"`
+	Op        string    `json:"op"`
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// keyspaceOps are the operations keyspace events are emitted for, they are
+// the operations written to the append-only file. The type of a deleted,
+// expired or evicted key is the type it had.
+var keyspaceOps = []string{
+	"set", "del", "expire", "expired", "evicted",
+	"rpush", "lpush", "pop", "lpop", "lset", "lrem", "ltrim", "linsert",
+	"hset", "hdel", "zadd", "zrem", "sadd", "srem", "move_from", "move_to",
+	"restore",
+}
+
+// keyspacePatterns returns the patterns a keyspace subscriber is subscribed
+// to. Events are published to the channel op:key, so the patterns select
+// both the operations and the keys. No ops means all of them and an empty
+// pattern matches every key.
+func keyspacePatterns(pattern string, ops []string) ([]string, error) {
+	if pattern == "" {
+		pattern = "*"
+	}
+	if len(ops) == 0 {
+		ops = keyspaceOps
+	}
+
+	patterns := make([]string, 0, len(ops))
+	for _, op := range ops {
+		if !knownKeyspaceOp(op) {
+			return nil, errors.New("unknown event " + op)
+		}
+		patterns = append(patterns, op+":"+pattern)
+	}
+
+	return patterns, nil
+}
+
+func knownKeyspaceOp(op string) bool {
+	for _, known := range keyspaceOps {
+		if op == known {
+			return true
+		}
+	}
+
+	return false
+}
+
+// notify publishes a keyspace event for the item at key, which may be nil.
+// It's called under the shard lock. An item is announced as expired only
+// once, by the read that finds it expired or by the janitor.
+func (c *cache) notify(op string, key string, i item) {
+	if op == "expired" && i != nil && !i.getHeader().expiryNotified.CompareAndSwap(false, true) {
+		return
+	}
+	if c.keyspace.count() == 0 {
+		return
+	}
+
+	c.keyspace.publish(op+":"+key, keyspaceEvent{
+		Key:       key,
+		Op:        op,
+		Type:      typeOf(i),
+		Timestamp: time.Now(),
+	})
+}
+
+// isExpired is expired for lookups by key, an expired item is announced to
+// keyspace subscribers. It's safe under the read lock.
+func (s *shard) isExpired(key string, i item) bool {
+	if s.replaying || !expired(i) {
+		return false
+	}
+	s.c.notify("expired", key, i)
+
+	return true
+}
@@ -0,0 +1,143 @@
+package app
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func receiveEvent(t *testing.T, s *subscriber) keyspaceEvent {
+	return receive(t, s).Payload.(keyspaceEvent)
+}
+
+func TestCache_Notify(t *testing.T) {
+	tc := NewCache(time.Hour)
+	patterns, _ := keyspacePatterns("user:*", nil)
+	sub := tc.keyspace.subscribe(nil, patterns)
+
+	tc.set("user:1", "a", 0)
+	tc.rpush("user:2", "x", 0)
+	tc.pop("user:2")
+	tc.hset("user:3", map[string]interface{}{"f": "v"}, 0)
+	tc.set("other", "b", 0)
+	tc.deleteItem("user:1")
+
+	for _, want := range []keyspaceEvent{
+		{Key: "user:1", Op: "set", Type: simpleType},
+		{Key: "user:2", Op: "rpush", Type: listType},
+		{Key: "user:2", Op: "pop", Type: listType},
+		{Key: "user:3", Op: "hset", Type: dictType},
+		{Key: "user:1", Op: "del", Type: simpleType},
+	} {
+		e := receiveEvent(t, sub)
+		if e.Key != want.Key || e.Op != want.Op || e.Type != want.Type || e.Timestamp.IsZero() {
+			t.Error("Event is wrong", e, want)
+		}
+	}
+
+	select {
+	case m := <-sub.messages:
+		t.Error("Event of other key is delivered", m)
+	default:
+	}
+}
+
+func TestCache_NotifyByEvent(t *testing.T) {
+	tc := NewCache(time.Hour)
+	if _, err := keyspacePatterns("*", []string{"unknown"}); err == nil {
+		t.Error("Unknown event is accepted")
+	}
+	patterns, _ := keyspacePatterns("", []string{"del", "evicted"})
+	sub := tc.keyspace.subscribe(nil, patterns)
+
+	tc.set("a", "1", 0)
+	tc.set("b", "2", 0)
+	tc.deleteItem("a")
+	tc.EvictionPolicy = AllKeysRandom
+	tc.evictOne("")
+
+	if e := receiveEvent(t, sub); e.Key != "a" || e.Op != "del" {
+		t.Error("Del event is wrong", e)
+	}
+	if e := receiveEvent(t, sub); e.Key != "b" || e.Op != "evicted" || e.Type != simpleType {
+		t.Error("Evicted event is wrong", e)
+	}
+}
+
+func TestCache_NotifyExpired(t *testing.T) {
+	tc := NewCache(time.Hour)
+	tc.ExpiredTimeMultiplier = time.Millisecond
+	patterns, _ := keyspacePatterns("", []string{"expired"})
+	sub := tc.keyspace.subscribe(nil, patterns)
+
+	tc.set("read", "a", 10)
+	tc.rpush("janitor", "x", 10)
+	<-time.After(20 * time.Millisecond)
+
+	if _, err := tc.get("read"); err == nil {
+		t.Error("Expired key is found")
+	}
+	if e := receiveEvent(t, sub); e.Key != "read" || e.Type != simpleType {
+		t.Error("Expired event of read is wrong", e)
+	}
+
+	tc.get("read")
+	tc.DeleteExpired()
+	if e := receiveEvent(t, sub); e.Key != "janitor" || e.Type != listType {
+		t.Error("Expired event of janitor is wrong", e)
+	}
+
+	select {
+	case m := <-sub.messages:
+		t.Error("Expiry is announced twice", m)
+	default:
+	}
+}
+
+func TestApp_Notifications(t *testing.T) {
+	a, server := newTestServer(t)
+
+	resp, err := http.Get(server.URL + "/notifications?pattern=user:*&event=set")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	for i := 0; a.cache.keyspace.count() < 1; i++ {
+		if i == 100 {
+			t.Fatal("Subscriber isn't registered")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	a.cache.set("user:1", "a", 0)
+
+	reader := bufio.NewReader(resp.Body)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.HasPrefix(line, "data: ") {
+			var e keyspaceEvent
+			if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &e); err != nil {
+				t.Fatal(err)
+			}
+			if e.Key != "user:1" || e.Op != "set" || e.Type != simpleType {
+				t.Error("Event is wrong", e)
+			}
+			break
+		}
+	}
+
+	resp, err = http.Get(server.URL + "/notifications?event=unknown")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Error("Unknown event doesn't return 400", resp.StatusCode)
+	}
+}
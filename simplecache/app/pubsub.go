@@ -0,0 +1,126 @@
+package app
+
+import (
+	"sync"
+)
+
+const defaultSubscriberBuffer = 256
+
+// message is a published message as it is delivered to subscribers.
+// Pattern is set when the subscriber got it by a pattern.
+type message struct {
+	Channel string      `json:"channel"`
+	Pattern string      `json:"pattern,omitempty"`
+	Payload interface{} `json:"payload"`
+}
+
+// subscriber receives the messages of its channels and of the channels
+// matching its glob patterns.
+type subscriber struct {
+	channels map[string]bool
+	patterns []string
+	messages chan message
+	// dropped is closed when the subscriber is disconnected because its
+	// buffer is full.
+	dropped chan struct{}
+}
+
+// match reports whether the subscriber gets messages of the channel and by
+// which pattern.
+func (s *subscriber) match(channel string) (string, bool) {
+	if s.channels[channel] {
+		return "", true
+	}
+	for _, p := range s.patterns {
+		if globMatch(p, channel) {
+			return p, true
+		}
+	}
+
+	return "", false
+}
+
+type pubsub struct {
+	mu          sync.RWMutex
+	subscribers map[*subscriber]struct{}
+	// bufferSize is the number of messages a subscriber may fall behind
+	// before it is disconnected.
+	bufferSize int
+}
+
+func newPubsub(bufferSize int) *pubsub {
+	return &pubsub{
+		subscribers: map[*subscriber]struct{}{},
+		bufferSize:  bufferSize,
+	}
+}
+
+func (p *pubsub) subscribe(channels []string, patterns []string) *subscriber {
+	s := &subscriber{
+		channels: map[string]bool{},
+		patterns: patterns,
+		messages: make(chan message, p.bufferSize),
+		dropped:  make(chan struct{}),
+	}
+	for _, c := range channels {
+		s.channels[c] = true
+	}
+
+	p.mu.Lock()
+	p.subscribers[s] = struct{}{}
+	p.mu.Unlock()
+
+	return s
+}
+
+func (p *pubsub) unsubscribe(s *subscriber) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, found := p.subscribers[s]; !found {
+		return false
+	}
+	delete(p.subscribers, s)
+
+	return true
+}
+
+// publish delivers the payload to the subscribers of the channel and
+// returns how many got it. Publishing never waits for a subscriber, one
+// with a full buffer is disconnected instead.
+func (p *pubsub) publish(channel string, payload interface{}) int {
+	var slow []*subscriber
+	receivers := 0
+
+	p.mu.RLock()
+	for s := range p.subscribers {
+		pattern, ok := s.match(channel)
+		if !ok {
+			continue
+		}
+
+		select {
+		case s.messages <- message{Channel: channel, Pattern: pattern, Payload: payload}:
+			receivers++
+		default:
+			slow = append(slow, s)
+		}
+	}
+	p.mu.RUnlock()
+
+	for _, s := range slow {
+		if p.unsubscribe(s) {
+			close(s.dropped)
+		}
+	}
+
+	return receivers
+}
+
+// count returns the number of subscribers.
+func (p *pubsub) count() int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return len(p.subscribers)
+}
@@ -0,0 +1,178 @@
+package app
+
+import (
+	"bufio"
+	"encoding/json"
+	"golang.org/x/net/websocket"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func receive(t *testing.T, s *subscriber) message {
+	select {
+	case m := <-s.messages:
+		return m
+	case <-time.After(time.Second):
+		t.Fatal("Message isn't delivered")
+	}
+
+	return message{}
+}
+
+func TestPubsub_Publish(t *testing.T) {
+	p := newPubsub(8)
+	orders := p.subscribe([]string{"orders"}, nil)
+	news := p.subscribe(nil, []string{"news.*"})
+
+	if receivers := p.publish("orders", "order:1"); receivers != 1 {
+		t.Error("Publish to orders doesn't reach 1 subscriber", receivers)
+	}
+	if m := receive(t, orders); m.Channel != "orders" || m.Payload != "order:1" || m.Pattern != "" {
+		t.Error("Message of orders is wrong", m)
+	}
+
+	p.publish("news.sport", "goal")
+	if m := receive(t, news); m.Channel != "news.sport" || m.Pattern != "news.*" {
+		t.Error("Message by pattern is wrong", m)
+	}
+
+	if receivers := p.publish("weather", "rain"); receivers != 0 {
+		t.Error("Publish to channel without subscribers reaches somebody", receivers)
+	}
+
+	p.unsubscribe(orders)
+	if receivers := p.publish("orders", "order:2"); receivers != 0 {
+		t.Error("Unsubscribed subscriber gets messages", receivers)
+	}
+}
+
+func TestPubsub_SlowSubscriberIsDropped(t *testing.T) {
+	p := newPubsub(2)
+	slow := p.subscribe([]string{"c"}, nil)
+	fast := p.subscribe([]string{"c"}, nil)
+
+	for i := 0; i < 3; i++ {
+		p.publish("c", i)
+		receive(t, fast)
+	}
+
+	select {
+	case <-slow.dropped:
+	default:
+		t.Error("Slow subscriber isn't dropped")
+	}
+	if p.count() != 1 {
+		t.Error("Slow subscriber isn't removed", p.count())
+	}
+}
+
+func newTestServer(t *testing.T) (*App, *httptest.Server) {
+	a, err := NewApp(Config{Shards: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.Initialize()
+
+	server := httptest.NewServer(a.Router)
+	t.Cleanup(server.Close)
+
+	return a, server
+}
+
+// waitForSubscribers waits until the server registered n subscribers.
+func waitForSubscribers(t *testing.T, a *App, n int) {
+	for i := 0; a.cache.pubsub.count() < n; i++ {
+		if i == 100 {
+			t.Fatal("Subscriber isn't registered")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestApp_PublishValues(t *testing.T) {
+	a, server := newTestServer(t)
+	sub := a.cache.pubsub.subscribe([]string{"orders"}, nil)
+	defer a.cache.pubsub.unsubscribe(sub)
+
+	// Messages are stored values, the same as over gRPC.
+	for _, tc := range []struct {
+		message string
+		want    interface{}
+	}{
+		{`9007199254740993`, json.Number("9007199254740993")},
+		{`"order:1"`, "order:1"},
+		{`{"id": 1}`, json.RawMessage(`{"id": 1}`)},
+	} {
+		var response map[string]interface{}
+		if code := dbRequest(t, "POST", server.URL+"/publish", "", `{"channel":"orders","message":`+tc.message+`}`, &response); code != http.StatusCreated || response["receivers"] != 1.0 {
+			t.Fatal("Publish fails", code, response)
+		}
+		if m := receive(t, sub); !reflect.DeepEqual(m.Payload, tc.want) {
+			t.Errorf("Message %s is delivered as %#v", tc.message, m.Payload)
+		}
+	}
+}
+
+func TestApp_SubscribeSSE(t *testing.T) {
+	a, server := newTestServer(t)
+
+	resp, err := http.Get(server.URL + "/subscribe?channel=orders&pattern=news.*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.Header.Get("Content-Type") != "text/event-stream" {
+		t.Error("Content type isn't text/event-stream", resp.Header.Get("Content-Type"))
+	}
+
+	waitForSubscribers(t, a, 1)
+	a.cache.pubsub.publish("news.sport", "goal")
+
+	reader := bufio.NewReader(resp.Body)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.HasPrefix(line, "data: ") {
+			if line != `data: {"channel":"news.sport","pattern":"news.*","payload":"goal"}`+"\n" {
+				t.Error("Event is wrong", line)
+			}
+			break
+		}
+	}
+}
+
+func TestApp_SubscribeWebSocket(t *testing.T) {
+	a, server := newTestServer(t)
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws/subscribe?channel=orders"
+	ws, err := websocket.Dial(url, "", server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ws.Close()
+
+	waitForSubscribers(t, a, 1)
+	a.cache.pubsub.publish("orders", map[string]interface{}{"id": 1.0})
+
+	var m message
+	if err := websocket.JSON.Receive(ws, &m); err != nil {
+		t.Fatal(err)
+	}
+	if m.Channel != "orders" || m.Payload.(map[string]interface{})["id"] != 1.0 {
+		t.Error("Message is wrong", m)
+	}
+
+	ws.Close()
+	for i := 0; a.cache.pubsub.count() > 0; i++ {
+		if i == 100 {
+			t.Fatal("Subscriber isn't removed after the client goes away")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
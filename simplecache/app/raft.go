@@ -0,0 +1,1151 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Roles of a raft node.
+const (
+	raftFollower  = "follower"
+	raftCandidate = "candidate"
+	raftLeader    = "leader"
+)
+
+// Types of raft log entries. A command is applied to the state machine, a
+// noop starts the term of a leader and a config entry holds the members of
+// the group from the moment it's appended.
+const (
+	raftCommandEntry = ""
+	raftNoopEntry    = "noop"
+	raftConfigEntry  = "config"
+)
+
+const defaultRaftSnapshotEntries = 10000
+
+var (
+	// raftHeartbeatInterval is how often a leader contacts its followers.
+	// A follower that hears nothing from a leader for raftElectionTimeout
+	// plus a random part of it starts an election, and a leader that
+	// hears from no majority for as long steps down.
+	raftHeartbeatInterval = 50 * time.Millisecond
+	raftElectionTimeout   = 500 * time.Millisecond
+	raftRPCTimeout        = time.Second
+	// raftMaxAppendEntries is the most entries sent in one AppendEntries.
+	raftMaxAppendEntries = 256
+)
+
+var (
+	errRaftStopped        = errors.New("raft node is stopped")
+	errRaftLostLeadership = errors.New("leadership was lost, the command may or may not be applied")
+	errRaftMembership     = errors.New("another membership change is in progress")
+)
+
+// raftNotLeader is the error of a proposal to a node that isn't the
+// leader, with the leader if the node knows it.
+type raftNotLeader struct {
+	leader clusterNode
+}
+
+// message is the error with addr, the address of the leader for the
+// protocol of the request.
+func (e *raftNotLeader) message(addr string) string {
+	if addr == "" {
+		return "NOTLEADER no leader is elected"
+	}
+
+	return "NOTLEADER " + addr
+}
+
+func (e *raftNotLeader) Error() string {
+	return e.message(e.leader.Addr)
+}
+
+// raftEntry is an entry of the raft log. applied marks an entry the node
+// applied when it appended it as the leader, see raftNode.resolve. It's
+// neither stored nor sent.
+type raftEntry struct {
+	Index int64           `json:"index"`
+	Term  int64           `json:"term"`
+	Type  string          `json:"type,omitempty"`
+	Data  json.RawMessage `json:"data,omitempty"`
+
+	applied bool
+}
+
+type raftVoteRequest struct {
+	Term      int64  `json:"term"`
+	Candidate string `json:"candidate"`
+	LastIndex int64  `json:"last_index"`
+	LastTerm  int64  `json:"last_term"`
+}
+
+type raftVoteResponse struct {
+	Term    int64 `json:"term"`
+	Granted bool  `json:"granted"`
+}
+
+// raftAppendRequest carries the entries after PrevIndex to a follower, or
+// none as a heartbeat. Leader has the addresses of all APIs of the leader,
+// so followers can redirect clients to it.
+type raftAppendRequest struct {
+	Term      int64       `json:"term"`
+	Leader    clusterNode `json:"leader"`
+	PrevIndex int64       `json:"prev_index"`
+	PrevTerm  int64       `json:"prev_term"`
+	Entries   []raftEntry `json:"entries,omitempty"`
+	Commit    int64       `json:"commit"`
+}
+
+// raftAppendResponse answers AppendEntries and InstallSnapshot. Match is
+// the last index the follower shares with the leader after a success,
+// Conflict the index the leader should retry from after a failure.
+type raftAppendResponse struct {
+	Term     int64 `json:"term"`
+	Success  bool  `json:"success"`
+	Match    int64 `json:"match,omitempty"`
+	Conflict int64 `json:"conflict,omitempty"`
+}
+
+// raftSnapshotRequest replaces the state of a follower that is behind the
+// log of the leader with a snapshot at Index.
+type raftSnapshotRequest struct {
+	Term     int64           `json:"term"`
+	Leader   clusterNode     `json:"leader"`
+	Index    int64           `json:"index"`
+	LastTerm int64           `json:"last_term"`
+	Members  []string        `json:"members"`
+	Data     json.RawMessage `json:"data"`
+}
+
+// raftTransport sends the RPCs of a node to the node at addr.
+type raftTransport interface {
+	requestVote(ctx context.Context, addr string, req *raftVoteRequest) (*raftVoteResponse, error)
+	appendEntries(ctx context.Context, addr string, req *raftAppendRequest) (*raftAppendResponse, error)
+	installSnapshot(ctx context.Context, addr string, req *raftSnapshotRequest) (*raftAppendResponse, error)
+}
+
+// raftStateMachine is what a raft node replicates. apply applies a
+// committed command the node didn't resolve itself, snapshot encodes the
+// state after the last applied command and restore replaces the state
+// with a snapshot. They are never called concurrently, nor while a write
+// is resolved.
+type raftStateMachine struct {
+	apply    func(e raftEntry) interface{}
+	snapshot func() ([]byte, error)
+	restore  func(data []byte) error
+}
+
+type raftResult struct {
+	value interface{}
+	err   error
+}
+
+// raftWaiter waits for the entry a leader appended in term to be applied.
+type raftWaiter struct {
+	term int64
+	ch   chan raftResult
+}
+
+type raftReplicator struct {
+	cancel  context.CancelFunc
+	trigger chan struct{}
+}
+
+// raftNode is a member of a raft group, see the raft paper and the
+// dissertation of Diego Ongaro. Members are identified by the URL of
+// their HTTP API and membership changes add or remove one member at a time.
+type raftNode struct {
+	self            clusterNode
+	transport       raftTransport
+	storage         *raftStorage
+	sm              raftStateMachine
+	snapshotEntries int64
+
+	mu   sync.Mutex
+	cond *sync.Cond
+	role string
+	term int64
+	vote string
+	// leader is the leader of the term, heard when it was last heard of.
+	leader clusterNode
+	heard  time.Time
+	// members come from the config entry at configIndex, or from the
+	// snapshot.
+	members     []string
+	configIndex int64
+	// log holds the entries after the snapshot at snapIndex.
+	log         []raftEntry
+	snapIndex   int64
+	snapTerm    int64
+	snapMembers []string
+	snapData    []byte
+	commitIndex int64
+	lastApplied int64
+	deadline    time.Time
+	votes       map[string]bool
+	stopped     bool
+	// diverged is set when an entry the node applied as a leader is
+	// removed from the log, the applier rebuilds the state then.
+	diverged bool
+
+	// Leader state. termStart is the index of the noop entry of the term,
+	// acked when followers last answered.
+	termStart   int64
+	nextIndex   map[string]int64
+	matchIndex  map[string]int64
+	acked       map[string]time.Time
+	replicators map[string]*raftReplicator
+	waiters     map[int64]*raftWaiter
+
+	// applyMu is held while commands are applied, resolved or rebuilt and
+	// snapshots are taken or installed. resolveMu lets one write at a
+	// time be resolved.
+	applyMu   sync.Mutex
+	resolveMu sync.Mutex
+	ctx       context.Context
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+// newRaftNode creates a node with the state kept in storage, or a new
+// group of peers when there is none. A node without peers waits to be
+// added to a group.
+func newRaftNode(self clusterNode, peers []string, transport raftTransport, storage *raftStorage, sm raftStateMachine, snapshotEntries int) (*raftNode, error) {
+	if snapshotEntries <= 0 {
+		snapshotEntries = defaultRaftSnapshotEntries
+	}
+
+	n := &raftNode{
+		self:            self,
+		transport:       transport,
+		storage:         storage,
+		sm:              sm,
+		snapshotEntries: int64(snapshotEntries),
+		role:            raftFollower,
+		snapMembers:     peers,
+		members:         peers,
+		replicators:     map[string]*raftReplicator{},
+		waiters:         map[int64]*raftWaiter{},
+	}
+	n.cond = sync.NewCond(&n.mu)
+	n.ctx, n.cancel = context.WithCancel(context.Background())
+
+	if storage != nil {
+		state, snap, entries, err := storage.load()
+		if err != nil {
+			return nil, err
+		}
+		n.term, n.vote = state.Term, state.Vote
+		if snap != nil {
+			if err := sm.restore(snap.Data); err != nil {
+				return nil, err
+			}
+			n.snapIndex, n.snapTerm, n.snapMembers, n.snapData = snap.Index, snap.Term, snap.Members, snap.Data
+			n.commitIndex, n.lastApplied = snap.Index, snap.Index
+		} else if err := storage.saveSnapshot(raftSnapshot{Members: peers}); err != nil {
+			return nil, err
+		}
+		n.log = entries
+		n.members, n.configIndex = n.membersAt(n.lastIndex())
+	}
+
+	return n, nil
+}
+
+func (n *raftNode) start() {
+	n.mu.Lock()
+	n.resetDeadline()
+	n.mu.Unlock()
+
+	n.wg.Add(2)
+	go n.run()
+	go n.runApplier()
+}
+
+func (n *raftNode) stop() {
+	n.mu.Lock()
+	if n.stopped {
+		n.mu.Unlock()
+		return
+	}
+	n.stopped = true
+	n.stopReplicators()
+	n.cond.Broadcast()
+	n.mu.Unlock()
+
+	n.cancel()
+	n.wg.Wait()
+
+	if n.storage != nil {
+		if err := n.storage.close(); err != nil {
+			log.Println("raft:", err)
+		}
+	}
+}
+
+// run starts elections when the leader is silent and makes a leader that
+// lost its majority step down.
+func (n *raftNode) run() {
+	defer n.wg.Done()
+
+	ticker := time.NewTicker(raftElectionTimeout / 10)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-n.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		n.mu.Lock()
+		switch {
+		case n.role == raftLeader:
+			acked := map[string]bool{n.self.Addr: true}
+			for addr, at := range n.acked {
+				if time.Since(at) < raftElectionTimeout {
+					acked[addr] = true
+				}
+			}
+			if !n.quorum(acked) {
+				log.Println("raft: no majority, stepping down in term", n.term)
+				n.becomeFollower()
+			}
+		case n.isMember(n.self.Addr) && time.Now().After(n.deadline):
+			n.campaign()
+		}
+		n.mu.Unlock()
+	}
+}
+
+func (n *raftNode) resetDeadline() {
+	n.deadline = time.Now().Add(raftElectionTimeout + time.Duration(rand.Int63n(int64(raftElectionTimeout))))
+}
+
+func (n *raftNode) lastIndex() int64 {
+	return n.snapIndex + int64(len(n.log))
+}
+
+// entry returns the entry at index, which must be in the log.
+func (n *raftNode) entry(index int64) raftEntry {
+	return n.log[index-n.snapIndex-1]
+}
+
+// termAt returns the term of the entry at index, -1 when it was compacted.
+func (n *raftNode) termAt(index int64) int64 {
+	switch {
+	case index == n.snapIndex:
+		return n.snapTerm
+	case index < n.snapIndex || index > n.lastIndex():
+		return -1
+	}
+
+	return n.entry(index).Term
+}
+
+// membersAt returns the members of the group at index with the index of
+// the entry they come from.
+func (n *raftNode) membersAt(index int64) ([]string, int64) {
+	for i := index; i > n.snapIndex; i-- {
+		if e := n.entry(i); e.Type == raftConfigEntry {
+			var members []string
+			json.Unmarshal(e.Data, &members)
+			return members, i
+		}
+	}
+
+	return n.snapMembers, n.snapIndex
+}
+
+func (n *raftNode) isMember(addr string) bool {
+	for _, m := range n.members {
+		if m == addr {
+			return true
+		}
+	}
+
+	return false
+}
+
+// quorum reports whether a majority of the members is in set.
+func (n *raftNode) quorum(set map[string]bool) bool {
+	count := 0
+	for _, m := range n.members {
+		if set[m] {
+			count++
+		}
+	}
+
+	return count > len(n.members)/2
+}
+
+func (n *raftNode) persistState() {
+	if n.storage == nil {
+		return
+	}
+	if err := n.storage.saveState(raftHardState{Term: n.term, Vote: n.vote}); err != nil {
+		log.Println("raft:", err)
+	}
+}
+
+// observeTerm adopts a newer term as a follower and reports whether it did.
+func (n *raftNode) observeTerm(term int64) bool {
+	if term <= n.term {
+		return false
+	}
+
+	n.term = term
+	n.vote = ""
+	n.leader = clusterNode{}
+	n.persistState()
+	n.becomeFollower()
+
+	return true
+}
+
+func (n *raftNode) becomeFollower() {
+	if n.role == raftLeader {
+		n.leader = clusterNode{}
+	}
+	n.role = raftFollower
+	n.stopReplicators()
+	n.resetDeadline()
+}
+
+func (n *raftNode) campaign() {
+	n.term++
+	n.role = raftCandidate
+	n.vote = n.self.Addr
+	n.leader = clusterNode{}
+	n.persistState()
+	n.resetDeadline()
+	n.votes = map[string]bool{n.self.Addr: true}
+	if n.quorum(n.votes) {
+		n.becomeLeader()
+		return
+	}
+
+	req := &raftVoteRequest{Term: n.term, Candidate: n.self.Addr, LastIndex: n.lastIndex(), LastTerm: n.termAt(n.lastIndex())}
+	for _, addr := range n.members {
+		if addr == n.self.Addr {
+			continue
+		}
+		go func(addr string) {
+			ctx, cancel := context.WithTimeout(n.ctx, raftRPCTimeout)
+			defer cancel()
+
+			resp, err := n.transport.requestVote(ctx, addr, req)
+			if err != nil {
+				return
+			}
+
+			n.mu.Lock()
+			defer n.mu.Unlock()
+
+			if n.stopped || n.observeTerm(resp.Term) || n.role != raftCandidate || n.term != req.Term || !resp.Granted {
+				return
+			}
+			n.votes[addr] = true
+			if n.quorum(n.votes) {
+				n.becomeLeader()
+			}
+		}(addr)
+	}
+}
+
+func (n *raftNode) becomeLeader() {
+	log.Println("raft: leader of term", n.term)
+	n.role = raftLeader
+	n.leader = n.self
+	n.nextIndex = map[string]int64{}
+	n.matchIndex = map[string]int64{}
+	n.acked = map[string]time.Time{}
+	now := time.Now()
+	for _, addr := range n.members {
+		n.nextIndex[addr] = n.lastIndex() + 1
+		n.acked[addr] = now
+	}
+
+	// Entries of earlier terms are committed once an entry of this term is.
+	n.termStart = n.appendLocal(raftNoopEntry, nil).Index
+	n.syncReplicators()
+	n.advanceCommit()
+}
+
+// appendLocal appends an entry of the leader to its log.
+func (n *raftNode) appendLocal(typ string, data []byte) raftEntry {
+	e := raftEntry{Index: n.lastIndex() + 1, Term: n.term, Type: typ, Data: data}
+	n.log = append(n.log, e)
+	if n.storage != nil {
+		if err := n.storage.append([]raftEntry{e}); err != nil {
+			log.Println("raft:", err)
+		}
+	}
+	if typ == raftConfigEntry {
+		json.Unmarshal(data, &n.members)
+		n.configIndex = e.Index
+		n.syncReplicators()
+	}
+	for _, r := range n.replicators {
+		r.notify()
+	}
+
+	return e
+}
+
+// syncReplicators runs a replicator for every other member of a leader.
+// Members removed by a config entry that isn't committed yet keep theirs,
+// so they learn about their removal.
+func (n *raftNode) syncReplicators() {
+	if n.role != raftLeader {
+		n.stopReplicators()
+		return
+	}
+
+	targets := map[string]bool{}
+	for _, addr := range n.members {
+		targets[addr] = true
+	}
+	if n.configIndex > n.commitIndex {
+		previous, _ := n.membersAt(n.configIndex - 1)
+		for _, addr := range previous {
+			targets[addr] = true
+		}
+	}
+	delete(targets, n.self.Addr)
+
+	for addr, r := range n.replicators {
+		if !targets[addr] {
+			r.cancel()
+			delete(n.replicators, addr)
+		}
+	}
+	for addr := range targets {
+		if _, found := n.replicators[addr]; found {
+			continue
+		}
+		if _, found := n.nextIndex[addr]; !found {
+			n.nextIndex[addr] = n.lastIndex() + 1
+			n.acked[addr] = time.Now()
+		}
+		ctx, cancel := context.WithCancel(n.ctx)
+		r := &raftReplicator{cancel: cancel, trigger: make(chan struct{}, 1)}
+		n.replicators[addr] = r
+		go n.replicate(ctx, addr, r.trigger)
+	}
+}
+
+func (n *raftNode) stopReplicators() {
+	for addr, r := range n.replicators {
+		r.cancel()
+		delete(n.replicators, addr)
+	}
+}
+
+func (r *raftReplicator) notify() {
+	select {
+	case r.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// replicate sends the log of the leader to the member at addr, at once
+// when there is something new and as a heartbeat otherwise.
+func (n *raftNode) replicate(ctx context.Context, addr string, trigger <-chan struct{}) {
+	heartbeat := time.NewTicker(raftHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		for n.sendTo(ctx, addr) {
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-trigger:
+		case <-heartbeat.C:
+		}
+	}
+}
+
+// sendTo sends the next entries, or the snapshot, to a follower and
+// reports whether there's more to send right away.
+func (n *raftNode) sendTo(ctx context.Context, addr string) bool {
+	n.mu.Lock()
+	if n.role != raftLeader || ctx.Err() != nil {
+		n.mu.Unlock()
+		return false
+	}
+	term := n.term
+	next := n.nextIndex[addr]
+
+	if next <= n.snapIndex {
+		req := &raftSnapshotRequest{Term: term, Leader: n.self, Index: n.snapIndex, LastTerm: n.snapTerm, Members: n.snapMembers, Data: n.snapData}
+		n.mu.Unlock()
+
+		ctx, cancel := context.WithTimeout(ctx, 10*raftRPCTimeout)
+		defer cancel()
+		resp, err := n.transport.installSnapshot(ctx, addr, req)
+		if err != nil {
+			return false
+		}
+
+		return n.handleAppendResponse(addr, term, req.Index, resp) && resp.Success
+	}
+
+	prevIndex := next - 1
+	last := n.lastIndex()
+	if last-prevIndex > int64(raftMaxAppendEntries) {
+		last = prevIndex + int64(raftMaxAppendEntries)
+	}
+	req := &raftAppendRequest{
+		Term:      term,
+		Leader:    n.self,
+		PrevIndex: prevIndex,
+		PrevTerm:  n.termAt(prevIndex),
+		Entries:   append([]raftEntry(nil), n.log[prevIndex-n.snapIndex:last-n.snapIndex]...),
+		Commit:    n.commitIndex,
+	}
+	n.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, raftRPCTimeout)
+	defer cancel()
+	resp, err := n.transport.appendEntries(ctx, addr, req)
+	if err != nil {
+		return false
+	}
+
+	return n.handleAppendResponse(addr, term, last, resp)
+}
+
+// handleAppendResponse updates the progress of a follower that was sent
+// the log up to last in term.
+func (n *raftNode) handleAppendResponse(addr string, term int64, last int64, resp *raftAppendResponse) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.stopped || n.observeTerm(resp.Term) || n.role != raftLeader || n.term != term {
+		return false
+	}
+	n.acked[addr] = time.Now()
+
+	if !resp.Success {
+		next := resp.Conflict
+		if next >= n.nextIndex[addr] {
+			next = n.nextIndex[addr] - 1
+		}
+		if next < 1 {
+			next = 1
+		}
+		n.nextIndex[addr] = next
+		return true
+	}
+
+	if last > n.matchIndex[addr] {
+		n.matchIndex[addr] = last
+	}
+	n.nextIndex[addr] = n.matchIndex[addr] + 1
+	n.advanceCommit()
+
+	return n.nextIndex[addr] <= n.lastIndex()
+}
+
+// advanceCommit commits the entries a majority of the members stores.
+// Only an entry of the current term is committed by counting, the ones
+// before it are committed with it.
+func (n *raftNode) advanceCommit() {
+	for index := n.lastIndex(); index > n.commitIndex && n.termAt(index) == n.term; index-- {
+		stored := map[string]bool{n.self.Addr: true}
+		for addr, match := range n.matchIndex {
+			if match >= index {
+				stored[addr] = true
+			}
+		}
+		if !n.quorum(stored) {
+			continue
+		}
+
+		configCommitted := n.configIndex > n.commitIndex && n.configIndex <= index
+		n.commitIndex = index
+		n.cond.Broadcast()
+		for _, r := range n.replicators {
+			r.notify()
+		}
+		if configCommitted {
+			if !n.isMember(n.self.Addr) {
+				log.Println("raft: removed from the group, stepping down")
+				n.becomeFollower()
+				return
+			}
+			n.syncReplicators()
+		}
+		return
+	}
+}
+
+// handleVote answers a candidate. A member that hears from a leader
+// ignores candidates, so a member cut off from the group doesn't depose
+// the leader when it's back.
+func (n *raftNode) handleVote(req *raftVoteRequest) *raftVoteResponse {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.stopped || req.Term < n.term {
+		return &raftVoteResponse{Term: n.term}
+	}
+	if n.role == raftLeader || n.leader.Addr != "" && time.Since(n.heard) < raftElectionTimeout {
+		return &raftVoteResponse{Term: n.term}
+	}
+	n.observeTerm(req.Term)
+
+	lastIndex := n.lastIndex()
+	lastTerm := n.termAt(lastIndex)
+	upToDate := req.LastTerm > lastTerm || req.LastTerm == lastTerm && req.LastIndex >= lastIndex
+	if !upToDate || n.vote != "" && n.vote != req.Candidate {
+		return &raftVoteResponse{Term: n.term}
+	}
+
+	n.vote = req.Candidate
+	n.persistState()
+	n.resetDeadline()
+
+	return &raftVoteResponse{Term: n.term, Granted: true}
+}
+
+// follow accepts the leader of a request in term, which isn't older than
+// the term of the node.
+func (n *raftNode) follow(term int64, leader clusterNode) {
+	n.observeTerm(term)
+	if n.role != raftFollower {
+		n.becomeFollower()
+	}
+	n.leader = leader
+	n.heard = time.Now()
+	n.resetDeadline()
+}
+
+func (n *raftNode) handleAppend(req *raftAppendRequest) *raftAppendResponse {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.stopped || req.Term < n.term {
+		return &raftAppendResponse{Term: n.term}
+	}
+	n.follow(req.Term, req.Leader)
+
+	if req.PrevIndex > n.lastIndex() {
+		return &raftAppendResponse{Term: n.term, Conflict: n.lastIndex() + 1}
+	}
+	if req.PrevIndex >= n.snapIndex {
+		if term := n.termAt(req.PrevIndex); term != req.PrevTerm {
+			// Skip the whole term that doesn't match.
+			conflict := req.PrevIndex
+			for conflict-1 > n.snapIndex && n.termAt(conflict-1) == term {
+				conflict--
+			}
+			return &raftAppendResponse{Term: n.term, Conflict: conflict}
+		}
+	}
+
+	truncated := false
+	var appended []raftEntry
+	for _, e := range req.Entries {
+		if e.Index <= n.snapIndex {
+			continue
+		}
+		if e.Index <= n.lastIndex() {
+			if n.termAt(e.Index) == e.Term {
+				continue
+			}
+			for _, lost := range n.log[e.Index-n.snapIndex-1:] {
+				n.diverged = n.diverged || lost.applied
+			}
+			n.log = n.log[:e.Index-n.snapIndex-1]
+			truncated = true
+		}
+		n.log = append(n.log, e)
+		appended = append(appended, e)
+	}
+	if truncated || len(appended) > 0 {
+		n.members, n.configIndex = n.membersAt(n.lastIndex())
+	}
+	if n.storage != nil {
+		var err error
+		if truncated {
+			err = n.storage.rewrite(n.log)
+		} else if len(appended) > 0 {
+			err = n.storage.append(appended)
+		}
+		if err != nil {
+			log.Println("raft:", err)
+		}
+	}
+
+	match := req.PrevIndex + int64(len(req.Entries))
+	if commit := min(req.Commit, match); commit > n.commitIndex {
+		n.commitIndex = commit
+		n.cond.Broadcast()
+	}
+	if n.diverged {
+		n.cond.Broadcast()
+	}
+
+	return &raftAppendResponse{Term: n.term, Success: true, Match: match}
+}
+
+// handleSnapshot installs the snapshot of the leader. Entries after it are
+// kept when the log has the last entry of the snapshot.
+func (n *raftNode) handleSnapshot(req *raftSnapshotRequest) *raftAppendResponse {
+	n.applyMu.Lock()
+	defer n.applyMu.Unlock()
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.stopped || req.Term < n.term {
+		return &raftAppendResponse{Term: n.term}
+	}
+	n.follow(req.Term, req.Leader)
+
+	if req.Index <= n.commitIndex {
+		return &raftAppendResponse{Term: n.term, Success: true, Match: req.Index}
+	}
+
+	if err := n.sm.restore(req.Data); err != nil {
+		log.Println("raft: snapshot isn't installed:", err)
+		return &raftAppendResponse{Term: n.term}
+	}
+
+	if n.termAt(req.Index) == req.LastTerm {
+		n.log = append([]raftEntry(nil), n.log[req.Index-n.snapIndex:]...)
+	} else {
+		n.log = nil
+	}
+	// The kept entries aren't in the installed state.
+	for i := range n.log {
+		n.log[i].applied = false
+	}
+	n.diverged = false
+	n.snapIndex, n.snapTerm, n.snapMembers, n.snapData = req.Index, req.LastTerm, req.Members, req.Data
+	n.commitIndex, n.lastApplied = req.Index, req.Index
+	n.members, n.configIndex = n.membersAt(n.lastIndex())
+	n.saveSnapshot()
+
+	for index, w := range n.waiters {
+		if index <= req.Index {
+			w.ch <- raftResult{err: errRaftLostLeadership}
+			delete(n.waiters, index)
+		}
+	}
+
+	return &raftAppendResponse{Term: n.term, Success: true, Match: req.Index}
+}
+
+func (n *raftNode) saveSnapshot() {
+	if n.storage == nil {
+		return
+	}
+
+	err := n.storage.saveSnapshot(raftSnapshot{Index: n.snapIndex, Term: n.snapTerm, Members: n.snapMembers, Data: n.snapData})
+	if err == nil {
+		err = n.storage.rewrite(n.log)
+	}
+	if err != nil {
+		log.Println("raft:", err)
+	}
+}
+
+// runApplier applies the committed entries in order.
+func (n *raftNode) runApplier() {
+	defer n.wg.Done()
+
+	for {
+		n.mu.Lock()
+		for !n.stopped && !n.diverged && n.lastApplied >= n.commitIndex {
+			n.cond.Wait()
+		}
+		stopped := n.stopped
+		n.mu.Unlock()
+		if stopped {
+			return
+		}
+
+		n.applyCommitted()
+	}
+}
+
+func (n *raftNode) applyCommitted() {
+	n.applyMu.Lock()
+	defer n.applyMu.Unlock()
+
+	n.mu.Lock()
+	diverged := n.diverged
+	n.mu.Unlock()
+	if diverged {
+		n.rebuild()
+	}
+
+	for {
+		n.mu.Lock()
+		if n.lastApplied >= n.commitIndex {
+			n.mu.Unlock()
+			break
+		}
+		e := n.entry(n.lastApplied + 1)
+		n.mu.Unlock()
+
+		var result interface{}
+		if e.Type == raftCommandEntry && !e.applied {
+			result = n.sm.apply(e)
+		}
+
+		n.mu.Lock()
+		n.lastApplied = e.Index
+		if w, found := n.waiters[e.Index]; found {
+			delete(n.waiters, e.Index)
+			if w.term == e.Term {
+				w.ch <- raftResult{value: result}
+			} else {
+				w.ch <- raftResult{err: errRaftLostLeadership}
+			}
+		}
+		n.mu.Unlock()
+	}
+
+	n.mu.Lock()
+	due := n.lastApplied-n.snapIndex >= n.snapshotEntries && !n.ahead()
+	n.mu.Unlock()
+	if due {
+		n.takeSnapshot()
+	}
+}
+
+// ahead reports whether the state has entries after the applied ones,
+// which a leader applies when it resolves them. It must not be snapshotted
+// then.
+func (n *raftNode) ahead() bool {
+	for _, e := range n.log[n.lastApplied-n.snapIndex:] {
+		if e.applied {
+			return true
+		}
+	}
+
+	return false
+}
+
+// rebuild restores the snapshot and applies the applied entries after it
+// again, which drops the entries the node applied as a leader that were
+// removed from the log. It's called with applyMu held.
+func (n *raftNode) rebuild() {
+	n.mu.Lock()
+	n.diverged = false
+	for i := range n.log {
+		n.log[i].applied = false
+	}
+	data := n.snapData
+	entries := append([]raftEntry(nil), n.log[:n.lastApplied-n.snapIndex]...)
+	n.mu.Unlock()
+
+	if err := n.sm.restore(data); err != nil {
+		log.Println("raft: state isn't rebuilt:", err)
+		return
+	}
+	for _, e := range entries {
+		if e.Type == raftCommandEntry {
+			n.sm.apply(e)
+		}
+	}
+}
+
+// takeSnapshot compacts the applied entries into a snapshot. It's called
+// with applyMu held, so the state is the one after the last applied entry.
+func (n *raftNode) takeSnapshot() {
+	data, err := n.sm.snapshot()
+	if err != nil {
+		log.Println("raft: snapshot isn't taken:", err)
+		return
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	index := n.lastApplied
+	n.snapMembers, _ = n.membersAt(index)
+	n.snapTerm = n.termAt(index)
+	n.log = append([]raftEntry(nil), n.log[index-n.snapIndex:]...)
+	n.snapIndex = index
+	n.snapData = data
+	n.saveSnapshot()
+}
+
+// resolve runs write on the leader and appends the command it returns to
+// the log, or nothing when it returns nil. write changes the state of the
+// leader at once, after every entry the leader appended before, so the
+// entry isn't applied again when it's committed, and the state is rebuilt
+// when the entry is removed from the log instead. It returns the result
+// of write once the entry is committed. Writes are resolved one at a time.
+func (n *raftNode) resolve(ctx context.Context, write func() ([]byte, interface{})) (interface{}, error) {
+	n.resolveMu.Lock()
+	defer n.resolveMu.Unlock()
+
+	// The state of a new leader misses the entries of earlier terms until
+	// the first entry of its term is applied.
+	var term int64
+	for {
+		n.applyMu.Lock()
+		n.mu.Lock()
+		err := n.leading()
+		term = n.term
+		ready := n.lastApplied >= n.termStart && !n.diverged
+		n.mu.Unlock()
+		if err == nil && ready {
+			break
+		}
+		n.applyMu.Unlock()
+		if err != nil {
+			return nil, err
+		}
+
+		select {
+		case <-time.After(raftHeartbeatInterval / 10):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	data, result := write()
+	if data == nil {
+		n.applyMu.Unlock()
+		return result, nil
+	}
+
+	n.mu.Lock()
+	if err := n.leading(); err != nil || n.term != term {
+		n.mu.Unlock()
+		// The write isn't in the log, so it's dropped from the state.
+		n.rebuild()
+		n.applyMu.Unlock()
+		return nil, errRaftLostLeadership
+	}
+	e := n.appendLocal(raftCommandEntry, data)
+	n.log[len(n.log)-1].applied = true
+	w := n.wait(e)
+	n.advanceCommit()
+	n.mu.Unlock()
+	n.applyMu.Unlock()
+
+	if _, err := n.result(ctx, e, w); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// changeMembers adds or removes the member addr. A change waits for the
+// previous one and for the first entry of the term of the leader to be
+// committed.
+func (n *raftNode) changeMembers(ctx context.Context, addr string, add bool) error {
+	n.mu.Lock()
+	if err := n.leading(); err != nil {
+		n.mu.Unlock()
+		return err
+	}
+	if n.configIndex > n.commitIndex || n.termStart > n.commitIndex {
+		n.mu.Unlock()
+		return errRaftMembership
+	}
+
+	var members []string
+	for _, m := range n.members {
+		if m != addr {
+			members = append(members, m)
+		}
+	}
+	if add {
+		members = append(members, addr)
+	}
+	if len(members) == len(n.members) {
+		n.mu.Unlock()
+		return nil
+	}
+
+	data, _ := json.Marshal(members)
+	e := n.appendLocal(raftConfigEntry, data)
+	w := n.wait(e)
+	n.advanceCommit()
+	n.mu.Unlock()
+
+	_, err := n.result(ctx, e, w)
+	return err
+}
+
+func (n *raftNode) leading() error {
+	if n.stopped {
+		return errRaftStopped
+	}
+	if n.role != raftLeader {
+		return &raftNotLeader{leader: n.leader}
+	}
+
+	return nil
+}
+
+func (n *raftNode) wait(e raftEntry) *raftWaiter {
+	w := &raftWaiter{term: e.Term, ch: make(chan raftResult, 1)}
+	n.waiters[e.Index] = w
+
+	return w
+}
+
+func (n *raftNode) result(ctx context.Context, e raftEntry, w *raftWaiter) (interface{}, error) {
+	select {
+	case r := <-w.ch:
+		return r.value, r.err
+	case <-ctx.Done():
+	case <-n.ctx.Done():
+	}
+
+	n.mu.Lock()
+	delete(n.waiters, e.Index)
+	n.mu.Unlock()
+	if n.ctx.Err() != nil {
+		return nil, errRaftStopped
+	}
+
+	return nil, ctx.Err()
+}
+
+// raftStatus is the state of a raft node.
+type raftStatus struct {
+	Addr          string   `json:"addr"`
+	Role          string   `json:"role"`
+	Term          int64    `json:"term"`
+	Leader        string   `json:"leader,omitempty"`
+	Members       []string `json:"members"`
+	LastIndex     int64    `json:"last_index"`
+	CommitIndex   int64    `json:"commit_index"`
+	AppliedIndex  int64    `json:"applied_index"`
+	SnapshotIndex int64    `json:"snapshot_index"`
+}
+
+func (n *raftNode) status() raftStatus {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return raftStatus{
+		Addr:          n.self.Addr,
+		Role:          n.role,
+		Term:          n.term,
+		Leader:        n.leader.Addr,
+		Members:       append([]string{}, n.members...),
+		LastIndex:     n.lastIndex(),
+		CommitIndex:   n.commitIndex,
+		AppliedIndex:  n.lastApplied,
+		SnapshotIndex: n.snapIndex,
+	}
+}
@@ -0,0 +1,622 @@
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/iqOptionTest/simplecache/cachepb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func init() {
+	raftHeartbeatInterval = 10 * time.Millisecond
+	raftElectionTimeout = 100 * time.Millisecond
+	raftRPCTimeout = 50 * time.Millisecond
+	raftCommitTimeout = time.Second
+	raftExpiryInterval = 20 * time.Millisecond
+}
+
+// raftNetwork connects raft nodes in memory. RPCs between the sides of a
+// partition are dropped.
+type raftNetwork struct {
+	mu    sync.Mutex
+	nodes map[string]*raftNode
+	side  map[string]int
+}
+
+func newRaftNetwork() *raftNetwork {
+	return &raftNetwork{nodes: map[string]*raftNode{}, side: map[string]int{}}
+}
+
+func (n *raftNetwork) add(addr string, node *raftNode) {
+	n.mu.Lock()
+	n.nodes[addr] = node
+	n.mu.Unlock()
+}
+
+// partition cuts the listed nodes off from the others and from each other.
+func (n *raftNetwork) partition(sides ...[]string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.side = map[string]int{}
+	for i, side := range sides {
+		for _, addr := range side {
+			n.side[addr] = i + 1
+		}
+	}
+}
+
+func (n *raftNetwork) heal() {
+	n.partition()
+}
+
+func (n *raftNetwork) node(from string, to string) (*raftNode, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	node, found := n.nodes[to]
+	if !found || n.side[from] != n.side[to] {
+		return nil, errors.New("unreachable")
+	}
+
+	return node, nil
+}
+
+// raftPipe is the transport of the node at from. Requests and responses
+// are copied as if they were sent over the network.
+type raftPipe struct {
+	net  *raftNetwork
+	from string
+}
+
+func (p *raftPipe) call(addr string, req interface{}, in interface{}, handle func() interface{}, resp interface{}) error {
+	if _, err := p.net.node(p.from, addr); err != nil {
+		return err
+	}
+	b, _ := json.Marshal(req)
+	json.Unmarshal(b, in)
+	b, _ = json.Marshal(handle())
+	// The response is lost when the link was cut meanwhile.
+	if _, err := p.net.node(addr, p.from); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, resp)
+}
+
+func (p *raftPipe) requestVote(ctx context.Context, addr string, req *raftVoteRequest) (*raftVoteResponse, error) {
+	node, err := p.net.node(p.from, addr)
+	if err != nil {
+		return nil, err
+	}
+	var in raftVoteRequest
+	var resp raftVoteResponse
+	return &resp, p.call(addr, req, &in, func() interface{} { return node.handleVote(&in) }, &resp)
+}
+
+func (p *raftPipe) appendEntries(ctx context.Context, addr string, req *raftAppendRequest) (*raftAppendResponse, error) {
+	node, err := p.net.node(p.from, addr)
+	if err != nil {
+		return nil, err
+	}
+	var in raftAppendRequest
+	var resp raftAppendResponse
+	return &resp, p.call(addr, req, &in, func() interface{} { return node.handleAppend(&in) }, &resp)
+}
+
+func (p *raftPipe) installSnapshot(ctx context.Context, addr string, req *raftSnapshotRequest) (*raftAppendResponse, error) {
+	node, err := p.net.node(p.from, addr)
+	if err != nil {
+		return nil, err
+	}
+	var in raftSnapshotRequest
+	var resp raftAppendResponse
+	return &resp, p.call(addr, req, &in, func() interface{} { return node.handleSnapshot(&in) }, &resp)
+}
+
+func raftAddrs(size int) []string {
+	addrs := make([]string, size)
+	for i := range addrs {
+		addrs[i] = fmt.Sprintf("http://n%d", i+1)
+	}
+
+	return addrs
+}
+
+// newRaftNode starts an instance at addr in raft mode on net.
+func newRaftApp(t *testing.T, net *raftNetwork, addr string, peers []string, config Config) *App {
+	a, err := NewApp(Config{Shards: 4, Databases: config.Databases})
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.RaftAddr = addr
+	config.RaftPeers = peers
+	if err := a.newRaft(config, &raftPipe{net: net, from: addr}); err != nil {
+		t.Fatal(err)
+	}
+	net.add(addr, a.raft)
+	a.Initialize()
+	t.Cleanup(a.Close)
+
+	return a
+}
+
+func newRaftGroup(t *testing.T, size int, config Config) (*raftNetwork, []*App) {
+	net := newRaftNetwork()
+	addrs := raftAddrs(size)
+	apps := make([]*App, size)
+	for i, addr := range addrs {
+		apps[i] = newRaftApp(t, net, addr, addrs, config)
+	}
+
+	return net, apps
+}
+
+// waitForLeader waits until one of apps leads the others.
+func waitForLeader(t *testing.T, apps []*App) *App {
+	var leader *App
+	waitFor(t, "No leader is elected", func() bool {
+		leader = nil
+		for _, a := range apps {
+			if a.raft.status().Role == raftLeader {
+				leader = a
+			}
+		}
+		if leader == nil {
+			return false
+		}
+		st := leader.raft.status()
+		if st.CommitIndex < leader.raft.termStartIndex() {
+			return false
+		}
+		for _, a := range apps {
+			if a.raft.status().Leader != st.Addr {
+				return false
+			}
+		}
+		return true
+	})
+
+	return leader
+}
+
+func (n *raftNode) termStartIndex() int64 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.termStart
+}
+
+// waitForApplied waits until apps applied everything the leader
+// committed.
+func waitForApplied(t *testing.T, leader *App, apps []*App) {
+	waitFor(t, "Committed entries aren't applied", func() bool {
+		commit := leader.raft.status().CommitIndex
+		for _, a := range apps {
+			if a.raft.status().AppliedIndex != commit {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func raftRequest(a *App, method string, path string, body string, v interface{}) (int, string) {
+	w := httptest.NewRecorder()
+	a.Router.ServeHTTP(w, httptest.NewRequest(method, path, strings.NewReader(body)))
+	if v != nil {
+		json.NewDecoder(w.Body).Decode(v)
+	}
+
+	return w.Code, w.Header().Get("Location")
+}
+
+// raftValue returns the string value at key as it's served. The leader
+// keeps a counter it increments as int64, the other nodes as json.Number.
+func raftValue(a *App, db int, key string) string {
+	v, err := a.dbs[db].get(key)
+	if err != nil {
+		return ""
+	}
+	b, _ := json.Marshal(v)
+
+	return string(b)
+}
+
+// raftStored returns the item at key whether it's expired or not, nil
+// when the node doesn't store it.
+func raftStored(a *App, key string) item {
+	s := a.cache.getShard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.items[key]
+}
+
+func raftAddr(a *App) string {
+	return a.raft.self.Addr
+}
+
+func others(apps []*App, a *App) []*App {
+	var rest []*App
+	for _, app := range apps {
+		if app != a {
+			rest = append(rest, app)
+		}
+	}
+
+	return rest
+}
+
+func TestRaft_ReplicatesWrites(t *testing.T) {
+	_, apps := newRaftGroup(t, 3, Config{Databases: 2})
+	leader := waitForLeader(t, apps)
+	follower := others(apps, leader)[0]
+
+	var result map[string]string
+	if code, _ := raftRequest(leader, "POST", "/set", `{"key":"a","value":"one"}`, &result); code != http.StatusCreated || result["result"] != "success" {
+		t.Fatal("Leader doesn't accept writes", code, result)
+	}
+	raftRequest(leader, "POST", "/db/1/set", `{"key":"b","value":"two"}`, nil)
+	raftRequest(leader, "POST", "/sadd", `{"key":"s","value":["c","a","b"]}`, nil)
+	raftRequest(leader, "GET", "/spop/s?count=1", "", nil)
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("PUT", "/setbytes/bin", bytes.NewReader([]byte{0xff, 0x00}))
+	r.Header.Set("Content-Type", "image/png")
+	leader.Router.ServeHTTP(w, r)
+	waitForApplied(t, leader, apps)
+
+	for _, a := range apps {
+		if v, _ := a.cache.get("a"); v != "one" {
+			t.Error("Write isn't applied on every node", raftAddr(a), v)
+		}
+		if v, _ := a.dbs[1].get("b"); v != "two" {
+			t.Error("Write to another database isn't applied", raftAddr(a), v)
+		}
+		if rv, _, _ := a.cache.getRaw("bin"); rv == nil || !bytes.Equal(rv.Data, []byte{0xff, 0x00}) || rv.ContentType != "image/png" {
+			t.Error("Raw value isn't applied on every node", raftAddr(a), rv)
+		}
+		members, _ := a.cache.smembers("s")
+		sort.Strings(members)
+		if len(members) != 2 || members[0] != "b" || members[1] != "c" {
+			t.Error("Nodes pop different members", raftAddr(a), members)
+		}
+	}
+
+	code, location := raftRequest(follower, "POST", "/set", `{"key":"a","value":"x"}`, &result)
+	if code != http.StatusTemporaryRedirect || location != raftAddr(leader)+"/set" || result["error"] != "NOTLEADER "+raftAddr(leader) {
+		t.Error("Follower doesn't redirect writes to the leader", code, location, result)
+	}
+	if code, _ := raftRequest(follower, "GET", "/get/a", "", nil); code != http.StatusOK {
+		t.Error("Follower doesn't serve reads", code)
+	}
+	if code, _ := raftRequest(leader, "GET", "/blpop?key=l&timeout=1", "", nil); code != http.StatusBadRequest {
+		t.Error("Blocking pop is accepted", code)
+	}
+
+	s := &grpcServer{a: follower}
+	_, err := s.Set(context.Background(), &cachepb.SetRequest{Key: "g", Value: &cachepb.Value{Kind: &cachepb.Value_NumberValue{NumberValue: "1"}}})
+	if st := status.Convert(err); st.Code() != codes.Unavailable || st.Message() != "NOTLEADER "+raftAddr(leader) {
+		t.Error("gRPC write isn't redirected to the leader", err)
+	}
+	s = &grpcServer{a: leader}
+	if r, err := s.IncrBy(context.Background(), &cachepb.IncrByRequest{Key: "g", Value: 2}); err != nil || r.Value != 2 {
+		t.Error("gRPC write fails on the leader", r, err)
+	}
+	incrby := &cachepb.Command{Op: &cachepb.Command_Incrby{Incrby: &cachepb.IncrByRequest{Key: "g", Value: 3}}}
+	tx, err := s.Tx(context.Background(), &cachepb.TxRequest{Commands: []*cachepb.Command{incrby}})
+	if err != nil || len(tx.Results) != 1 || tx.Results[0].Error != "" {
+		t.Error("gRPC transaction fails on the leader", tx, err)
+	}
+
+	var b bytes.Buffer
+	conn := &respConn{w: bufio.NewWriter(&b), proto: 2, a: follower}
+	conn.exec(follower.cache, []string{"SET", "r", "1"})
+	conn.w.Flush()
+	if b.String() != "-NOTLEADER "+raftAddr(leader)+"\r\n" {
+		t.Error("RESP write isn't redirected to the leader", b.String())
+	}
+	b.Reset()
+	conn = &respConn{w: bufio.NewWriter(&b), proto: 2, a: leader}
+	conn.exec(leader.cache, []string{"SET", "r", "1"})
+	conn.w.Flush()
+	if b.String() != "+OK\r\n" {
+		t.Error("RESP write fails on the leader", b.String())
+	}
+
+	waitForApplied(t, leader, apps)
+	for _, a := range apps {
+		if v := raftValue(a, 0, "g"); v != "5" {
+			t.Error("gRPC writes aren't applied on every node", raftAddr(a), v)
+		}
+		if v, _ := a.cache.get("r"); v != "1" {
+			t.Error("RESP write isn't applied on every node", raftAddr(a), v)
+		}
+	}
+}
+
+func TestRaft_ExpiryThroughTheLog(t *testing.T) {
+	net, apps := newRaftGroup(t, 3, Config{})
+	leader := waitForLeader(t, apps)
+	for _, a := range apps {
+		a.cache.ExpiredTimeMultiplier = time.Millisecond
+	}
+
+	raftRequest(leader, "POST", "/set", `{"key":"long","value":1,"expired":60000}`, nil)
+	raftRequest(leader, "POST", "/rpush", `{"key":"short","value":1,"expired":50}`, nil)
+	waitForApplied(t, leader, apps)
+	expired := raftStored(leader, "long").getExpired()
+	for _, a := range apps {
+		if i := raftStored(a, "long"); i == nil || i.getExpired() != expired {
+			t.Error("Nodes get different expiry times", raftAddr(a), i)
+		}
+	}
+
+	// A node cut off from the leader hides the expired key but keeps it
+	// until the leader deletes it.
+	follower := others(apps, leader)[0]
+	net.partition([]string{raftAddr(follower)})
+	time.Sleep(100 * time.Millisecond)
+	follower.cache.DeleteExpired()
+	if raftStored(follower, "short") == nil {
+		t.Error("Node deletes an expired key itself")
+	}
+	if _, err := follower.cache.lgetall("short"); err == nil {
+		t.Error("Expired key is served")
+	}
+
+	net.heal()
+	waitFor(t, "Expired key isn't deleted through the log", func() bool {
+		for _, a := range apps {
+			if raftStored(a, "short") != nil {
+				return false
+			}
+		}
+		return true
+	})
+	for _, a := range apps {
+		if raftStored(a, "long") == nil {
+			t.Error("Key is deleted before it expires", raftAddr(a))
+		}
+	}
+}
+
+func TestRaft_LeaderFailover(t *testing.T) {
+	net, apps := newRaftGroup(t, 5, Config{})
+	leader := waitForLeader(t, apps)
+	raftRequest(leader, "POST", "/set", `{"key":"a","value":1}`, nil)
+
+	rest := others(apps, leader)
+	net.partition([]string{raftAddr(leader)})
+	next := waitForLeader(t, rest)
+
+	if code, _ := raftRequest(leader, "POST", "/set", `{"key":"lost","value":1}`, nil); code == http.StatusCreated {
+		t.Error("Leader cut off from the majority commits a write")
+	}
+	if code, _ := raftRequest(next, "POST", "/set", `{"key":"b","value":2}`, nil); code != http.StatusCreated {
+		t.Error("New leader doesn't accept writes", code)
+	}
+	waitFor(t, "Leader cut off from the majority doesn't step down", func() bool {
+		return leader.raft.status().Role != raftLeader
+	})
+
+	net.heal()
+	leader = waitForLeader(t, apps)
+	waitForApplied(t, leader, apps)
+	for _, a := range apps {
+		if _, err := a.cache.get("b"); err != nil {
+			t.Error("Write of the new leader isn't applied", raftAddr(a))
+		}
+		if _, err := a.cache.get("lost"); err == nil {
+			t.Error("Uncommitted write is applied", raftAddr(a))
+		}
+		if a.cache.count.Load() != 2 {
+			t.Error("Nodes have different keys", raftAddr(a), a.cache.count.Load())
+		}
+	}
+}
+
+func TestRaft_SnapshotCatchUp(t *testing.T) {
+	net, apps := newRaftGroup(t, 3, Config{RaftSnapshotEntries: 20})
+	leader := waitForLeader(t, apps)
+	behind := others(apps, leader)[0]
+
+	net.partition([]string{raftAddr(behind)})
+	for i := 0; i < 100; i++ {
+		if code, _ := raftRequest(leader, "POST", "/set", fmt.Sprintf(`{"key":"k%d","value":%d}`, i, i), nil); code != http.StatusCreated {
+			t.Fatal("Write fails", code)
+		}
+	}
+	if st := leader.raft.status(); st.SnapshotIndex == 0 || st.LastIndex-st.SnapshotIndex > 20 {
+		t.Error("Log isn't compacted", st)
+	}
+
+	net.heal()
+	waitForApplied(t, leader, apps)
+	if st := behind.raft.status(); st.SnapshotIndex == 0 {
+		t.Error("Follower doesn't install the snapshot", st)
+	}
+	if behind.cache.count.Load() != 100 {
+		t.Error("Snapshot doesn't copy the keys", behind.cache.count.Load())
+	}
+}
+
+func TestRaft_MembershipChanges(t *testing.T) {
+	net, apps := newRaftGroup(t, 3, Config{RaftSnapshotEntries: 10})
+	leader := waitForLeader(t, apps)
+	for i := 0; i < 20; i++ {
+		raftRequest(leader, "POST", "/set", fmt.Sprintf(`{"key":"k%d","value":%d}`, i, i), nil)
+	}
+
+	joining := newRaftApp(t, net, "http://n4", nil, Config{RaftSnapshotEntries: 10})
+	follower := others(apps, leader)[0]
+	if code, location := raftRequest(follower, "POST", "/raft/join", `{"addr":"http://n4"}`, nil); code != http.StatusTemporaryRedirect || location != raftAddr(leader)+"/raft/join" {
+		t.Error("Follower doesn't redirect membership changes", code, location)
+	}
+	var st raftStatus
+	if code, _ := raftRequest(leader, "POST", "/raft/join", `{"addr":"http://n4"}`, &st); code != http.StatusOK || len(st.Members) != 4 {
+		t.Fatal("Node isn't added", code, st)
+	}
+	apps = append(apps, joining)
+	waitForApplied(t, leader, apps)
+	if joining.cache.count.Load() != 20 {
+		t.Error("Added node doesn't catch up", joining.cache.count.Load())
+	}
+
+	if code, _ := raftRequest(leader, "POST", "/raft/leave", fmt.Sprintf(`{"addr":%q}`, raftAddr(follower)), &st); code != http.StatusOK || len(st.Members) != 3 {
+		t.Fatal("Node isn't removed", code, st)
+	}
+	apps = others(apps, follower)
+	term := leader.raft.status().Term
+	raftRequest(leader, "POST", "/set", `{"key":"after","value":1}`, nil)
+	// The removed node may not learn it's removed and campaign, which
+	// must not disturb the group.
+	time.Sleep(5 * raftElectionTimeout)
+	if st := leader.raft.status(); st.Term != term || st.Role != raftLeader {
+		t.Error("Removed node disturbs the group", st)
+	}
+	if _, err := follower.cache.get("after"); err == nil {
+		t.Error("Removed node gets writes")
+	}
+
+	// A leader removing itself steps down once the change is committed.
+	if code, _ := raftRequest(leader, "POST", "/raft/leave", fmt.Sprintf(`{"addr":%q}`, raftAddr(leader)), nil); code != http.StatusOK {
+		t.Fatal("Leader isn't removed", code)
+	}
+	rest := others(apps, leader)
+	next := waitForLeader(t, rest)
+	if code, _ := raftRequest(next, "POST", "/set", `{"key":"last","value":1}`, nil); code != http.StatusCreated {
+		t.Error("Group of the remaining members doesn't accept writes", code)
+	}
+	waitForApplied(t, next, rest)
+	for _, a := range rest {
+		if _, err := a.cache.get("last"); err != nil || a.cache.count.Load() != 22 {
+			t.Error("Remaining members diverge", raftAddr(a), a.cache.count.Load())
+		}
+	}
+}
+
+func TestRaft_Restart(t *testing.T) {
+	dir := t.TempDir()
+	net := newRaftNetwork()
+	addrs := raftAddrs(3)
+	start := func() []*App {
+		apps := make([]*App, len(addrs))
+		for i, addr := range addrs {
+			apps[i] = newRaftApp(t, net, addr, addrs, Config{RaftDir: filepath.Join(dir, addr[len("http://"):]), RaftSnapshotEntries: 10, Databases: 2})
+		}
+		return apps
+	}
+
+	apps := start()
+	leader := waitForLeader(t, apps)
+	for i := 0; i < 25; i++ {
+		raftRequest(leader, "POST", "/db/1/incrby", `{"key":"n","value":1}`, nil)
+	}
+	raftRequest(leader, "POST", "/set", `{"key":"ttl","value":1,"expired":60}`, nil)
+	expired := raftStored(leader, "ttl").getExpired()
+	term := leader.raft.status().Term
+	for _, a := range apps {
+		a.raft.stop()
+	}
+
+	apps = start()
+	leader = waitForLeader(t, apps)
+	if st := leader.raft.status(); st.Term <= term {
+		t.Error("Term isn't kept", st.Term, term)
+	}
+	waitForApplied(t, leader, apps)
+	for _, a := range apps {
+		if v := raftValue(a, 1, "n"); v != "25" {
+			t.Error("Writes are lost on restart", raftAddr(a), v)
+		}
+		if i := raftStored(a, "ttl"); i == nil || i.getExpired() != expired {
+			t.Error("Expiry time changes on restart", raftAddr(a), i)
+		}
+	}
+}
+
+func TestRaft_ConsistencyUnderPartitions(t *testing.T) {
+	net, apps := newRaftGroup(t, 5, Config{RaftSnapshotEntries: 50})
+	byAddr := map[string]*App{}
+	for _, a := range apps {
+		byAddr[raftAddr(a)] = a
+	}
+	waitForLeader(t, apps)
+
+	// The writer counts the increments acknowledged by a leader, which
+	// must all be applied, and the ones it attempted, which may be.
+	var acked, attempted atomic.Int64
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		a := apps[0]
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			attempted.Add(1)
+			code, location := raftRequest(a, "POST", "/incrby", `{"key":"n","value":1}`, nil)
+			switch code {
+			case http.StatusCreated:
+				acked.Add(1)
+			case http.StatusTemporaryRedirect:
+				a = byAddr[strings.TrimSuffix(location, "/incrby")]
+			default:
+				a = apps[rand.Intn(len(apps))]
+				time.Sleep(raftHeartbeatInterval)
+			}
+		}
+	}()
+
+	for round := 0; round < 6; round++ {
+		shuffled := append([]*App(nil), apps...)
+		rand.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+		var minority []string
+		for _, a := range shuffled[:1+rand.Intn(2)] {
+			minority = append(minority, raftAddr(a))
+		}
+		net.partition(minority)
+		time.Sleep(3 * raftElectionTimeout)
+		net.heal()
+		time.Sleep(2 * raftElectionTimeout)
+	}
+	close(stop)
+	wg.Wait()
+
+	leader := waitForLeader(t, apps)
+	waitForApplied(t, leader, apps)
+	v := raftValue(leader, 0, "n")
+	n, _ := strconv.ParseInt(v, 10, 64)
+	if n < acked.Load() || n > attempted.Load() {
+		t.Error("Acknowledged writes are lost", n, acked.Load(), attempted.Load())
+	}
+	if acked.Load() == 0 {
+		t.Error("No write is acknowledged")
+	}
+	for _, a := range apps {
+		if w := raftValue(a, 0, "n"); w != v {
+			t.Error("Nodes diverge", raftAddr(a), w, v)
+		}
+	}
+}
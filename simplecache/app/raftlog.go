@@ -0,0 +1,214 @@
+package app
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+// Files of the raft storage.
+const (
+	raftStateFile    = "raft-state.json"
+	raftSnapshotFile = "raft-snapshot.json"
+	raftLogFile      = "raft-log.ndjson"
+)
+
+// raftHardState is the term and vote of a node, which must survive a
+// restart so the node never votes twice in a term.
+type raftHardState struct {
+	Term int64  `json:"term"`
+	Vote string `json:"vote,omitempty"`
+}
+
+// raftSnapshot is the state machine at Index with the members of the
+// group at that index.
+type raftSnapshot struct {
+	Index   int64           `json:"index"`
+	Term    int64           `json:"term"`
+	Members []string        `json:"members"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// raftStorage keeps the state, the snapshot and the log entries after the
+// snapshot of a raft node in a directory. Every write is synced before it
+// returns, since the node answers RPCs only after it's durable.
+type raftStorage struct {
+	dir  string
+	file *os.File
+}
+
+func openRaftStorage(dir string) (*raftStorage, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(filepath.Join(dir, raftLogFile), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &raftStorage{dir: dir, file: f}, nil
+}
+
+// load returns what was stored, a nil snapshot when there is none. An
+// entry cut off by a crash at the end of the log is dropped.
+func (s *raftStorage) load() (raftHardState, *raftSnapshot, []raftEntry, error) {
+	var state raftHardState
+	if err := readJSONFile(filepath.Join(s.dir, raftStateFile), &state); err != nil && !os.IsNotExist(err) {
+		return state, nil, nil, err
+	}
+
+	var snap *raftSnapshot
+	if err := readJSONFile(filepath.Join(s.dir, raftSnapshotFile), &snap); err != nil && !os.IsNotExist(err) {
+		return state, nil, nil, err
+	}
+
+	f, err := os.Open(filepath.Join(s.dir, raftLogFile))
+	if err != nil {
+		return state, nil, nil, err
+	}
+	defer f.Close()
+
+	var entries []raftEntry
+	truncated := false
+	decoder := json.NewDecoder(bufio.NewReader(f))
+	for {
+		var e raftEntry
+		err := decoder.Decode(&e)
+		if err == io.EOF {
+			break
+		}
+		if err == io.ErrUnexpectedEOF {
+			log.Println("raft: ignoring truncated entry at the end of the log")
+			truncated = true
+			break
+		}
+		if err != nil {
+			return state, nil, nil, err
+		}
+		// The log is rewritten after the snapshot is saved, a crash in
+		// between leaves entries the snapshot already has.
+		if snap != nil && e.Index <= snap.Index {
+			continue
+		}
+		entries = append(entries, e)
+	}
+
+	if truncated {
+		if err := s.rewrite(entries); err != nil {
+			return state, nil, nil, err
+		}
+	}
+
+	return state, snap, entries, nil
+}
+
+func (s *raftStorage) saveState(state raftHardState) error {
+	return writeJSONFile(filepath.Join(s.dir, raftStateFile), state)
+}
+
+func (s *raftStorage) saveSnapshot(snap raftSnapshot) error {
+	return writeJSONFile(filepath.Join(s.dir, raftSnapshotFile), snap)
+}
+
+// append adds entries to the end of the log.
+func (s *raftStorage) append(entries []raftEntry) error {
+	w := bufio.NewWriter(s.file)
+	encoder := json.NewEncoder(w)
+	for _, e := range entries {
+		if err := encoder.Encode(e); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	return s.file.Sync()
+}
+
+// rewrite replaces the log with entries, after it's truncated or
+// compacted.
+func (s *raftStorage) rewrite(entries []raftEntry) error {
+	path := filepath.Join(s.dir, raftLogFile)
+	f, err := os.CreateTemp(s.dir, raftLogFile+".rewrite")
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+	encoder := json.NewEncoder(w)
+	for _, e := range entries {
+		if err := encoder.Encode(e); err != nil {
+			f.Close()
+			os.Remove(f.Name())
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	s.file.Close()
+	s.file = file
+
+	return nil
+}
+
+func (s *raftStorage) close() error {
+	return s.file.Close()
+}
+
+func readJSONFile(path string, v interface{}) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return json.NewDecoder(bufio.NewReader(f)).Decode(v)
+}
+
+// writeJSONFile replaces the file at path with v, so a crash leaves either
+// the old or the new file.
+func writeJSONFile(path string, v interface{}) error {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+
+	err = json.NewEncoder(f).Encode(v)
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
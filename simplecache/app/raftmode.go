@@ -0,0 +1,455 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// raftCommitTimeout is how long a write waits for its raft entry to be
+	// committed, and raftExpiryInterval how often the leader deletes
+	// expired keys.
+	raftCommitTimeout  = 10 * time.Second
+	raftExpiryInterval = time.Second
+)
+
+var (
+	errRaftBlocking = errors.New("blocking pops aren't supported in raft mode")
+	errRaftTimeout  = errors.New("write isn't committed in time, it may still be applied")
+)
+
+// raftBatch records the commands a write logs while the leader resolves
+// it. They are the entry of the raft log: the commands of the append-only
+// log with absolute expiry times, so every node and every replay of the
+// log gets the same state.
+type raftBatch struct {
+	mu        sync.Mutex
+	recording bool
+	entries   []replEntry
+}
+
+func (b *raftBatch) start() {
+	b.mu.Lock()
+	b.recording, b.entries = true, nil
+	b.mu.Unlock()
+}
+
+func (b *raftBatch) stop() []replEntry {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.recording = false
+	return b.entries
+}
+
+func (b *raftBatch) active() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.recording
+}
+
+func (b *raftBatch) add(db int, cmd command) {
+	b.mu.Lock()
+	if b.recording {
+		b.entries = append(b.entries, replEntry{DB: db, command: cmd})
+	}
+	b.mu.Unlock()
+}
+
+// raftTxResult is the result of a gRPC transaction.
+type raftTxResult struct {
+	results []txResult
+	err     error
+}
+
+// raftResponse records the response to an HTTP request the leader
+// resolves.
+type raftResponse struct {
+	code   int
+	header http.Header
+	body   bytes.Buffer
+}
+
+func (r *raftResponse) Header() http.Header {
+	return r.header
+}
+
+func (r *raftResponse) Write(b []byte) (int, error) {
+	return r.body.Write(b)
+}
+
+func (r *raftResponse) WriteHeader(code int) {
+	if r.code == 0 {
+		r.code = code
+	}
+}
+
+// raftMemberObject is the body of the membership changes.
+type raftMemberObject struct {
+	Addr string `json:"addr"`
+}
+
+// newRaft makes the instance a node of a raft group, see Config.RaftAddr.
+// The node starts with Initialize.
+func (a *App) newRaft(config Config, transport raftTransport) error {
+	addr := strings.TrimSuffix(config.RaftAddr, "/")
+	self := clusterNode{Addr: addr}
+	if u, err := url.Parse(addr); err == nil {
+		self.GRPC = advertisedAddr(u.Hostname(), config.GRPCAddr)
+		self.RESP = advertisedAddr(u.Hostname(), config.RESPAddr)
+	}
+
+	var peers []string
+	for _, peer := range config.RaftPeers {
+		peers = append(peers, strings.TrimSuffix(peer, "/"))
+	}
+
+	var storage *raftStorage
+	if config.RaftDir != "" {
+		var err error
+		if storage, err = openRaftStorage(config.RaftDir); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range a.dbs {
+		c.deterministic = true
+		c.batch = &a.raftBatch
+	}
+
+	sm := raftStateMachine{apply: a.applyRaft, snapshot: a.raftSnapshot, restore: a.restoreRaft}
+	n, err := newRaftNode(self, peers, transport, storage, sm, config.RaftSnapshotEntries)
+	if err != nil {
+		if storage != nil {
+			storage.close()
+		}
+		return err
+	}
+	a.raft = n
+
+	return nil
+}
+
+// resolveRaft serves a write on the leader and commits the commands it
+// logged, see raftNode.resolve. It returns the result of write.
+func (a *App) resolveRaft(ctx context.Context, write func() interface{}) (interface{}, error) {
+	ctx, cancel := context.WithTimeout(ctx, raftCommitTimeout)
+	defer cancel()
+
+	result, err := a.raft.resolve(ctx, func() ([]byte, interface{}) {
+		a.raftBatch.start()
+		result := write()
+		data, _ := marshalJSON(a.raftBatch.stop())
+		return data, result
+	})
+	if err == context.DeadlineExceeded {
+		return nil, errRaftTimeout
+	}
+
+	return result, err
+}
+
+// runRaftExpiry deletes the expired keys on the leader through the log,
+// so every node deletes them at the same point of it. Nodes don't delete
+// expired keys themselves in raft mode.
+func (a *App) runRaftExpiry() {
+	ticker := time.NewTicker(raftExpiryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-a.stop:
+			return
+		}
+
+		if a.raft.status().Role != raftLeader {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), raftCommitTimeout)
+		_, err := a.raft.resolve(ctx, func() ([]byte, interface{}) {
+			a.raftBatch.start()
+			for _, c := range a.dbs {
+				c.deleteExpired()
+			}
+			entries := a.raftBatch.stop()
+			if len(entries) == 0 {
+				return nil, nil
+			}
+			data, _ := marshalJSON(entries)
+			return data, nil
+		})
+		cancel()
+		if err != nil {
+			log.Println("raft: expired keys aren't deleted:", err)
+		}
+	}
+}
+
+// applyRaft applies the commands of a committed entry.
+func (a *App) applyRaft(e raftEntry) interface{} {
+	var entries []replEntry
+	if err := json.Unmarshal(e.Data, &entries); err != nil {
+		return err
+	}
+
+	for _, re := range entries {
+		if re.DB < 0 || re.DB >= len(a.dbs) {
+			return errNoSuchDB
+		}
+		if err := a.dbs[re.DB].apply(re.command); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// raftSnapshot encodes restore commands of every key of every database.
+func (a *App) raftSnapshot() ([]byte, error) {
+	entries, err := a.keyspaceEntries(func() {})
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalJSON(entries)
+}
+
+// restoreRaft replaces all databases with a snapshot of raftSnapshot.
+func (a *App) restoreRaft(data []byte) error {
+	var entries []replEntry
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &entries); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range a.dbs {
+		c.flush()
+	}
+	for _, e := range entries {
+		if e.DB < 0 || e.DB >= len(a.dbs) {
+			return errNoSuchDB
+		}
+		if err := a.dbs[e.DB].apply(e.command); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// raftWrites serves the write requests on the leader and answers them
+// once the commands they logged are committed. Followers redirect them to
+// the leader with 307, which keeps the method and the body.
+func (a *App) raftWrites(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		op := routeOp(r)
+		if a.raft == nil || !writeOps[op] && op != "tx" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if op == "blpop" || op == "brpop" {
+			respondWithError(w, http.StatusBadRequest, errRaftBlocking.Error())
+			return
+		}
+
+		// The body is read before the write is resolved, which blocks
+		// the other writes.
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		result, err := a.resolveRaft(r.Context(), func() interface{} {
+			resp := &raftResponse{header: http.Header{}}
+			next.ServeHTTP(resp, r)
+			return resp
+		})
+		if err != nil {
+			a.raftError(w, r, err)
+			return
+		}
+
+		resp := result.(*raftResponse)
+		for k, v := range resp.header {
+			w.Header()[k] = v
+		}
+		if resp.code != 0 {
+			w.WriteHeader(resp.code)
+		}
+		w.Write(resp.body.Bytes())
+	})
+}
+
+// raftError answers a request that wasn't committed.
+func (a *App) raftError(w http.ResponseWriter, r *http.Request, err error) {
+	var notLeader *raftNotLeader
+	switch {
+	case errors.As(err, &notLeader) && notLeader.leader.Addr != "":
+		w.Header().Set("Location", notLeader.leader.Addr+r.URL.RequestURI())
+		respondWithError(w, http.StatusTemporaryRedirect, err.Error())
+	case errors.As(err, &notLeader), err == errRaftTimeout, err == errRaftLostLeadership, err == errRaftStopped:
+		respondWithError(w, http.StatusServiceUnavailable, err.Error())
+	case err == errRaftMembership:
+		respondWithError(w, http.StatusConflict, err.Error())
+	default:
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+// raftMessage is the error of a write that wasn't committed, with the
+// address of the leader for gRPC or RESP.
+func raftMessage(err error, leaderAddr func(clusterNode) string) string {
+	var notLeader *raftNotLeader
+	if errors.As(err, &notLeader) {
+		return notLeader.message(leaderAddr(notLeader.leader))
+	}
+
+	return err.Error()
+}
+
+func grpcLeaderAddr(leader clusterNode) string {
+	if leader.GRPC != "" {
+		return leader.GRPC
+	}
+
+	return leader.Addr
+}
+
+func respLeaderAddr(leader clusterNode) string {
+	if leader.RESP != "" {
+		return leader.RESP
+	}
+
+	return leader.Addr
+}
+
+func (a *App) raftStatus(w http.ResponseWriter, r *http.Request) {
+	respondWithJSON(w, http.StatusOK, a.raft.status())
+}
+
+func (a *App) raftJoin(w http.ResponseWriter, r *http.Request) {
+	a.changeRaftMembers(w, r, true)
+}
+
+func (a *App) raftLeave(w http.ResponseWriter, r *http.Request) {
+	a.changeRaftMembers(w, r, false)
+}
+
+func (a *App) changeRaftMembers(w http.ResponseWriter, r *http.Request, add bool) {
+	var mo raftMemberObject
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&mo); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	if mo.Addr == "" {
+		respondWithError(w, http.StatusBadRequest, "addr is empty")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), raftCommitTimeout)
+	defer cancel()
+	if err := a.raft.changeMembers(ctx, strings.TrimSuffix(mo.Addr, "/"), add); err != nil {
+		if err == context.DeadlineExceeded {
+			err = errRaftTimeout
+		}
+		a.raftError(w, r, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, a.raft.status())
+}
+
+func (a *App) raftVote(w http.ResponseWriter, r *http.Request) {
+	var req raftVoteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	respondWithJSON(w, http.StatusOK, a.raft.handleVote(&req))
+}
+
+func (a *App) raftAppend(w http.ResponseWriter, r *http.Request) {
+	var req raftAppendRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	respondWithJSON(w, http.StatusOK, a.raft.handleAppend(&req))
+}
+
+func (a *App) raftInstallSnapshot(w http.ResponseWriter, r *http.Request) {
+	var req raftSnapshotRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	respondWithJSON(w, http.StatusOK, a.raft.handleSnapshot(&req))
+}
+
+// httpRaftTransport sends the raft RPCs to the /raft endpoints of the
+// other nodes.
+type httpRaftTransport struct {
+	client *http.Client
+}
+
+func (t *httpRaftTransport) call(ctx context.Context, url string, req interface{}, resp interface{}) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	r, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	res, err := t.client.Do(r)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", url, res.Status)
+	}
+
+	return json.NewDecoder(res.Body).Decode(resp)
+}
+
+func (t *httpRaftTransport) requestVote(ctx context.Context, addr string, req *raftVoteRequest) (*raftVoteResponse, error) {
+	var resp raftVoteResponse
+	return &resp, t.call(ctx, addr+"/raft/vote", req, &resp)
+}
+
+func (t *httpRaftTransport) appendEntries(ctx context.Context, addr string, req *raftAppendRequest) (*raftAppendResponse, error) {
+	var resp raftAppendResponse
+	return &resp, t.call(ctx, addr+"/raft/append", req, &resp)
+}
+
+func (t *httpRaftTransport) installSnapshot(ctx context.Context, addr string, req *raftSnapshotRequest) (*raftAppendResponse, error) {
+	var resp raftAppendResponse
+	return &resp, t.call(ctx, addr+"/raft/snapshot", req, &resp)
+}
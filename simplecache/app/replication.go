@@ -0,0 +1,614 @@
+package app
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gorilla/mux"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const defaultReplBacklogSize = 1024 * 1024
+
+var (
+	// replPingInterval is how often an idle leader tells its followers its
+	// offset, and replTimeout how long a follower waits for anything from
+	// the leader before it reconnects.
+	replPingInterval  = time.Second
+	replTimeout       = 10 * time.Second
+	replRetryInterval = time.Second
+)
+
+var errReadOnly = errors.New("write is rejected by a read-only follower")
+
+// writeOps are the endpoints and transaction commands that change the
+// keyspace, which followers reject.
+var writeOps = map[string]bool{
+	"set": true, "getset": true, "cas": true, "incr": true, "decr": true,
+	"incrby": true, "incrbyfloat": true, "unset": true, "expire": true,
+	"expireat": true, "persist": true, "rpush": true, "lpush": true,
+	"pop": true, "lpop": true, "blpop": true, "brpop": true, "lset": true,
+	"lrem": true, "ltrim": true, "linsert": true, "hset": true, "hdel": true,
+	"hsetnx": true, "hincrby": true, "hincrbyfloat": true, "sadd": true,
+	"srem": true, "spop": true, "sinterstore": true, "sunionstore": true,
+	"sdiffstore": true, "zadd": true, "zrem": true, "zpopmin": true,
+	"zpopmax": true, "flushdb": true, "move": true, "setbytes": true,
+	"append": true,
+}
+
+// replEntry is a line of the replication stream: a logged command of the
+// database DB with its offset in the stream. Commands of a full sync have
+// no offset, and a ping carries the offset of the leader.
+type replEntry struct {
+	Offset int64 `json:"offset,omitempty"`
+	DB     int   `json:"db,omitempty"`
+	command
+}
+
+// replHeader starts the replication stream. A full sync is followed by
+// Entries restore commands of the keyspace at Offset, a partial one by the
+// commands after the offset the follower asked for.
+type replHeader struct {
+	ReplID  string `json:"replid"`
+	Offset  int64  `json:"offset"`
+	Full    bool   `json:"full"`
+	Entries int    `json:"entries"`
+}
+
+type replicationStatus struct {
+	Role      string          `json:"role"`
+	ReplID    string          `json:"replid"`
+	Offset    int64           `json:"offset"`
+	Followers []replicaStatus `json:"followers,omitempty"`
+	*followerStatus
+}
+
+// replicaStatus is a connected follower as the leader sees it. Offset is
+// the last command sent to it.
+type replicaStatus struct {
+	Addr   string `json:"addr"`
+	Offset int64  `json:"offset"`
+	Lag    int64  `json:"lag"`
+}
+
+type followerStatus struct {
+	Leader        string `json:"leader"`
+	Connected     bool   `json:"connected"`
+	LeaderOffset  int64  `json:"leader_offset"`
+	Lag           int64  `json:"lag"`
+	LastContactMs int64  `json:"last_contact_ms"`
+}
+
+type followObject struct {
+	Leader string `json:"leader"`
+}
+
+// backlog keeps the latest logged commands of all databases, so followers
+// that reconnect can resume from their offset. It is enabled by the first
+// full sync, before that nothing is kept.
+type backlog struct {
+	enabled atomic.Bool
+	id      string
+
+	mu      sync.Mutex
+	entries []backlogEntry
+	size    int
+	maxSize int
+	last    int64
+	// changed is closed and replaced by every append.
+	changed chan struct{}
+}
+
+type backlogEntry struct {
+	offset int64
+	line   []byte
+}
+
+func newBacklog(maxSize int) *backlog {
+	if maxSize <= 0 {
+		maxSize = defaultReplBacklogSize
+	}
+
+	id := make([]byte, 20)
+	rand.Read(id)
+
+	return &backlog{id: hex.EncodeToString(id), maxSize: maxSize, changed: make(chan struct{})}
+}
+
+// append adds a command of the database db. The oldest commands are
+// dropped once the backlog is larger than its maximum size.
+func (b *backlog) append(db int, cmd command) {
+	if !b.enabled.Load() {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	line, err := marshalJSON(replEntry{Offset: b.last + 1, DB: db, command: cmd})
+	if err != nil {
+		return
+	}
+	line = append(line, '\n')
+
+	b.last++
+	b.entries = append(b.entries, backlogEntry{offset: b.last, line: line})
+	b.size += len(line)
+	for b.size > b.maxSize && len(b.entries) > 1 {
+		b.size -= len(b.entries[0].line)
+		b.entries = b.entries[1:]
+	}
+
+	close(b.changed)
+	b.changed = make(chan struct{})
+}
+
+func (b *backlog) offset() int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.last
+}
+
+// since returns the lines of the commands after offset, the offset of the
+// last one and a channel that is closed by the next append. It reports
+// false when commands after offset were already dropped.
+func (b *backlog) since(offset int64) ([][]byte, int64, <-chan struct{}, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	first := b.last - int64(len(b.entries)) + 1
+	if offset > b.last || offset+1 < first {
+		return nil, b.last, b.changed, false
+	}
+
+	var lines [][]byte
+	for _, e := range b.entries[offset+1-first:] {
+		lines = append(lines, e.line)
+	}
+
+	return lines, b.last, b.changed, true
+}
+
+// has reports whether a follower at offset can resume from the backlog.
+func (b *backlog) has(offset int64) bool {
+	_, _, _, ok := b.since(offset)
+
+	return ok
+}
+
+// replica is a follower connected to the leader.
+type replica struct {
+	addr   string
+	offset atomic.Int64
+}
+
+// follower is the state of an instance that follows a leader.
+type follower struct {
+	leader string
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	mu           sync.Mutex
+	replID       string
+	offset       int64
+	leaderOffset int64
+	connected    bool
+	lastContact  time.Time
+}
+
+// readOnly returns errReadOnly when the instance follows a leader and any
+// of ops is a write.
+func (a *App) readOnly(ops ...string) error {
+	if a.follower.Load() == nil {
+		return nil
+	}
+	for _, op := range ops {
+		if writeOps[op] {
+			return errReadOnly
+		}
+	}
+
+	return nil
+}
+
+// rejectWrites answers the write endpoints with 403 while the instance
+// follows a leader. Transactions are checked by their commands.
+func (a *App) rejectWrites(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if a.follower.Load() != nil && a.readOnly(routeOp(r)) != nil {
+			respondWithError(w, http.StatusForbidden, errReadOnly.Error())
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// routeOp returns the endpoint of a request, the first segment of its
+// route after the database prefix.
+func routeOp(r *http.Request) string {
+	route := mux.CurrentRoute(r)
+	if route == nil {
+		return ""
+	}
+	path, _ := route.GetPathTemplate()
+
+	return strings.Split(strings.TrimPrefix(strings.TrimPrefix(path, dbPrefix), "/"), "/")[0]
+}
+
+// txReadOnly is readOnly for the commands of a transaction.
+func (a *App) txReadOnly(commands []txCommand) error {
+	ops := make([]string, len(commands))
+	for i, cmd := range commands {
+		ops[i] = cmd.Op
+	}
+
+	return a.readOnly(ops...)
+}
+
+// replicationStatus returns the role of the instance and how far it is
+// in the replication stream.
+func (a *App) replicationStatus() replicationStatus {
+	if f := a.follower.Load(); f != nil {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		fs := &followerStatus{Leader: f.leader, Connected: f.connected, LeaderOffset: f.leaderOffset, Lag: f.leaderOffset - f.offset}
+		if !f.lastContact.IsZero() {
+			fs.LastContactMs = time.Since(f.lastContact).Milliseconds()
+		}
+		return replicationStatus{Role: "follower", ReplID: f.replID, Offset: f.offset, followerStatus: fs}
+	}
+
+	st := replicationStatus{Role: "leader", ReplID: a.repl.id, Offset: a.repl.offset()}
+	a.replicasMu.Lock()
+	for r := range a.replicas {
+		offset := r.offset.Load()
+		st.Followers = append(st.Followers, replicaStatus{Addr: r.addr, Offset: offset, Lag: st.Offset - offset})
+	}
+	a.replicasMu.Unlock()
+
+	return st
+}
+
+func (a *App) replication(w http.ResponseWriter, r *http.Request) {
+	respondWithJSON(w, http.StatusOK, a.replicationStatus())
+}
+
+// follow makes the instance a follower of the leader at the given URL, or
+// a leader again when it's empty.
+func (a *App) follow(w http.ResponseWriter, r *http.Request) {
+	var fo followObject
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&fo); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	defer r.Body.Close()
+
+	if a.raft != nil {
+		respondWithError(w, http.StatusConflict, "raft nodes don't follow a leader")
+		return
+	}
+
+	if fo.Leader != "" {
+		if _, err := url.ParseRequestURI(fo.Leader); err != nil {
+			respondWithError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	a.startFollowing(fo.Leader)
+	respondWithJSON(w, http.StatusOK, a.replicationStatus())
+}
+
+func (a *App) promote(w http.ResponseWriter, r *http.Request) {
+	a.startFollowing("")
+	respondWithJSON(w, http.StatusOK, a.replicationStatus())
+}
+
+// startFollowing stops following the current leader, if any, and starts
+// following leader unless it's empty. Expired keys are left to the leader
+// while following.
+func (a *App) startFollowing(leader string) {
+	if f := a.follower.Swap(nil); f != nil {
+		f.cancel()
+		<-f.done
+	}
+
+	for _, c := range a.dbs {
+		c.following.Store(leader != "")
+	}
+	if leader == "" {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	f := &follower{leader: strings.TrimSuffix(leader, "/"), cancel: cancel, done: make(chan struct{})}
+	a.follower.Store(f)
+	go a.runFollower(ctx, f)
+}
+
+// runFollower syncs from the leader until ctx is done, reconnecting when
+// the connection fails.
+func (a *App) runFollower(ctx context.Context, f *follower) {
+	defer close(f.done)
+
+	for {
+		err := a.syncFromLeader(ctx, f)
+
+		f.mu.Lock()
+		f.connected = false
+		f.mu.Unlock()
+
+		if ctx.Err() != nil {
+			return
+		}
+		log.Println("replication:", err)
+
+		select {
+		case <-time.After(replRetryInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// syncFromLeader resumes from the offset of the follower, or loads the
+// keyspace of the leader when it can't, and applies the commands the
+// leader streams until the connection fails.
+func (a *App) syncFromLeader(ctx context.Context, f *follower) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	f.mu.Lock()
+	query := url.Values{"replid": {f.replID}, "offset": {strconv.FormatInt(f.offset, 10)}}
+	f.mu.Unlock()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", f.leader+"/replication/sync?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+
+	// The leader pings idle followers, so a silent connection is dead.
+	watchdog := time.AfterFunc(replTimeout, cancel)
+	defer watchdog.Stop()
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("leader answered %s", resp.Status)
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	var header replHeader
+	if err := decoder.Decode(&header); err != nil {
+		return err
+	}
+	watchdog.Reset(replTimeout)
+
+	if header.Full {
+		for _, c := range a.dbs {
+			c.flush()
+		}
+		for i := 0; i < header.Entries; i++ {
+			var e replEntry
+			if err := decoder.Decode(&e); err != nil {
+				return err
+			}
+			if err := a.applyReplEntry(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	f.mu.Lock()
+	f.replID = header.ReplID
+	f.offset = header.Offset
+	if f.leaderOffset < header.Offset {
+		f.leaderOffset = header.Offset
+	}
+	f.connected = true
+	f.lastContact = time.Now()
+	f.mu.Unlock()
+
+	for {
+		var e replEntry
+		if err := decoder.Decode(&e); err != nil {
+			return err
+		}
+		watchdog.Reset(replTimeout)
+
+		f.mu.Lock()
+		f.lastContact = time.Now()
+		offset := f.offset
+		f.mu.Unlock()
+
+		if e.Op == "ping" {
+			f.mu.Lock()
+			f.leaderOffset = e.Offset
+			f.mu.Unlock()
+			continue
+		}
+
+		if e.Offset != offset+1 {
+			return fmt.Errorf("expected offset %d, got %d", offset+1, e.Offset)
+		}
+		if err := a.applyReplEntry(e); err != nil {
+			return err
+		}
+
+		f.mu.Lock()
+		f.offset = e.Offset
+		if f.leaderOffset < e.Offset {
+			f.leaderOffset = e.Offset
+		}
+		f.mu.Unlock()
+	}
+}
+
+// applyReplEntry applies a command of the leader and logs it like a
+// command of the follower itself, so it is persisted and passed on to
+// the followers of the follower.
+func (a *App) applyReplEntry(e replEntry) error {
+	if e.DB < 0 || e.DB >= len(a.dbs) {
+		return errNoSuchDB
+	}
+
+	c := a.dbs[e.DB]
+	if err := c.applyUnlogged(e.command); err != nil {
+		return err
+	}
+	// flushdb is logged by flush.
+	if e.Op != "flushdb" && c.logging() {
+		c.log(e.command)
+	}
+
+	return nil
+}
+
+// replicationSync streams the replication stream to a follower, see
+// replHeader.
+func (a *App) replicationSync(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		respondWithError(w, http.StatusInternalServerError, "streaming unsupported")
+		return
+	}
+
+	query := r.URL.Query()
+	offset, err := strconv.ParseInt(query.Get("offset"), 10, 64)
+	if err != nil {
+		offset = 0
+	}
+
+	header := replHeader{ReplID: a.repl.id, Offset: offset}
+	var entries []replEntry
+	if query.Get("replid") != a.repl.id || !a.repl.has(offset) {
+		header.Full = true
+		header.Offset, entries, err = a.replSnapshot()
+		if err != nil {
+			respondWithError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		header.Entries = len(entries)
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.Encode(header)
+	for _, e := range entries {
+		if err := encoder.Encode(e); err != nil {
+			return
+		}
+	}
+	flusher.Flush()
+
+	rep := &replica{addr: r.RemoteAddr}
+	rep.offset.Store(header.Offset)
+	a.replicasMu.Lock()
+	a.replicas[rep] = true
+	a.replicasMu.Unlock()
+	defer func() {
+		a.replicasMu.Lock()
+		delete(a.replicas, rep)
+		a.replicasMu.Unlock()
+	}()
+
+	ping := time.NewTicker(replPingInterval)
+	defer ping.Stop()
+
+	offset = header.Offset
+	for {
+		lines, last, changed, ok := a.repl.since(offset)
+		if !ok {
+			// The follower fell behind the backlog, it does a full
+			// sync when it reconnects.
+			return
+		}
+		for _, line := range lines {
+			if _, err := w.Write(line); err != nil {
+				return
+			}
+		}
+		if len(lines) > 0 {
+			flusher.Flush()
+			offset = last
+			rep.offset.Store(offset)
+		}
+
+		select {
+		case <-changed:
+		case <-ping.C:
+			encoder.Encode(replEntry{Offset: a.repl.offset(), command: command{Op: "ping"}})
+			flusher.Flush()
+		case <-r.Context().Done():
+			return
+		case <-a.stop:
+			return
+		}
+	}
+}
+
+// replSnapshot enables the backlog and returns its offset with restore
+// commands of every key of every database at that offset.
+func (a *App) replSnapshot() (int64, []replEntry, error) {
+	var offset int64
+	entries, err := a.keyspaceEntries(func() {
+		a.repl.enabled.Store(true)
+		offset = a.repl.offset()
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return offset, entries, nil
+}
+
+// keyspaceEntries returns restore commands of every key of every database,
+// copied while all of them are locked. locked is called with the locks
+// held.
+func (a *App) keyspaceEntries(locked func()) ([]replEntry, error) {
+	for _, c := range a.dbs {
+		c.rlockAll()
+	}
+	locked()
+	copies := make([][]snapshotEntry, len(a.dbs))
+	for i, c := range a.dbs {
+		copies[i] = c.copyItems()
+	}
+	for _, c := range a.dbs {
+		c.runlockAll()
+	}
+
+	var entries []replEntry
+	for db, copy := range copies {
+		for _, e := range copy {
+			r, err := encodeItem(e.key, e.item)
+			if err != nil {
+				return nil, err
+			}
+			cmd := command{Op: "restore", Key: r.Key, Type: r.Type, Value: r.Value, Expired: r.Expired, Version: r.Version}
+			entries = append(entries, replEntry{DB: db, command: cmd})
+		}
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,251 @@
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func init() {
+	replPingInterval = 20 * time.Millisecond
+	replRetryInterval = 10 * time.Millisecond
+	replTimeout = time.Second
+}
+
+// newReplServer starts an instance whose replication stream answers 503
+// while down is set.
+func newReplServer(t *testing.T, config Config) (*App, *httptest.Server, *atomic.Bool) {
+	a, err := NewApp(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.Initialize()
+
+	down := &atomic.Bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if down.Load() && r.URL.Path == "/replication/sync" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		a.Router.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(a.Close)
+
+	return a, server, down
+}
+
+func waitFor(t *testing.T, what string, cond func() bool) {
+	deadline := time.Now().Add(3 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal(what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// caughtUp reports whether the follower applied everything the leader
+// logged.
+func caughtUp(leader *App, follower *App) bool {
+	st := follower.replicationStatus()
+	return st.Connected && st.ReplID == leader.repl.id && st.Offset == leader.repl.offset()
+}
+
+func TestReplication_SyncAndStream(t *testing.T) {
+	leader, server, _ := newReplServer(t, Config{Shards: 4, Databases: 2})
+	leader.dbs[0].set("a", "one", 0)
+	leader.dbs[1].rpush("l", "x", 0)
+
+	follower, _, _ := newReplServer(t, Config{Shards: 4, Databases: 2, Follow: server.URL})
+	waitFor(t, "Follower doesn't do a full sync", func() bool { return caughtUp(leader, follower) })
+
+	if v, _ := follower.dbs[0].get("a"); v != "one" {
+		t.Error("Full sync doesn't copy the keys", v)
+	}
+	if l, _ := follower.dbs[1].lgetall("l"); len(l) != 1 {
+		t.Error("Full sync doesn't copy the other databases", l)
+	}
+
+	leader.dbs[0].set("b", "two", 0)
+	leader.dbs[0].hset("h", map[string]interface{}{"f": "v"}, 0)
+	leader.dbs[0].set("short", "x", 0)
+	leader.dbs[0].expire("short", 10*time.Millisecond)
+	leader.moveKey("l", 1, 0)
+	waitFor(t, "Follower doesn't stream the writes", func() bool { return caughtUp(leader, follower) })
+
+	if v, _ := follower.dbs[0].get("b"); v != "two" {
+		t.Error("Write isn't replicated", v)
+	}
+	if v, _ := follower.dbs[0].hget("h", "f"); v != "v" {
+		t.Error("Dict isn't replicated", v)
+	}
+	if _, err := follower.dbs[0].lgetall("l"); err != nil || follower.dbs[1].count.Load() != 0 {
+		t.Error("Move isn't replicated", err)
+	}
+	lv, _ := leader.dbs[0].version("b")
+	if fv, _ := follower.dbs[0].version("b"); fv != lv {
+		t.Error("Version isn't replicated", fv, lv)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	follower.dbs[0].DeleteExpired()
+	if follower.dbs[0].count.Load() != 5 {
+		t.Error("Follower expires keys by itself")
+	}
+	leader.dbs[0].DeleteExpired()
+	waitFor(t, "Expiration isn't replicated", func() bool { return follower.dbs[0].count.Load() == 4 })
+}
+
+func TestReplication_LogsOnce(t *testing.T) {
+	leader, server, _ := newReplServer(t, Config{Shards: 4})
+	leader.cache.rpush("l", "a", 0)
+
+	path := filepath.Join(t.TempDir(), "cache.aof")
+	follower, followerServer, _ := newReplServer(t, Config{Shards: 4, Follow: server.URL, AOFPath: path, AOFFsync: FsyncAlways})
+	chained, _, _ := newReplServer(t, Config{Shards: 4, Follow: followerServer.URL})
+	waitFor(t, "Follower doesn't sync", func() bool { return caughtUp(leader, follower) && caughtUp(follower, chained) })
+	leaderStart, followerStart := leader.repl.offset(), follower.repl.offset()
+
+	leader.cache.push("l", []interface{}{"x", "y"}, false, 0)
+	leader.cache.hset("h", map[string]interface{}{"f": 1}, 0)
+	leader.cache.incrby("n", 1, 0, false)
+	leader.cache.pop("l")
+	waitFor(t, "Writes aren't streamed down the chain", func() bool { return caughtUp(leader, follower) && caughtUp(follower, chained) })
+
+	if logged, want := follower.repl.offset()-followerStart, leader.repl.offset()-leaderStart; logged != want {
+		t.Error("Follower logs the commands of the leader more than once", logged, want)
+	}
+
+	replayed := NewCache(0)
+	if err := replayed.replayAOF(path); err != nil {
+		t.Fatal(err)
+	}
+	for name, c := range map[string]*cache{"AOF of the follower": replayed, "Chained follower": chained.cache} {
+		want, _ := leader.cache.lgetall("l")
+		if l, _ := c.lgetall("l"); !reflect.DeepEqual(l, want) {
+			t.Error(name, "doesn't match the leader's list", l, want)
+		}
+		if v, _ := c.hget("h", "f"); v != json.Number("1") {
+			t.Error(name, "doesn't match the leader's dict", v)
+		}
+		if v, _ := c.get("n"); v != json.Number("1") && v != int64(1) {
+			t.Error(name, "doesn't match the leader's counter", v)
+		}
+	}
+}
+
+func TestReplication_FollowerRejectsWrites(t *testing.T) {
+	_, server, _ := newReplServer(t, Config{Shards: 4})
+	follower, followerServer, _ := newReplServer(t, Config{Shards: 4, Follow: server.URL})
+
+	var respErr map[string]string
+	if code := dbRequest(t, "POST", followerServer.URL+"/set", "", `{"key":"k","value":1}`, &respErr); code != http.StatusForbidden || respErr["error"] != errReadOnly.Error() {
+		t.Error("Follower doesn't reject writes", code, respErr)
+	}
+	if code := dbRequest(t, "DELETE", followerServer.URL+"/db/0/unset/k", "", "", nil); code != http.StatusForbidden {
+		t.Error("Follower doesn't reject writes with the database prefix", code)
+	}
+	if code := dbRequest(t, "POST", followerServer.URL+"/tx", "", `[{"op":"get","args":{"key":"k"}},{"op":"incr","args":{"key":"k"}}]`, nil); code != http.StatusForbidden {
+		t.Error("Follower doesn't reject transactions with writes", code)
+	}
+	if code := dbRequest(t, "POST", followerServer.URL+"/tx", "", `[{"op":"get","args":{"key":"k"}}]`, nil); code != http.StatusOK {
+		t.Error("Follower rejects read-only transactions", code)
+	}
+	if code := dbRequest(t, "GET", followerServer.URL+"/get/k", "", "", nil); code != http.StatusNotFound {
+		t.Error("Follower rejects reads", code)
+	}
+
+	var st replicationStatus
+	if code := dbRequest(t, "POST", followerServer.URL+"/replication/promote", "", "", &st); code != http.StatusOK || st.Role != "leader" {
+		t.Error("Follower isn't promoted", code, st)
+	}
+	if code := dbRequest(t, "POST", followerServer.URL+"/set", "", `{"key":"k","value":1}`, nil); code != http.StatusCreated {
+		t.Error("Promoted follower rejects writes", code)
+	}
+	if follower.dbs[0].following.Load() {
+		t.Error("Promoted follower leaves expiration to the leader")
+	}
+}
+
+func TestReplication_ResumeFromOffset(t *testing.T) {
+	leader, server, down := newReplServer(t, Config{Shards: 4})
+	follower, _, _ := newReplServer(t, Config{Shards: 4, Follow: server.URL})
+	leader.cache.set("a", 1, 0)
+	waitFor(t, "Follower doesn't sync", func() bool { return caughtUp(leader, follower) })
+
+	// A full resync would drop a key the leader doesn't have.
+	follower.cache.set("marker", 1, 0)
+
+	down.Store(true)
+	server.CloseClientConnections()
+	waitFor(t, "Follower doesn't notice the lost connection", func() bool { return !follower.replicationStatus().Connected })
+	for i := 0; i < 10; i++ {
+		leader.cache.incrby("n", 1, 0, false)
+	}
+	down.Store(false)
+
+	waitFor(t, "Follower doesn't reconnect", func() bool { return caughtUp(leader, follower) })
+	if v, _ := follower.cache.get("n"); v != json.Number("10") && v != int64(10) {
+		t.Error("Writes missed while disconnected aren't replicated", v)
+	}
+	if _, err := follower.cache.get("marker"); err != nil {
+		t.Error("Follower does a full resync instead of resuming")
+	}
+}
+
+func TestReplication_FullResyncWhenBehind(t *testing.T) {
+	leader, server, down := newReplServer(t, Config{Shards: 4, ReplBacklogSize: 256})
+	follower, _, _ := newReplServer(t, Config{Shards: 4, Follow: server.URL})
+	leader.cache.set("a", 1, 0)
+	waitFor(t, "Follower doesn't sync", func() bool { return caughtUp(leader, follower) })
+	follower.cache.set("marker", 1, 0)
+
+	down.Store(true)
+	server.CloseClientConnections()
+	waitFor(t, "Follower doesn't notice the lost connection", func() bool { return !follower.replicationStatus().Connected })
+	for i := 0; i < 100; i++ {
+		leader.cache.incrby("n", 1, 0, false)
+	}
+	down.Store(false)
+
+	waitFor(t, "Follower doesn't resync", func() bool { return caughtUp(leader, follower) })
+	if v, _ := follower.cache.get("n"); v != json.Number("100") && v != int64(100) {
+		t.Error("Full resync doesn't copy the keys", v)
+	}
+	if _, err := follower.cache.get("marker"); err == nil {
+		t.Error("Follower resumes from commands dropped from the backlog")
+	}
+}
+
+func TestReplication_Status(t *testing.T) {
+	leader, server, _ := newReplServer(t, Config{Shards: 4})
+	follower, followerServer, _ := newReplServer(t, Config{Shards: 4, Follow: server.URL})
+	waitFor(t, "Follower doesn't sync", func() bool { return caughtUp(leader, follower) })
+	leader.cache.set("a", 1, 0)
+	leader.cache.set("b", 1, 0)
+	waitFor(t, "Follower doesn't stream the writes", func() bool { return caughtUp(leader, follower) })
+	waitFor(t, "Leader doesn't track the follower", func() bool {
+		st := leader.replicationStatus()
+		return len(st.Followers) == 1 && st.Followers[0].Offset == st.Offset
+	})
+
+	var st map[string]interface{}
+	dbRequest(t, "GET", server.URL+"/replication", "", "", &st)
+	if st["role"] != "leader" || st["offset"] != 2.0 {
+		t.Error("Leader doesn't report its offset", st)
+	}
+	if followers, _ := st["followers"].([]interface{}); len(followers) != 1 {
+		t.Error("Leader doesn't report its followers", st)
+	}
+
+	dbRequest(t, "GET", followerServer.URL+"/replication", "", "", &st)
+	if st["role"] != "follower" || st["offset"] != 2.0 || st["lag"] != 0.0 || st["connected"] != true || st["leader"] != server.URL {
+		t.Error("Follower doesn't report its offset and lag", st)
+	}
+}
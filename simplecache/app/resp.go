@@ -0,0 +1,766 @@
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limits of a request, as in Redis.
+const (
+	respMaxArgs     = 1024 * 1024
+	respMaxBulkSize = 512 * 1024 * 1024
+	respMaxInline   = 64 * 1024
+)
+
+const errWrongTypeReply = "WRONGTYPE Operation against a key holding the wrong kind of value"
+
+// respProtocolError is a malformed request, the connection is closed after
+// it is reported.
+type respProtocolError string
+
+func (e respProtocolError) Error() string {
+	return "Protocol error: " + string(e)
+}
+
+// respConn is a connection of the RESP listener. Replies are buffered and
+// flushed when no more pipelined commands are waiting.
+type respConn struct {
+	r *bufio.Reader
+	w *bufio.Writer
+	// proto is the protocol version chosen by HELLO, 2 or 3.
+	proto int
+	// a is the app serving the connection and db the database chosen by
+	// SELECT.
+	a  *App
+	db int
+	// asking is set by ASKING for the next command, see cluster.route.
+	asking bool
+	// resolving is set on the connection a raft leader runs a write on,
+	// see App.resolveRaft.
+	resolving bool
+}
+
+// respCommand is a command of the RESP listener.
+type respCommand struct {
+	// arity is the number of arguments including the command name, or
+	// minus the least number of them, as in Redis.
+	arity int
+	run   func(c *cache, conn *respConn, args []string)
+}
+
+var respCommands = map[string]respCommand{
+	"ping":    {-1, respPing},
+	"hello":   {-1, respHello},
+	"command": {-1, respIgnored},
+	"client":  {-2, respIgnored},
+	"info":    {-1, respInfo},
+	"get":     {2, respGet},
+	"set":     {-3, respSet},
+	"del":     {-2, respDel},
+	"keys":    {2, respKeys},
+	"expire":  {3, respExpire},
+	"ttl":     {2, respTTL},
+	"rpush":   {-3, respRpush},
+	"rpop":    {2, respRpop},
+	"lindex":  {3, respLindex},
+	"lrange":  {4, respLrange},
+	"hset":    {-4, respHset},
+	"hget":    {3, respHget},
+	"hgetall": {2, respHgetall},
+	"select":  {2, respSelect},
+	"dbsize":  {1, respDbsize},
+	"flushdb": {-1, respFlushdb},
+	"move":    {3, respMove},
+	"asking":  {1, respAsking},
+}
+
+// respWrites are the commands a follower rejects.
+var respWrites = map[string]bool{
+	"set": true, "del": true, "expire": true, "rpush": true, "rpop": true,
+	"hset": true, "flushdb": true, "move": true,
+}
+
+// serveRESP accepts RESP2 and RESP3 connections on l until ctx is done.
+func (a *App) serveRESP(ctx context.Context, l net.Listener) error {
+	go func() {
+		<-ctx.Done()
+		l.Close()
+	}()
+
+	for {
+		nc, err := l.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		go a.serveRESPConn(nc)
+	}
+}
+
+func (a *App) serveRESPConn(nc net.Conn) {
+	defer nc.Close()
+	// A bug hit by one client closes its connection, not the server.
+	defer func() {
+		if r := recover(); r != nil {
+			log.Println("resp: panic serving", nc.RemoteAddr(), r)
+		}
+	}()
+
+	conn := &respConn{r: bufio.NewReader(nc), w: bufio.NewWriter(nc), proto: 2, a: a}
+	for {
+		args, err := conn.readCommand()
+		if err != nil {
+			if pe, ok := err.(respProtocolError); ok {
+				conn.writeError("ERR " + pe.Error())
+				conn.w.Flush()
+			} else if err != io.EOF {
+				log.Println("resp:", err)
+			}
+			return
+		}
+		if len(args) == 0 {
+			continue
+		}
+
+		quit := strings.EqualFold(args[0], "quit")
+		if quit {
+			conn.writeSimple("OK")
+		} else {
+			conn.exec(a.dbs[conn.db], args)
+		}
+
+		if quit || conn.r.Buffered() == 0 {
+			if err := conn.w.Flush(); err != nil {
+				return
+			}
+		}
+		if quit {
+			return
+		}
+	}
+}
+
+func (conn *respConn) exec(c *cache, args []string) {
+	name := strings.ToLower(args[0])
+	cmd, found := respCommands[name]
+	if !found {
+		conn.writeError(fmt.Sprintf("ERR unknown command '%s'", args[0]))
+		return
+	}
+	if cmd.arity > 0 && len(args) != cmd.arity || cmd.arity < 0 && len(args) < -cmd.arity {
+		conn.writeError(fmt.Sprintf("ERR wrong number of arguments for '%s' command", name))
+		return
+	}
+	if respWrites[name] && conn.a.follower.Load() != nil {
+		conn.writeError("READONLY You can't write against a read only replica.")
+		return
+	}
+
+	if respWrites[name] && conn.a.raft != nil && !conn.resolving {
+		reply, err := conn.a.resolveRaft(context.Background(), func() interface{} {
+			var b bytes.Buffer
+			rc := &respConn{w: bufio.NewWriter(&b), proto: conn.proto, a: conn.a, db: conn.db, resolving: true}
+			rc.exec(c, args)
+			rc.w.Flush()
+			return b.Bytes()
+		})
+		if err != nil {
+			conn.writeError(raftMessage(err, respLeaderAddr))
+			return
+		}
+		conn.w.Write(reply.([]byte))
+		return
+	}
+
+	if conn.a.cluster != nil {
+		asking := conn.asking
+		conn.asking = false
+		redirect, done, err := conn.a.cluster.route(respCommandKeys(name, args), asking, c.exists)
+		if err != nil {
+			conn.writeError(err.Error())
+			return
+		}
+		if redirect != nil {
+			addr := redirect.node.RESP
+			if addr == "" {
+				addr = redirect.node.Addr
+			}
+			conn.writeError(redirect.message(addr))
+			return
+		}
+		defer done()
+	}
+
+	cmd.run(c, conn, args)
+}
+
+// respCommandKeys returns the keys of a command.
+func respCommandKeys(name string, args []string) []string {
+	switch name {
+	case "del":
+		return args[1:]
+	case "ping", "hello", "command", "client", "info", "keys", "select", "dbsize", "flushdb", "asking":
+		return nil
+	}
+
+	return args[1:2]
+}
+
+// readCommand reads a command sent as an array of bulk strings, or as an
+// inline command separated by spaces like telnet sends it.
+func (conn *respConn) readCommand() ([]string, error) {
+	line, err := conn.readLine()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(line) == 0 || line[0] != '*' {
+		if len(line) > respMaxInline {
+			return nil, respProtocolError("too big inline request")
+		}
+		return strings.Fields(line), nil
+	}
+
+	n, err := strconv.Atoi(line[1:])
+	if err != nil || n > respMaxArgs {
+		return nil, respProtocolError("invalid multibulk length")
+	}
+	// Redis skips empty and null arrays, *-1 included.
+	if n <= 0 {
+		return nil, nil
+	}
+
+	args := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		line, err := conn.readLine()
+		if err != nil {
+			return nil, err
+		}
+		if len(line) == 0 || line[0] != '$' {
+			return nil, respProtocolError("expected '$', got '" + line + "'")
+		}
+		size, err := strconv.Atoi(line[1:])
+		if err != nil || size < 0 || size > respMaxBulkSize {
+			return nil, respProtocolError("invalid bulk length")
+		}
+
+		b := make([]byte, size+2)
+		if _, err := io.ReadFull(conn.r, b); err != nil {
+			return nil, err
+		}
+		if b[size] != '\r' || b[size+1] != '\n' {
+			return nil, respProtocolError("bulk string isn't terminated")
+		}
+		args = append(args, string(b[:size]))
+	}
+
+	return args, nil
+}
+
+func (conn *respConn) readLine() (string, error) {
+	line, err := conn.r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func (conn *respConn) writeSimple(s string) {
+	conn.w.WriteString("+" + s + "\r\n")
+}
+
+func (conn *respConn) writeError(s string) {
+	conn.w.WriteString("-" + s + "\r\n")
+}
+
+// writeErr replies with the error of a cache method, using the error
+// codes of Redis for the errors it has them for.
+func (conn *respConn) writeErr(err error) {
+	switch {
+	case err == errOutOfMemory:
+		conn.writeError("OOM command not allowed when used memory > 'maxmemory'")
+	case isWrongType(err):
+		conn.writeError(errWrongTypeReply)
+	default:
+		conn.writeError("ERR " + err.Error())
+	}
+}
+
+func (conn *respConn) writeInt(n int64) {
+	conn.w.WriteString(":" + strconv.FormatInt(n, 10) + "\r\n")
+}
+
+func (conn *respConn) writeBulk(s string) {
+	conn.w.WriteString("$" + strconv.Itoa(len(s)) + "\r\n" + s + "\r\n")
+}
+
+func (conn *respConn) writeNull() {
+	if conn.proto == 3 {
+		conn.w.WriteString("_\r\n")
+	} else {
+		conn.w.WriteString("$-1\r\n")
+	}
+}
+
+func (conn *respConn) writeArray(n int) {
+	conn.w.WriteString("*" + strconv.Itoa(n) + "\r\n")
+}
+
+// writeMap starts a map of n pairs, a flat array of them in RESP2.
+func (conn *respConn) writeMap(n int) {
+	if conn.proto == 3 {
+		conn.w.WriteString("%" + strconv.Itoa(n) + "\r\n")
+	} else {
+		conn.writeArray(2 * n)
+	}
+}
+
+func (conn *respConn) writeValues(values []interface{}) {
+	conn.writeArray(len(values))
+	for _, v := range values {
+		conn.writeBulk(respString(v))
+	}
+}
+
+// respString formats a stored value as a bulk string. Values set over HTTP
+// may be numbers or JSON objects, which are written as they were sent.
+func respString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case json.Number:
+		return string(v)
+	case json.RawMessage:
+		return string(v)
+	case *rawValue:
+		return string(v.Data)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+func isWrongType(err error) bool {
+	switch err.Error() {
+	case "wrong type", "wrong object type", "invalid object type":
+		return true
+	}
+
+	return false
+}
+
+func isNotFound(err error) bool {
+	return err.Error() == "not found"
+}
+
+func respInt(s string) (int, bool) {
+	n, err := strconv.Atoi(s)
+	return n, err == nil
+}
+
+func respPing(c *cache, conn *respConn, args []string) {
+	switch len(args) {
+	case 1:
+		conn.writeSimple("PONG")
+	case 2:
+		conn.writeBulk(args[1])
+	default:
+		conn.writeError("ERR wrong number of arguments for 'ping' command")
+	}
+}
+
+// respHello switches the protocol version, the AUTH and SETNAME options
+// are ignored.
+func respHello(c *cache, conn *respConn, args []string) {
+	if len(args) > 1 {
+		proto, ok := respInt(args[1])
+		if !ok || proto != 2 && proto != 3 {
+			conn.writeError("NOPROTO unsupported protocol version")
+			return
+		}
+		conn.proto = proto
+	}
+
+	conn.writeMap(4)
+	conn.writeBulk("server")
+	conn.writeBulk("simplecache")
+	conn.writeBulk("proto")
+	conn.writeInt(int64(conn.proto))
+	conn.writeBulk("mode")
+	conn.writeBulk("standalone")
+	conn.writeBulk("role")
+	conn.writeBulk("master")
+}
+
+// respIgnored answers the commands clients send on connect, like COMMAND
+// DOCS and CLIENT SETINFO, without doing anything.
+func respIgnored(c *cache, conn *respConn, args []string) {
+	if strings.EqualFold(args[0], "command") {
+		conn.writeArray(0)
+		return
+	}
+	conn.writeSimple("OK")
+}
+
+func respInfo(c *cache, conn *respConn, args []string) {
+	st := c.stats()
+	sections := []struct {
+		name   string
+		fields [][2]string
+	}{
+		{"Server", [][2]string{{"server", "simplecache"}, {"resp_protocols", "2,3"}}},
+		{"Memory", [][2]string{
+			{"used_memory", strconv.FormatInt(st.UsedMemory, 10)},
+			{"maxmemory", strconv.FormatInt(st.MaxMemory, 10)},
+			{"maxmemory_policy", st.EvictionPolicy},
+		}},
+		{"Stats", [][2]string{
+			{"evicted_keys", strconv.FormatInt(st.EvictedKeys, 10)},
+			{"rejected_writes", strconv.FormatInt(st.RejectedWrites, 10)},
+		}},
+		{"Replication", respReplication(conn.a.replicationStatus())},
+		{"Keyspace", nil},
+	}
+	for i, db := range conn.a.dbs {
+		if keys := db.count.Load(); keys > 0 || i == 0 {
+			sections[4].fields = append(sections[4].fields, [2]string{"db" + strconv.Itoa(i), "keys=" + strconv.FormatInt(keys, 10)})
+		}
+	}
+
+	var b strings.Builder
+	for _, section := range sections {
+		if len(args) > 1 && !strings.EqualFold(args[1], section.name) && !strings.EqualFold(args[1], "all") {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteString("\r\n")
+		}
+		b.WriteString("# " + section.name + "\r\n")
+		for _, f := range section.fields {
+			b.WriteString(f[0] + ":" + f[1] + "\r\n")
+		}
+	}
+
+	conn.writeBulk(b.String())
+}
+
+// respReplication returns the fields of the replication section of INFO
+// with the names Redis uses.
+func respReplication(st replicationStatus) [][2]string {
+	if st.followerStatus == nil {
+		return [][2]string{
+			{"role", "master"},
+			{"connected_slaves", strconv.Itoa(len(st.Followers))},
+			{"master_replid", st.ReplID},
+			{"master_repl_offset", strconv.FormatInt(st.Offset, 10)},
+		}
+	}
+
+	link := "down"
+	if st.Connected {
+		link = "up"
+	}
+	return [][2]string{
+		{"role", "slave"},
+		{"master_host", st.Leader},
+		{"master_link_status", link},
+		{"master_last_io_seconds_ago", strconv.FormatInt(st.LastContactMs/1000, 10)},
+		{"slave_repl_offset", strconv.FormatInt(st.Offset, 10)},
+		{"master_repl_offset", strconv.FormatInt(st.LeaderOffset, 10)},
+	}
+}
+
+func respAsking(c *cache, conn *respConn, args []string) {
+	conn.asking = true
+	conn.writeSimple("OK")
+}
+
+func respGet(c *cache, conn *respConn, args []string) {
+	v, err := c.get(args[1])
+	switch {
+	case err != nil && isNotFound(err):
+		conn.writeNull()
+	case err != nil:
+		conn.writeErr(err)
+	default:
+		conn.writeBulk(respString(v))
+	}
+}
+
+// respSet is SET key value [EX seconds | PX milliseconds] [NX | XX].
+func respSet(c *cache, conn *respConn, args []string) {
+	key := args[1]
+	var flags setFlags
+	var ttl time.Duration
+	for i := 3; i < len(args); i++ {
+		switch strings.ToLower(args[i]) {
+		case "nx":
+			flags.nx = true
+		case "xx":
+			flags.xx = true
+		case "ex", "px":
+			if ttl != 0 || i+1 == len(args) {
+				conn.writeError("ERR syntax error")
+				return
+			}
+			n, ok := respInt(args[i+1])
+			if !ok || n <= 0 {
+				conn.writeError("ERR invalid expire time in 'set' command")
+				return
+			}
+			ttl = time.Duration(n) * time.Second
+			if strings.EqualFold(args[i], "px") {
+				ttl = time.Duration(n) * time.Millisecond
+			}
+			i++
+		default:
+			conn.writeError("ERR syntax error")
+			return
+		}
+	}
+	if flags.nx && flags.xx {
+		conn.writeError("ERR syntax error")
+		return
+	}
+
+	if err := c.ensureCapacity(key); err != nil {
+		conn.writeErr(err)
+		return
+	}
+	var e int64
+	if ttl > 0 {
+		e = time.Now().Add(ttl).UnixNano()
+	}
+
+	s := c.getShard(key)
+	s.mu.Lock()
+	ok, _, err := s.setCond(key, args[2], e, flags)
+	s.mu.Unlock()
+
+	switch {
+	case err != nil:
+		conn.writeErr(err)
+	case !ok:
+		conn.writeNull()
+	default:
+		conn.writeSimple("OK")
+	}
+}
+
+func respDel(c *cache, conn *respConn, args []string) {
+	conn.writeInt(int64(c.deleteKeys(args[1:])))
+}
+
+func respKeys(c *cache, conn *respConn, args []string) {
+	keys := c.keysMatching(args[1])
+	sort.Strings(keys)
+
+	conn.writeArray(len(keys))
+	for _, k := range keys {
+		conn.writeBulk(k)
+	}
+}
+
+func respExpire(c *cache, conn *respConn, args []string) {
+	seconds, ok := respInt(args[2])
+	if !ok {
+		conn.writeError("ERR value is not an integer or out of range")
+		return
+	}
+
+	err := c.expire(args[1], time.Duration(seconds)*time.Second)
+	switch {
+	case err != nil && isNotFound(err):
+		conn.writeInt(0)
+	case err != nil:
+		conn.writeErr(err)
+	default:
+		conn.writeInt(1)
+	}
+}
+
+func respTTL(c *cache, conn *respConn, args []string) {
+	ttl, err := c.ttl(args[1])
+	switch {
+	case err != nil && isNotFound(err):
+		conn.writeInt(-2)
+	case err != nil:
+		conn.writeErr(err)
+	case ttl == noExpiry:
+		conn.writeInt(-1)
+	default:
+		conn.writeInt(int64((ttl + time.Second/2) / time.Second))
+	}
+}
+
+func respRpush(c *cache, conn *respConn, args []string) {
+	values := make([]interface{}, len(args)-2)
+	for i, v := range args[2:] {
+		values[i] = v
+	}
+
+	length, err := c.push(args[1], values, false, 0)
+	if err != nil {
+		conn.writeErr(err)
+		return
+	}
+	conn.writeInt(int64(length))
+}
+
+func respRpop(c *cache, conn *respConn, args []string) {
+	v, err := c.pop(args[1])
+	switch {
+	case err != nil && isNotFound(err):
+		conn.writeNull()
+	case err != nil:
+		conn.writeErr(err)
+	default:
+		conn.writeBulk(respString(v))
+	}
+}
+
+func respLindex(c *cache, conn *respConn, args []string) {
+	index, ok := respInt(args[2])
+	if !ok {
+		conn.writeError("ERR value is not an integer or out of range")
+		return
+	}
+
+	v, err := c.lget(args[1], index)
+	switch {
+	case err != nil && isNotFound(err):
+		conn.writeNull()
+	case err != nil:
+		conn.writeErr(err)
+	default:
+		conn.writeBulk(respString(v))
+	}
+}
+
+func respLrange(c *cache, conn *respConn, args []string) {
+	start, ok1 := respInt(args[2])
+	stop, ok2 := respInt(args[3])
+	if !ok1 || !ok2 {
+		conn.writeError("ERR value is not an integer or out of range")
+		return
+	}
+
+	values, err := c.lrange(args[1], start, stop)
+	switch {
+	case err != nil && isNotFound(err):
+		conn.writeArray(0)
+	case err != nil:
+		conn.writeErr(err)
+	default:
+		conn.writeValues(values)
+	}
+}
+
+func respHset(c *cache, conn *respConn, args []string) {
+	if len(args)%2 != 0 {
+		conn.writeError("ERR wrong number of arguments for 'hset' command")
+		return
+	}
+	value := map[string]interface{}{}
+	for i := 2; i < len(args); i += 2 {
+		value[args[i]] = args[i+1]
+	}
+
+	added, err := c.hsetCount(args[1], value, 0)
+	if err != nil {
+		conn.writeErr(err)
+		return
+	}
+	conn.writeInt(int64(added))
+}
+
+func respHget(c *cache, conn *respConn, args []string) {
+	v, err := c.hget(args[1], args[2])
+	switch {
+	case err != nil && isNotFound(err):
+		conn.writeNull()
+	case err != nil:
+		conn.writeErr(err)
+	default:
+		conn.writeBulk(respString(v))
+	}
+}
+
+func respHgetall(c *cache, conn *respConn, args []string) {
+	dict, err := c.hgetall(args[1])
+	if err != nil && !isNotFound(err) {
+		conn.writeErr(err)
+		return
+	}
+
+	fields := make([]string, 0, len(dict))
+	for f := range dict {
+		fields = append(fields, f)
+	}
+	sort.Strings(fields)
+
+	conn.writeMap(len(fields))
+	for _, f := range fields {
+		conn.writeBulk(f)
+		conn.writeBulk(respString(dict[f]))
+	}
+}
+
+func respSelect(c *cache, conn *respConn, args []string) {
+	db, err := conn.a.parseDB(args[1])
+	if err != nil {
+		conn.writeError("ERR DB index is out of range")
+		return
+	}
+
+	conn.db = db
+	conn.writeSimple("OK")
+}
+
+func respDbsize(c *cache, conn *respConn, args []string) {
+	conn.writeInt(c.count.Load())
+}
+
+// respFlushdb accepts the ASYNC and SYNC options, the flush is always
+// synchronous.
+func respFlushdb(c *cache, conn *respConn, args []string) {
+	c.flush()
+	conn.writeSimple("OK")
+}
+
+func respMove(c *cache, conn *respConn, args []string) {
+	db, err := conn.a.parseDB(args[2])
+	if err != nil {
+		conn.writeError("ERR DB index is out of range")
+		return
+	}
+
+	moved, err := conn.a.moveKey(args[1], conn.db, db)
+	switch {
+	case err != nil && isNotFound(err):
+		conn.writeInt(0)
+	case err != nil:
+		conn.writeErr(err)
+	case moved:
+		conn.writeInt(1)
+	default:
+		conn.writeInt(0)
+	}
+}
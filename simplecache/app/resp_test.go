@@ -0,0 +1,302 @@
+package app
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// respClient is a minimal RESP client. Replies are decoded to strings,
+// int64, nil, slices, maps and errors.
+type respClient struct {
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+func newRESPServer(t *testing.T) (*App, *respClient) {
+	a, err := NewApp(Config{Shards: 4, Databases: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go a.serveRESP(ctx, l)
+
+	conn, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return a, &respClient{conn: conn, r: bufio.NewReader(conn)}
+}
+
+func encodeCommand(args ...string) string {
+	s := "*" + strconv.Itoa(len(args)) + "\r\n"
+	for _, a := range args {
+		s += "$" + strconv.Itoa(len(a)) + "\r\n" + a + "\r\n"
+	}
+
+	return s
+}
+
+func (c *respClient) do(t *testing.T, args ...string) interface{} {
+	if _, err := io.WriteString(c.conn, encodeCommand(args...)); err != nil {
+		t.Fatal(err)
+	}
+
+	return c.read(t)
+}
+
+func (c *respClient) read(t *testing.T) interface{} {
+	line, err := c.r.ReadString('\n')
+	if err != nil {
+		t.Fatal(err)
+	}
+	line = strings.TrimSuffix(line, "\r\n")
+
+	switch line[0] {
+	case '+':
+		return line[1:]
+	case '-':
+		return errors.New(line[1:])
+	case ':':
+		n, _ := strconv.ParseInt(line[1:], 10, 64)
+		return n
+	case '_':
+		return nil
+	case '$':
+		size, _ := strconv.Atoi(line[1:])
+		if size < 0 {
+			return nil
+		}
+		b := make([]byte, size+2)
+		if _, err := io.ReadFull(c.r, b); err != nil {
+			t.Fatal(err)
+		}
+		return string(b[:size])
+	case '*':
+		n, _ := strconv.Atoi(line[1:])
+		if n < 0 {
+			return nil
+		}
+		values := make([]interface{}, n)
+		for i := range values {
+			values[i] = c.read(t)
+		}
+		return values
+	case '%':
+		n, _ := strconv.Atoi(line[1:])
+		m := map[interface{}]interface{}{}
+		for i := 0; i < n; i++ {
+			k := c.read(t)
+			m[k] = c.read(t)
+		}
+		return m
+	}
+
+	t.Fatal("Unknown reply", line)
+	return nil
+}
+
+func TestRESP_Commands(t *testing.T) {
+	_, c := newRESPServer(t)
+
+	for _, tt := range []struct {
+		args []string
+		want interface{}
+	}{
+		{[]string{"PING"}, "PONG"},
+		{[]string{"ping", "hi"}, "hi"},
+		{[]string{"GET", "k"}, nil},
+		{[]string{"SET", "k", "v"}, "OK"},
+		{[]string{"SET", "k", "w", "NX"}, nil},
+		{[]string{"GET", "k"}, "v"},
+		{[]string{"TTL", "k"}, int64(-1)},
+		{[]string{"EXPIRE", "k", "100"}, int64(1)},
+		{[]string{"TTL", "k"}, int64(100)},
+		{[]string{"TTL", "missing"}, int64(-2)},
+		{[]string{"RPUSH", "l", "a", "b", "c"}, int64(3)},
+		{[]string{"LINDEX", "l", "-1"}, "c"},
+		{[]string{"LINDEX", "l", "5"}, nil},
+		{[]string{"LRANGE", "l", "0", "1"}, []interface{}{"a", "b"}},
+		{[]string{"LRANGE", "missing", "0", "-1"}, []interface{}{}},
+		{[]string{"RPOP", "l"}, "c"},
+		{[]string{"HSET", "h", "f1", "1", "f2", "2"}, int64(2)},
+		{[]string{"HSET", "h", "f1", "3"}, int64(0)},
+		{[]string{"HGET", "h", "f1"}, "3"},
+		{[]string{"HGETALL", "h"}, []interface{}{"f1", "3", "f2", "2"}},
+		{[]string{"KEYS", "*"}, []interface{}{"h", "k", "l"}},
+		{[]string{"DEL", "k", "l", "missing"}, int64(2)},
+		{[]string{"KEYS", "*"}, []interface{}{"h"}},
+	} {
+		if got := c.do(t, tt.args...); !reflect.DeepEqual(got, tt.want) {
+			t.Error(tt.args, "replies", got, "instead of", tt.want)
+		}
+	}
+
+	if info := c.do(t, "INFO", "keyspace"); info != "# Keyspace\r\ndb0:keys=1\r\n" {
+		t.Error("Info keyspace is wrong", info)
+	}
+}
+
+func TestRESP_Errors(t *testing.T) {
+	_, c := newRESPServer(t)
+	c.do(t, "SET", "s", "v")
+	c.do(t, "RPUSH", "l", "a")
+
+	for _, tt := range []struct {
+		args []string
+		want string
+	}{
+		{[]string{"RPUSH", "s", "a"}, "WRONGTYPE"},
+		{[]string{"LRANGE", "s", "0", "-1"}, "WRONGTYPE"},
+		{[]string{"HGET", "l", "f"}, "WRONGTYPE"},
+		{[]string{"GET", "l"}, "WRONGTYPE"},
+		{[]string{"HSET", "s", "f", "v"}, "WRONGTYPE"},
+		{[]string{"GET"}, "ERR wrong number of arguments for 'get' command"},
+		{[]string{"HSET", "h", "f"}, "ERR wrong number of arguments for 'hset' command"},
+		{[]string{"LINDEX", "l", "x"}, "ERR value is not an integer or out of range"},
+		{[]string{"SET", "k", "v", "EX"}, "ERR syntax error"},
+		{[]string{"FLUSHALL"}, "ERR unknown command 'FLUSHALL'"},
+	} {
+		err, ok := c.do(t, tt.args...).(error)
+		if !ok || !strings.HasPrefix(err.Error(), tt.want) {
+			t.Error(tt.args, "doesn't fail with", tt.want, err)
+		}
+	}
+
+	if c.do(t, "PING") != "PONG" {
+		t.Error("Connection isn't usable after errors")
+	}
+}
+
+func TestRESP_Pipelining(t *testing.T) {
+	_, c := newRESPServer(t)
+
+	var pipeline string
+	for i := 0; i < 100; i++ {
+		pipeline += encodeCommand("RPUSH", "l", strconv.Itoa(i))
+	}
+	pipeline += "PING\r\n" + encodeCommand("LINDEX", "l", "99")
+	if _, err := io.WriteString(c.conn, pipeline); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 100; i++ {
+		if n := c.read(t); n != int64(i+1) {
+			t.Fatal("Reply", i, "of pipeline is wrong", n)
+		}
+	}
+	if reply := c.read(t); reply != "PONG" {
+		t.Error("Inline command in pipeline isn't answered", reply)
+	}
+	if reply := c.read(t); reply != "99" {
+		t.Error("Last reply of pipeline is wrong", reply)
+	}
+}
+
+func TestRESP_Hello3(t *testing.T) {
+	a, c := newRESPServer(t)
+	a.cache.hset("h", map[string]interface{}{"n": 1.5, "o": map[string]interface{}{"a": "b"}}, 0)
+
+	hello, ok := c.do(t, "HELLO", "3").(map[interface{}]interface{})
+	if !ok || hello["proto"] != int64(3) {
+		t.Fatal("Hello 3 reply is wrong", hello)
+	}
+
+	if reply := c.do(t, "GET", "missing"); reply != nil {
+		t.Error("Missing key isn't null", reply)
+	}
+	want := map[interface{}]interface{}{"n": "1.5", "o": `{"a":"b"}`}
+	if reply := c.do(t, "HGETALL", "h"); !reflect.DeepEqual(reply, want) {
+		t.Error("Hgetall isn't a map", reply)
+	}
+
+	if err, ok := c.do(t, "HELLO", "4").(error); !ok || !strings.HasPrefix(err.Error(), "NOPROTO") {
+		t.Error("Unsupported protocol is accepted", err)
+	}
+}
+
+func TestRESP_ProtocolError(t *testing.T) {
+	_, c := newRESPServer(t)
+
+	io.WriteString(c.conn, "*1\r\n:1\r\n")
+	if err, ok := c.read(t).(error); !ok || !strings.HasPrefix(err.Error(), "ERR Protocol error") {
+		t.Error("Malformed request isn't reported", err)
+	}
+	if _, err := c.r.ReadString('\n'); err != io.EOF {
+		t.Error("Connection isn't closed after a protocol error", err)
+	}
+}
+
+func TestRESP_NegativeLengths(t *testing.T) {
+	_, c := newRESPServer(t)
+
+	// Null and negative arrays are skipped like empty ones.
+	io.WriteString(c.conn, "*-1\r\n*-5\r\n*0\r\n")
+	if v := c.do(t, "PING"); v != "PONG" {
+		t.Error("Connection isn't served after negative array lengths", v)
+	}
+
+	io.WriteString(c.conn, "*1\r\n$-5\r\n")
+	if err, ok := c.read(t).(error); !ok || err.Error() != "ERR Protocol error: invalid bulk length" {
+		t.Error("Negative bulk length isn't reported", err)
+	}
+
+	// The server still accepts connections.
+	conn, err := net.Dial("tcp", c.conn.RemoteAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	c = &respClient{conn: conn, r: bufio.NewReader(conn)}
+	if v := c.do(t, "PING"); v != "PONG" {
+		t.Error("Server doesn't serve after a bad request", v)
+	}
+}
+
+func TestRESP_Select(t *testing.T) {
+	a, c := newRESPServer(t)
+
+	c.do(t, "SET", "k", "zero")
+	if reply := c.do(t, "SELECT", "1"); reply != "OK" {
+		t.Fatal("Select fails", reply)
+	}
+	if reply := c.do(t, "GET", "k"); reply != nil {
+		t.Error("Key of another database is visible", reply)
+	}
+	c.do(t, "SET", "k", "one")
+	c.do(t, "SET", "m", "moved")
+	if reply := c.do(t, "DBSIZE"); reply != int64(2) {
+		t.Error("Dbsize is wrong", reply)
+	}
+
+	if reply := c.do(t, "MOVE", "k", "0"); reply != int64(0) {
+		t.Error("Key is moved over an existing one", reply)
+	}
+	if reply := c.do(t, "MOVE", "m", "0"); reply != int64(1) {
+		t.Error("Key isn't moved", reply)
+	}
+	if v, _ := a.dbs[0].get("m"); v != "moved" {
+		t.Error("Moved key isn't in the default database", v)
+	}
+
+	if reply := c.do(t, "FLUSHDB"); reply != "OK" || a.dbs[1].count.Load() != 0 || a.dbs[0].count.Load() != 2 {
+		t.Error("Flushdb doesn't empty only the selected database", reply)
+	}
+	if _, ok := c.do(t, "SELECT", "2").(error); !ok {
+		t.Error("Database out of range is selected")
+	}
+}
@@ -0,0 +1,179 @@
+package app
+
+import (
+	"container/heap"
+	"encoding/base64"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const defaultScanCount = 10
+
+// scanDone is the cursor that starts an iteration and is returned when it
+// is finished.
+const scanDone = "0"
+
+// typeOf returns the type name of an item as it is stored in snapshots.
+func typeOf(i item) string {
+	switch i.(type) {
+	case *simpleItem:
+		return simpleType
+	case *listItem:
+		return listType
+	case *dictItem:
+		return dictType
+	case *zsetItem:
+		return zsetType
+	case *setItem:
+		return setType
+	}
+
+	return ""
+}
+
+// scanFilter selects keys by a glob pattern and a type name. Empty fields
+// match everything.
+type scanFilter struct {
+	match string
+	typ   string
+}
+
+func (f scanFilter) matches(key string, i item) bool {
+	if expired(i) {
+		return false
+	}
+	if f.match != "" && !globMatch(f.match, key) {
+		return false
+	}
+
+	return f.typ == "" || typeOf(i) == f.typ
+}
+
+// scanPosition is where an iteration stopped: the shard and the last key
+// returned from it. Keys of a shard are visited in sorted order, so a key
+// that exists for the whole iteration can't be skipped however the shard
+// changes between calls.
+type scanPosition struct {
+	shard   int
+	started bool
+	last    string
+}
+
+func (p scanPosition) String() string {
+	cursor := strconv.Itoa(p.shard)
+	if p.started {
+		cursor += ":" + p.last
+	}
+
+	return base64.RawURLEncoding.EncodeToString([]byte(cursor))
+}
+
+func parseCursor(cursor string) (scanPosition, error) {
+	if cursor == "" || cursor == scanDone {
+		return scanPosition{}, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return scanPosition{}, errors.New("invalid cursor")
+	}
+
+	shard, last, started := strings.Cut(string(b), ":")
+	n, err := strconv.Atoi(shard)
+	if err != nil || n < 0 {
+		return scanPosition{}, errors.New("invalid cursor")
+	}
+
+	return scanPosition{shard: n, started: started, last: last}, nil
+}
+
+// scan returns the keys matching the filter from the next count keys after
+// the cursor, and the cursor to continue from. Count is a hint of how many
+// keys are examined, so fewer keys may be returned. Only one shard at a
+// time is locked.
+func (c *cache) scan(cursor string, count int, filter scanFilter) (string, []string, error) {
+	return c.scanShards(cursor, count, filter, true)
+}
+
+// scanShards is scan that locks each shard while reading it when lock is
+// set, otherwise the caller holds the locks of all shards.
+func (c *cache) scanShards(cursor string, count int, filter scanFilter, lock bool) (string, []string, error) {
+	p, err := parseCursor(cursor)
+	if err != nil {
+		return "", nil, err
+	}
+	if count <= 0 {
+		count = defaultScanCount
+	}
+
+	keys := []string{}
+	for p.shard < len(c.shards) && count > 0 {
+		s := c.shards[p.shard]
+		if lock {
+			s.mu.RLock()
+		}
+		batch := s.nextKeys(p, count)
+		for _, k := range batch {
+			if filter.matches(k, s.items[k]) {
+				keys = append(keys, k)
+			}
+		}
+		if lock {
+			s.mu.RUnlock()
+		}
+
+		count -= len(batch)
+		if count > 0 {
+			p = scanPosition{shard: p.shard + 1}
+		} else {
+			p.started, p.last = true, batch[len(batch)-1]
+		}
+	}
+
+	if p.shard >= len(c.shards) {
+		return scanDone, keys, nil
+	}
+
+	return p.String(), keys, nil
+}
+
+// nextKeys returns up to count keys after the position in sorted order.
+func (s *shard) nextKeys(p scanPosition, count int) []string {
+	h := &keyHeap{}
+	for k := range s.items {
+		if p.started && k <= p.last {
+			continue
+		}
+		if h.Len() < count {
+			heap.Push(h, k)
+		} else if k < (*h)[0] {
+			(*h)[0] = k
+			heap.Fix(h, 0)
+		}
+	}
+
+	sort.Strings(*h)
+
+	return *h
+}
+
+// keyHeap is a max-heap of keys, to keep the smallest ones.
+type keyHeap []string
+
+func (h keyHeap) Len() int           { return len(h) }
+func (h keyHeap) Less(i, j int) bool { return h[i] > h[j] }
+func (h keyHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *keyHeap) Push(x interface{}) {
+	*h = append(*h, x.(string))
+}
+
+func (h *keyHeap) Pop() interface{} {
+	old := *h
+	k := old[len(old)-1]
+	*h = old[:len(old)-1]
+
+	return k
+}
@@ -0,0 +1,166 @@
+package app
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestGlobMatch(t *testing.T) {
+	for _, tt := range []struct {
+		pattern string
+		name    string
+		matched bool
+	}{
+		{"", "", true},
+		{"*", "", true},
+		{"*", "user/1", true},
+		{"user:*", "user:1", true},
+		{"user:*", "users:1", false},
+		{"*:name", "user:1:name", true},
+		{"*a*b", "xaxxb", true},
+		{"*a*b", "xaxxbc", false},
+		{"h?llo", "hello", true},
+		{"h?llo", "héllo", true},
+		{"h?llo", "hllo", false},
+		{"h[ae]llo", "hallo", true},
+		{"h[ae]llo", "hillo", false},
+		{"h[^e]llo", "hallo", true},
+		{"h[^e]llo", "hello", false},
+		{"h[a-c]llo", "hbllo", true},
+		{"h[a-c]llo", "hdllo", false},
+		{"[]]", "]", true},
+		{"a\\*", "a*", true},
+		{"a\\*", "ab", false},
+		{"a[", "a[", true},
+		{"a*", "abc", true},
+		{"abc", "ab", false},
+	} {
+		if matched := globMatch(tt.pattern, tt.name); matched != tt.matched {
+			t.Error("Glob match is wrong", tt.pattern, tt.name, matched)
+		}
+	}
+}
+
+func TestCache_KeysMatching(t *testing.T) {
+	tc := NewCache(0)
+	tc.set("user:1", "a", 0)
+	tc.set("user:2", "b", 0)
+	tc.set("order:1", "c", 0)
+
+	keys := tc.keysMatching("user:*")
+	sort.Strings(keys)
+	if !reflect.DeepEqual(keys, []string{"user:1", "user:2"}) {
+		t.Error("Keys matching user:* are wrong", keys)
+	}
+}
+
+func scanAll(tc *cache, count int, filter scanFilter) ([]string, int) {
+	var keys []string
+	calls := 0
+	cursor := scanDone
+	for {
+		next, batch, _ := tc.scan(cursor, count, filter)
+		keys = append(keys, batch...)
+		calls++
+		if next == scanDone {
+			return keys, calls
+		}
+		cursor = next
+	}
+}
+
+func TestCache_Scan(t *testing.T) {
+	tc := NewCache(0)
+	for i := 0; i < 1000; i++ {
+		tc.set("key:"+strconv.Itoa(i), i, 0)
+	}
+
+	keys, calls := scanAll(tc, 10, scanFilter{})
+	if len(keys) != 1000 {
+		t.Error("Scan doesn't return 1000 keys", len(keys))
+	}
+	if calls < 100 {
+		t.Error("Scan returns more keys than count", calls)
+	}
+
+	seen := map[string]bool{}
+	for _, k := range keys {
+		if seen[k] {
+			t.Error("Scan returns a key twice", k)
+		}
+		seen[k] = true
+	}
+
+	if _, _, err := tc.scan("not a cursor", 10, scanFilter{}); err == nil {
+		t.Error("Scan accepts invalid cursor")
+	}
+}
+
+func TestCache_ScanFilter(t *testing.T) {
+	tc := NewCache(0)
+	tc.set("user:1", "a", 0)
+	tc.rpush("user:2", "b", 0)
+	tc.hset("user:3", map[string]interface{}{"a": 1.0}, 0)
+	tc.set("order:1", "c", 0)
+
+	keys, _ := scanAll(tc, 2, scanFilter{match: "user:*"})
+	sort.Strings(keys)
+	if !reflect.DeepEqual(keys, []string{"user:1", "user:2", "user:3"}) {
+		t.Error("Scan with match is wrong", keys)
+	}
+
+	keys, _ = scanAll(tc, 2, scanFilter{typ: simpleType})
+	sort.Strings(keys)
+	if !reflect.DeepEqual(keys, []string{"order:1", "user:1"}) {
+		t.Error("Scan with type is wrong", keys)
+	}
+
+	keys, _ = scanAll(tc, 2, scanFilter{match: "user:*", typ: listType})
+	if !reflect.DeepEqual(keys, []string{"user:2"}) {
+		t.Error("Scan with match and type is wrong", keys)
+	}
+}
+
+func TestCache_ScanConcurrentChanges(t *testing.T) {
+	tc := NewCache(0)
+	for i := 0; i < 1000; i++ {
+		tc.set("stable:"+strconv.Itoa(i), i, 0)
+	}
+
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; ; i++ {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			key := "churn:" + strconv.Itoa(i%500)
+			if i%2 == 0 {
+				tc.set(key, i, 0)
+			} else {
+				tc.deleteItem(key)
+			}
+		}
+	}()
+
+	keys, _ := scanAll(tc, 7, scanFilter{match: "stable:*"})
+	close(stop)
+	wg.Wait()
+
+	seen := map[string]bool{}
+	for _, k := range keys {
+		seen[k] = true
+	}
+	for i := 0; i < 1000; i++ {
+		if !seen["stable:"+strconv.Itoa(i)] {
+			t.Error("Scan misses a key present for the whole iteration", i)
+		}
+	}
+}
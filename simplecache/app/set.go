@@ -0,0 +1,396 @@
+package app
+
+import (
+	"errors"
+	"sort"
+)
+
+type setItem struct {
+	header
+	setObject map[string]struct{}
+}
+
+func newSetItem(e int64) *setItem {
+	return &setItem{
+		header:    header{expired: e},
+		setObject: map[string]struct{}{},
+	}
+}
+
+func (si *setItem) members() []string {
+	members := make([]string, 0, len(si.setObject))
+	for m := range si.setObject {
+		members = append(members, m)
+	}
+	sort.Strings(members)
+
+	return members
+}
+
+// lookupSet returns the set at key, nil when there is no such key.
+func (s *shard) lookupSet(key string) (*setItem, error) {
+	item, found := s.items[key]
+	if !found || s.isExpired(key, item) {
+		return nil, nil
+	}
+
+	si, ok := item.(*setItem)
+	if !ok {
+		return nil, errors.New("wrong type")
+	}
+	si.touch()
+
+	return si, nil
+}
+
+func (c *cache) sadd(key string, members []string, duration int) (int, error) {
+	if err := c.ensureCapacity(key); err != nil {
+		return 0, err
+	}
+
+	e := c.expiration(duration)
+
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.sadd(key, members, e)
+}
+
+// sadd adds members to the set at key and returns the number of new ones.
+// A zero e keeps the current expiry of the set.
+func (s *shard) sadd(key string, members []string, e int64) (int, error) {
+	item, found := s.items[key]
+	if found && s.isExpired(key, item) {
+		s.removeExpired(key)
+		found = false
+	}
+
+	if !found {
+		si := newSetItem(e)
+		for _, m := range members {
+			si.setObject[m] = struct{}{}
+		}
+		if len(si.setObject) == 0 {
+			return 0, nil
+		}
+		s.store(key, si)
+		s.c.feed("sadd", key, members, si.expired)
+
+		return len(si.setObject), nil
+	}
+
+	si, ok := item.(*setItem)
+	if !ok {
+		return 0, errors.New("invalid object type")
+	}
+
+	var added []string
+	var delta int64
+	for _, m := range members {
+		if _, exists := si.setObject[m]; !exists {
+			si.setObject[m] = struct{}{}
+			added = append(added, m)
+			delta += sizeOf(m)
+		}
+	}
+
+	if e > 0 {
+		si.expired = e
+	}
+
+	s.grow(si, delta)
+	si.touch()
+	s.c.feed("sadd", key, added, si.expired)
+
+	return len(added), nil
+}
+
+func (c *cache) srem(key string, members []string) (int, error) {
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.srem(key, members)
+}
+
+func (s *shard) srem(key string, members []string) (int, error) {
+	si, err := s.lookupSet(key)
+	if err != nil || si == nil {
+		return 0, err
+	}
+
+	var removed []string
+	for _, m := range members {
+		if _, exists := si.setObject[m]; exists {
+			delete(si.setObject, m)
+			removed = append(removed, m)
+			s.grow(si, -sizeOf(m))
+		}
+	}
+
+	if len(removed) > 0 {
+		s.c.feed("srem", key, removed, 0)
+	}
+	if len(si.setObject) == 0 {
+		s.remove(key)
+	}
+
+	return len(removed), nil
+}
+
+func (c *cache) sismember(key string, member string) (bool, error) {
+	s := c.getShard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.sismember(key, member)
+}
+
+func (s *shard) sismember(key string, member string) (bool, error) {
+	si, err := s.lookupSet(key)
+	if err != nil || si == nil {
+		return false, err
+	}
+
+	_, found := si.setObject[member]
+
+	return found, nil
+}
+
+func (c *cache) smembers(key string) ([]string, error) {
+	s := c.getShard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.smembers(key)
+}
+
+func (s *shard) smembers(key string) ([]string, error) {
+	si, err := s.lookupSet(key)
+	if err != nil {
+		return nil, err
+	}
+	if si == nil {
+		return nil, errors.New("not found")
+	}
+
+	return si.members(), nil
+}
+
+func (c *cache) scard(key string) (int, error) {
+	s := c.getShard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.scard(key)
+}
+
+func (s *shard) scard(key string) (int, error) {
+	si, err := s.lookupSet(key)
+	if err != nil || si == nil {
+		return 0, err
+	}
+
+	return len(si.setObject), nil
+}
+
+// spop removes and returns up to count random members.
+func (c *cache) spop(key string, count int) ([]string, error) {
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.spop(key, count)
+}
+
+func (s *shard) spop(key string, count int) ([]string, error) {
+	si, err := s.lookupSet(key)
+	if err != nil {
+		return nil, err
+	}
+	if si == nil {
+		return nil, errors.New("not found")
+	}
+
+	popped := []string{}
+	if s.c.deterministic {
+		popped = sortedMembers(si.setObject)
+		if len(popped) > count {
+			popped = popped[:max(count, 0)]
+		}
+	} else {
+		for m := range si.setObject {
+			if len(popped) >= count {
+				break
+			}
+			popped = append(popped, m)
+		}
+	}
+
+	for _, m := range popped {
+		delete(si.setObject, m)
+		s.grow(si, -sizeOf(m))
+	}
+
+	if len(popped) > 0 {
+		s.c.feed("srem", key, popped, 0)
+	}
+	if len(si.setObject) == 0 {
+		s.remove(key)
+	}
+
+	return popped, nil
+}
+
+const (
+	setInter = iota
+	setUnion
+	setDiff
+)
+
+// combine computes the intersection, union or difference of the sets at
+// keys. Missing keys are empty sets. The shards of all keys must be locked.
+func (c *cache) combine(op int, keys []string) (map[string]struct{}, error) {
+	sets := make([]*setItem, len(keys))
+	for i, k := range keys {
+		si, err := c.getShard(k).lookupSet(k)
+		if err != nil {
+			return nil, err
+		}
+		sets[i] = si
+	}
+
+	result := map[string]struct{}{}
+	if sets[0] == nil && op != setUnion {
+		return result, nil
+	}
+
+	switch op {
+	case setInter:
+		for m := range sets[0].setObject {
+			inAll := true
+			for _, si := range sets[1:] {
+				if si == nil {
+					return map[string]struct{}{}, nil
+				}
+				if _, found := si.setObject[m]; !found {
+					inAll = false
+					break
+				}
+			}
+			if inAll {
+				result[m] = struct{}{}
+			}
+		}
+	case setUnion:
+		for _, si := range sets {
+			if si == nil {
+				continue
+			}
+			for m := range si.setObject {
+				result[m] = struct{}{}
+			}
+		}
+	case setDiff:
+		for m := range sets[0].setObject {
+			result[m] = struct{}{}
+		}
+		for _, si := range sets[1:] {
+			if si == nil {
+				continue
+			}
+			for m := range si.setObject {
+				delete(result, m)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func sortedMembers(set map[string]struct{}) []string {
+	members := make([]string, 0, len(set))
+	for m := range set {
+		members = append(members, m)
+	}
+	sort.Strings(members)
+
+	return members
+}
+
+func (c *cache) setOperation(op int, keys []string) ([]string, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("no keys given")
+	}
+
+	shards := c.shardsOf(keys...)
+	rlockShards(shards)
+	defer runlockShards(shards)
+
+	result, err := c.combine(op, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return sortedMembers(result), nil
+}
+
+func (c *cache) sinter(keys []string) ([]string, error) {
+	return c.setOperation(setInter, keys)
+}
+
+func (c *cache) sunion(keys []string) ([]string, error) {
+	return c.setOperation(setUnion, keys)
+}
+
+func (c *cache) sdiff(keys []string) ([]string, error) {
+	return c.setOperation(setDiff, keys)
+}
+
+// setOperationStore writes the result of the operation to destination,
+// replacing whatever was there, and returns the size of the result.
+func (c *cache) setOperationStore(op int, destination string, keys []string) (int, error) {
+	if len(keys) == 0 {
+		return 0, errors.New("no keys given")
+	}
+
+	if err := c.ensureCapacity(destination); err != nil {
+		return 0, err
+	}
+
+	shards := c.shardsOf(append([]string{destination}, keys...)...)
+	lockShards(shards)
+	defer unlockShards(shards)
+
+	return c.storeCombined(op, destination, keys)
+}
+
+// storeCombined is setOperationStore with the shards of all keys locked.
+func (c *cache) storeCombined(op int, destination string, keys []string) (int, error) {
+	result, err := c.combine(op, keys)
+	if err != nil {
+		return 0, err
+	}
+
+	s := c.getShard(destination)
+	s.deleteItem(destination)
+
+	if len(result) == 0 {
+		return 0, nil
+	}
+
+	return s.sadd(destination, sortedMembers(result), 0)
+}
+
+func (c *cache) sinterstore(destination string, keys []string) (int, error) {
+	return c.setOperationStore(setInter, destination, keys)
+}
+
+func (c *cache) sunionstore(destination string, keys []string) (int, error) {
+	return c.setOperationStore(setUnion, destination, keys)
+}
+
+func (c *cache) sdiffstore(destination string, keys []string) (int, error) {
+	return c.setOperationStore(setDiff, destination, keys)
+}
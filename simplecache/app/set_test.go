@@ -0,0 +1,184 @@
+package app
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCache_Sadd(t *testing.T) {
+	tc := NewCache(0)
+	key := "s"
+
+	added, err := tc.sadd(key, []string{"a", "b", "a"}, 0)
+	if err != nil || added != 2 {
+		t.Error("Sadd doesn't add 2 members", added, err)
+	}
+
+	added, _ = tc.sadd(key, []string{"b", "c"}, 0)
+	if added != 1 {
+		t.Error("Sadd doesn't add only the new member", added)
+	}
+
+	if count, _ := tc.scard(key); count != 3 {
+		t.Error("Scard doesn't equals 3", count)
+	}
+	if found, _ := tc.sismember(key, "c"); !found {
+		t.Error("Sismember doesn't find c")
+	}
+	if found, _ := tc.sismember(key, "x"); found {
+		t.Error("Sismember finds missing member")
+	}
+
+	if removed, _ := tc.srem(key, []string{"a", "x"}); removed != 1 {
+		t.Error("Srem doesn't remove 1 member", removed)
+	}
+	if members, _ := tc.smembers(key); !reflect.DeepEqual(members, []string{"b", "c"}) {
+		t.Error("Smembers doesn't return b and c", members)
+	}
+
+	tc.set("str", "string", 0)
+	if _, err := tc.sadd("str", []string{"a"}, 0); err == nil {
+		t.Error("Sadd to a string doesn't fail")
+	}
+	if _, err := tc.scard("str"); err == nil {
+		t.Error("Scard of a string doesn't fail")
+	}
+}
+
+func TestCache_Spop(t *testing.T) {
+	tc := NewCache(0)
+	key := "s"
+	tc.sadd(key, []string{"a", "b", "c"}, 0)
+
+	popped, _ := tc.spop(key, 2)
+	if len(popped) != 2 {
+		t.Error("Spop doesn't return 2 members", popped)
+	}
+	for _, m := range popped {
+		if found, _ := tc.sismember(key, m); found {
+			t.Error("Popped member is still in the set", m)
+		}
+	}
+
+	tc.spop(key, 5)
+	if _, err := tc.smembers(key); err == nil {
+		t.Error("Empty set wasn't deleted")
+	}
+	if _, err := tc.spop(key, 1); err == nil {
+		t.Error("Spop of missing key doesn't fail")
+	}
+	if tc.stats().UsedMemory != 0 {
+		t.Error("Memory of deleted set isn't released", tc.stats().UsedMemory)
+	}
+}
+
+func TestCache_SetOperations(t *testing.T) {
+	tc := NewCache(0)
+	tc.sadd("a", []string{"1", "2", "3", "4"}, 0)
+	tc.sadd("b", []string{"2", "3", "5"}, 0)
+	tc.sadd("c", []string{"3", "6"}, 0)
+
+	if members, _ := tc.sinter([]string{"a", "b", "c"}); !reflect.DeepEqual(members, []string{"3"}) {
+		t.Error("Sinter is wrong", members)
+	}
+	if members, _ := tc.sunion([]string{"a", "b", "missing"}); !reflect.DeepEqual(members, []string{"1", "2", "3", "4", "5"}) {
+		t.Error("Sunion is wrong", members)
+	}
+	if members, _ := tc.sdiff([]string{"a", "b", "missing"}); !reflect.DeepEqual(members, []string{"1", "4"}) {
+		t.Error("Sdiff is wrong", members)
+	}
+	if members, _ := tc.sinter([]string{"a", "missing"}); len(members) != 0 {
+		t.Error("Sinter with missing key isn't empty", members)
+	}
+	if members, _ := tc.sdiff([]string{"missing", "a"}); len(members) != 0 {
+		t.Error("Sdiff of missing key isn't empty", members)
+	}
+
+	tc.set("str", "string", 0)
+	if _, err := tc.sunion([]string{"a", "str"}); err == nil {
+		t.Error("Sunion with a string doesn't fail")
+	}
+	if _, err := tc.sinter(nil); err == nil {
+		t.Error("Sinter without keys doesn't fail")
+	}
+}
+
+func TestCache_SetOperationsStore(t *testing.T) {
+	tc := NewCache(0)
+	tc.sadd("a", []string{"1", "2", "3"}, 0)
+	tc.sadd("b", []string{"2", "3", "4"}, 0)
+	tc.set("dest", "string", 0)
+
+	count, err := tc.sinterstore("dest", []string{"a", "b"})
+	if err != nil || count != 2 {
+		t.Error("Sinterstore doesn't store 2 members", count, err)
+	}
+	if members, _ := tc.smembers("dest"); !reflect.DeepEqual(members, []string{"2", "3"}) {
+		t.Error("Sinterstore doesn't replace the destination", members)
+	}
+
+	if count, _ := tc.sunionstore("a", []string{"a", "b"}); count != 4 {
+		t.Error("Sunionstore into a source key doesn't store 4 members", count)
+	}
+
+	if count, _ := tc.sdiffstore("dest", []string{"b", "a"}); count != 0 {
+		t.Error("Sdiffstore doesn't store an empty result", count)
+	}
+	if _, err := tc.smembers("dest"); err == nil {
+		t.Error("Empty result of sdiffstore isn't deleted")
+	}
+}
+
+func TestCache_SetExpired(t *testing.T) {
+	tc := NewCache(time.Duration(time.Hour))
+	tc.ExpiredTimeMultiplier = time.Millisecond
+	key := "s"
+	tc.sadd(key, []string{"a"}, 10)
+
+	<-time.After(20 * time.Millisecond)
+
+	if found, _ := tc.sismember(key, "a"); found {
+		t.Error("Found member of expired set")
+	}
+
+	tc.sadd(key, []string{"b"}, 0)
+	if members, _ := tc.smembers(key); !reflect.DeepEqual(members, []string{"b"}) {
+		t.Error("Members of expired set are still there", members)
+	}
+}
+
+func TestCache_SetPersistence(t *testing.T) {
+	dir := t.TempDir()
+	aofPath := filepath.Join(dir, "cache.aof")
+	snapshotPath := filepath.Join(dir, "dump.json")
+
+	tc := NewCache(0)
+	if err := tc.enableAOF(aofPath, FsyncNever, 0); err != nil {
+		t.Fatal(err)
+	}
+	tc.sadd("a", []string{"1", "2", "3"}, 0)
+	tc.sadd("b", []string{"3", "4"}, 0)
+	tc.srem("a", []string{"1"})
+	tc.sunionstore("u", []string{"a", "b"})
+	tc.saveSnapshot(snapshotPath)
+	tc.disableAOF()
+
+	for _, load := range []func(*cache) error{
+		func(c *cache) error { return c.replayAOF(aofPath) },
+		func(c *cache) error { return c.loadSnapshot(snapshotPath) },
+	} {
+		lc := NewCache(0)
+		if err := load(lc); err != nil {
+			t.Fatal(err)
+		}
+
+		if members, _ := lc.smembers("a"); !reflect.DeepEqual(members, []string{"2", "3"}) {
+			t.Error("Set isn't restored", members)
+		}
+		if members, _ := lc.smembers("u"); !reflect.DeepEqual(members, []string{"2", "3", "4"}) {
+			t.Error("Stored set isn't restored", members)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package app
+
+import (
+	"sort"
+	"sync"
+)
+
+var defaultShardCount = 32
+
+// shard is a part of the keyspace with its own lock. A key always lives in
+// the shard picked by the hash of the key. Methods of shard expect s.mu to
+// be held by the caller.
+type shard struct {
+	c     *cache
+	mu    sync.RWMutex
+	items map[string]item
+	// replaying is set while a command of the raft log is applied, see
+	// cache.apply. Items don't expire then.
+	replaying bool
+	// unlogged is set while a command that its caller logs is applied,
+	// see cache.applyUnlogged. Its mutations aren't logged again then.
+	unlogged bool
+}
+
+func newShard(c *cache) *shard {
+	return &shard{
+		c:     c,
+		items: make(map[string]item),
+	}
+}
+
+// fnv32 is the 32-bit FNV-1a hash of key.
+func fnv32(key string) uint32 {
+	hash := uint32(2166136261)
+	for i := 0; i < len(key); i++ {
+		hash ^= uint32(key[i])
+		hash *= 16777619
+	}
+
+	return hash
+}
+
+func (c *cache) getShard(key string) *shard {
+	return c.shards[fnv32(key)%uint32(len(c.shards))]
+}
+
+// shardsOf returns the shards holding the keys, each shard once and in
+// the same order lockAll uses.
+func (c *cache) shardsOf(keys ...string) []*shard {
+	var indexes []int
+	seen := map[int]bool{}
+	for _, k := range keys {
+		i := int(fnv32(k) % uint32(len(c.shards)))
+		if !seen[i] {
+			seen[i] = true
+			indexes = append(indexes, i)
+		}
+	}
+	sort.Ints(indexes)
+
+	shards := make([]*shard, len(indexes))
+	for n, i := range indexes {
+		shards[n] = c.shards[i]
+	}
+
+	return shards
+}
+
+func lockShards(shards []*shard) {
+	for _, s := range shards {
+		s.mu.Lock()
+	}
+}
+
+func unlockShards(shards []*shard) {
+	for _, s := range shards {
+		s.mu.Unlock()
+	}
+}
+
+func rlockShards(shards []*shard) {
+	for _, s := range shards {
+		s.mu.RLock()
+	}
+}
+
+func runlockShards(shards []*shard) {
+	for _, s := range shards {
+		s.mu.RUnlock()
+	}
+}
+
+// lockAll write locks every shard. Shards are always locked in the same
+// order, so two callers can't deadlock each other.
+func (c *cache) lockAll() {
+	for _, s := range c.shards {
+		s.mu.Lock()
+	}
+}
+
+func (c *cache) unlockAll() {
+	for _, s := range c.shards {
+		s.mu.Unlock()
+	}
+}
+
+// rlockAll read locks every shard to get a consistent view of the whole
+// keyspace.
+func (c *cache) rlockAll() {
+	for _, s := range c.shards {
+		s.mu.RLock()
+	}
+}
+
+func (c *cache) runlockAll() {
+	for _, s := range c.shards {
+		s.mu.RUnlock()
+	}
+}
+
+// store puts a new item at key, replacing the one that was there.
+func (s *shard) store(key string, i item) {
+	if old, found := s.items[key]; found {
+		s.c.used.Add(-old.getHeader().size)
+	} else {
+		s.c.count.Add(1)
+	}
+
+	h := i.getHeader()
+	h.size = itemSize(key, i)
+	h.touch()
+	s.c.used.Add(h.size)
+	s.items[key] = i
+}
+
+func (s *shard) remove(key string) {
+	old, found := s.items[key]
+	if !found {
+		return
+	}
+
+	s.c.used.Add(-old.getHeader().size)
+	s.c.count.Add(-1)
+	delete(s.items, key)
+}
+
+// grow accounts for an item that was changed in place.
+func (s *shard) grow(i item, delta int64) {
+	i.getHeader().size += delta
+	s.c.used.Add(delta)
+}
+
+func (s *shard) deleteExpired(now int64) {
+	for k, v := range s.items {
+		if v.getExpired() > 0 && now > v.getExpired() {
+			s.removeExpired(k)
+		}
+	}
+}
+
+// removeExpired removes an expired item and logs it, so the log doesn't
+// depend on the clock when it's replayed. Writes that find an expired item
+// call it before they replace the item.
+func (s *shard) removeExpired(key string) {
+	s.c.feed("expired", key, nil, 0)
+	s.remove(key)
+}
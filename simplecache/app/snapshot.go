@@ -0,0 +1,284 @@
+package app
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	simpleType = "string"
+	listType   = "list"
+	dictType   = "dict"
+	zsetType   = "zset"
+	setType    = "set"
+
+	// rawType is a string value set from raw bytes, see rawValue.
+	rawType = "raw"
+)
+
+type snapshotRecord struct {
+	Key     string          `json:"key"`
+	Type    string          `json:"type"`
+	Expired int64           `json:"expired"`
+	Version int64           `json:"version,omitempty"`
+	Value   json.RawMessage `json:"value"`
+}
+
+type snapshotEntry struct {
+	key  string
+	item item
+}
+
+// dump copies the keyspace with all shards read locked, so the copy is a
+// point-in-time view. Lists and dicts are copied too, so the result can be
+// serialized after the locks are released.
+func (c *cache) dump() []snapshotEntry {
+	c.rlockAll()
+	entries := c.copyItems()
+	c.runlockAll()
+
+	return entries
+}
+
+func (c *cache) copyItems() []snapshotEntry {
+	var entries []snapshotEntry
+	for _, s := range c.shards {
+		for k, v := range s.items {
+			i := copyItem(v)
+			i.getHeader().version = v.getHeader().version
+			entries = append(entries, snapshotEntry{key: k, item: i})
+		}
+	}
+
+	return entries
+}
+
+func copyItem(i item) item {
+	switch v := i.(type) {
+	case *simpleItem:
+		return &simpleItem{header: header{expired: v.expired}, object: v.object}
+	case *listItem:
+		return &listItem{header: header{expired: v.expired}, listObject: newDeque(v.listObject.values())}
+	case *dictItem:
+		object := make(map[string]interface{}, len(v.dictObject))
+		for k, dv := range v.dictObject {
+			object[k] = dv
+		}
+		return &dictItem{header: header{expired: v.expired}, dictObject: object}
+	case *zsetItem:
+		zi := newZsetItem(v.expired)
+		for _, m := range v.members() {
+			zi.add(m.Member, m.Score)
+		}
+		return zi
+	case *setItem:
+		si := newSetItem(v.expired)
+		for m := range v.setObject {
+			si.setObject[m] = struct{}{}
+		}
+		return si
+	}
+
+	return i
+}
+
+func encodeItem(key string, i item) (snapshotRecord, error) {
+	var (
+		t     string
+		value interface{}
+	)
+	switch v := i.(type) {
+	case *simpleItem:
+		t, value = simpleType, v.object
+		if _, ok := v.object.(*rawValue); ok {
+			t = rawType
+		}
+	case *listItem:
+		t, value = listType, v.listObject.values()
+	case *dictItem:
+		t, value = dictType, v.dictObject
+	case *zsetItem:
+		t, value = zsetType, v.members()
+	case *setItem:
+		t, value = setType, v.members()
+	default:
+		return snapshotRecord{}, errors.New("unknown object type")
+	}
+
+	b, err := marshalJSON(value)
+	if err != nil {
+		return snapshotRecord{}, err
+	}
+
+	return snapshotRecord{Key: key, Type: t, Expired: i.getExpired(), Version: i.getHeader().version, Value: b}, nil
+}
+
+func decodeItem(r snapshotRecord) (item, error) {
+	switch r.Type {
+	case simpleType:
+		var object json.RawMessage
+		if err := json.Unmarshal(r.Value, &object); err != nil {
+			return nil, err
+		}
+		return &simpleItem{header: header{expired: r.Expired}, object: jsonValue(object)}, nil
+	case rawType:
+		var rv rawValue
+		if err := json.Unmarshal(r.Value, &rv); err != nil {
+			return nil, err
+		}
+		return &simpleItem{header: header{expired: r.Expired}, object: &rv}, nil
+	case listType:
+		var object []json.RawMessage
+		if err := json.Unmarshal(r.Value, &object); err != nil {
+			return nil, err
+		}
+		return &listItem{header: header{expired: r.Expired}, listObject: newDeque(jsonValues(object))}, nil
+	case dictType:
+		object := map[string]json.RawMessage{}
+		if err := json.Unmarshal(r.Value, &object); err != nil {
+			return nil, err
+		}
+		return &dictItem{header: header{expired: r.Expired}, dictObject: jsonFields(object)}, nil
+	case zsetType:
+		var members []zmember
+		if err := json.Unmarshal(r.Value, &members); err != nil {
+			return nil, err
+		}
+		zi := newZsetItem(r.Expired)
+		for _, m := range members {
+			zi.add(m.Member, m.Score)
+		}
+		return zi, nil
+	case setType:
+		var members []string
+		if err := json.Unmarshal(r.Value, &members); err != nil {
+			return nil, err
+		}
+		si := newSetItem(r.Expired)
+		for _, m := range members {
+			si.setObject[m] = struct{}{}
+		}
+		return si, nil
+	}
+
+	return nil, errors.New("unknown object type " + r.Type)
+}
+
+// saveSnapshot writes the keyspace to path. The data goes to a temporary
+// file first and is renamed over path, so a crash never leaves a partial
+// snapshot behind.
+func (c *cache) saveSnapshot(path string) error {
+	entries := c.dump()
+
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	w := bufio.NewWriter(f)
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	for _, e := range entries {
+		r, err := encodeItem(e.key, e.item)
+		if err != nil {
+			f.Close()
+			return err
+		}
+		if err := encoder.Encode(r); err != nil {
+			f.Close()
+			return err
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+// loadSnapshot fills the cache from a snapshot written by saveSnapshot.
+// Entries that expired while the server was down are skipped. A missing
+// file is not an error.
+func (c *cache) loadSnapshot(path string) error {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	now := time.Now().UnixNano()
+	decoder := json.NewDecoder(bufio.NewReader(f))
+
+	for decoder.More() {
+		var r snapshotRecord
+		if err := decoder.Decode(&r); err != nil {
+			return err
+		}
+		if r.Expired > 0 && now > r.Expired {
+			continue
+		}
+
+		i, err := decodeItem(r)
+		if err != nil {
+			return err
+		}
+
+		s := c.getShard(r.Key)
+		s.mu.Lock()
+		s.store(r.Key, i)
+		s.setVersion(r.Key, r.Version)
+		s.mu.Unlock()
+	}
+
+	return nil
+}
+
+func (a *App) runSnapshots() {
+	ticker := time.NewTicker(a.config.SnapshotInterval)
+	for {
+		select {
+		case <-ticker.C:
+			a.saveSnapshots()
+		case <-a.stop:
+			ticker.Stop()
+			return
+		}
+	}
+}
+
+// saveSnapshots saves every database. The snapshot of an empty database
+// other than the default one is removed instead, so unused databases leave
+// no files behind.
+func (a *App) saveSnapshots() {
+	for i, c := range a.dbs {
+		path := dbPath(a.config.SnapshotPath, i)
+		if i > 0 && c.count.Load() == 0 {
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				log.Println("snapshot:", err)
+			}
+			continue
+		}
+
+		if err := c.saveSnapshot(path); err != nil {
+			log.Println("snapshot:", err)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package app
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCache_Snapshot(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dump.json")
+
+	tc := NewCache(0)
+	tc.ExpiredTimeMultiplier = time.Millisecond
+	tc.set("s", "value", 0)
+	tc.set("e", "expired", 10)
+	tc.rpush("l", "a", 0)
+	tc.rpush("l", "b", 0)
+	tc.hset("h", map[string]interface{}{"name": "Igor"}, 1000)
+
+	if err := tc.saveSnapshot(path); err != nil {
+		t.Fatal("Can't save snapshot", err)
+	}
+
+	<-time.After(20 * time.Millisecond)
+
+	lc := NewCache(0)
+	if err := lc.loadSnapshot(path); err != nil {
+		t.Fatal("Can't load snapshot", err)
+	}
+
+	s, err := lc.get("s")
+	if err != nil || s != "value" {
+		t.Error("String value doesn't restored", s)
+	}
+
+	if _, err := lc.get("e"); err == nil {
+		t.Error("Found e that expired while the cache was down")
+	}
+
+	for _, k := range lc.keys() {
+		if k == "e" {
+			t.Error("Expired key e was loaded from snapshot")
+		}
+	}
+
+	list, err := lc.lgetall("l")
+	if err != nil || len(list) != 2 || list[0] != "a" || list[1] != "b" {
+		t.Error("List value doesn't restored", list)
+	}
+
+	name, err := lc.hget("h", "name")
+	if err != nil || name != "Igor" {
+		t.Error("Dict value doesn't restored", name)
+	}
+
+	expired := lc.getShard("h").items["h"].getExpired()
+	original := tc.getShard("h").items["h"].getExpired()
+	if expired != original {
+		t.Error("Expiry time of h doesn't restored", expired, original)
+	}
+}
+
+func TestCache_SnapshotMissingFile(t *testing.T) {
+	tc := NewCache(0)
+	if err := tc.loadSnapshot(filepath.Join(t.TempDir(), "missing.json")); err != nil {
+		t.Error("Loading missing snapshot returns error", err)
+	}
+}
@@ -0,0 +1,105 @@
+package app
+
+import (
+	"errors"
+	"time"
+)
+
+// noExpiry is the TTL of a key that never expires.
+const noExpiry time.Duration = -1
+
+// lookupAny returns the item at key of any type, nil when there is no such
+// key.
+func (s *shard) lookupAny(key string) item {
+	item, found := s.items[key]
+	if !found || s.isExpired(key, item) {
+		return nil
+	}
+
+	return item
+}
+
+// expire sets the expiry of a key of any type to ttl from now. A key with
+// a non-positive ttl is deleted at once.
+func (c *cache) expire(key string, ttl time.Duration) error {
+	return c.expireAt(key, time.Now().Add(ttl))
+}
+
+// expireAt sets the expiry of a key of any type to the given time. A key
+// with an expiry in the past is deleted at once.
+func (c *cache) expireAt(key string, at time.Time) error {
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.expireAt(key, at.UnixNano())
+}
+
+func (s *shard) expireAt(key string, e int64) error {
+	i := s.lookupAny(key)
+	if i == nil {
+		return errors.New("not found")
+	}
+
+	if e <= time.Now().UnixNano() {
+		s.deleteItem(key)
+		return nil
+	}
+
+	s.setExpiry(key, i, e)
+
+	return nil
+}
+
+// setExpiry replaces the expiry of the item and logs the change. A zero e
+// removes the expiry.
+func (s *shard) setExpiry(key string, i item, e int64) {
+	i.getHeader().expired = e
+	s.c.feed("expire", key, nil, e)
+}
+
+// persist removes the expiry of a key and reports whether it had one.
+func (c *cache) persist(key string) (bool, error) {
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.persist(key)
+}
+
+func (s *shard) persist(key string) (bool, error) {
+	i := s.lookupAny(key)
+	if i == nil {
+		return false, errors.New("not found")
+	}
+
+	if i.getExpired() == 0 {
+		return false, nil
+	}
+	s.setExpiry(key, i, 0)
+
+	return true, nil
+}
+
+// ttl returns the time left until the key expires, noExpiry for a key
+// without an expiry.
+func (c *cache) ttl(key string) (time.Duration, error) {
+	s := c.getShard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.ttl(key)
+}
+
+func (s *shard) ttl(key string) (time.Duration, error) {
+	i := s.lookupAny(key)
+	if i == nil {
+		return 0, errors.New("not found")
+	}
+
+	if i.getExpired() == 0 {
+		return noExpiry, nil
+	}
+
+	return time.Duration(i.getExpired() - time.Now().UnixNano()), nil
+}
@@ -0,0 +1,116 @@
+package app
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCache_Expire(t *testing.T) {
+	tc := NewCache(0)
+	tc.set("s", "a", 0)
+	tc.rpush("l", "a", 0)
+	tc.hset("h", map[string]interface{}{"a": 1.0}, 0)
+	tc.sadd("z", []string{"a"}, 0)
+
+	for _, key := range []string{"s", "l", "h", "z"} {
+		if ttl, _ := tc.ttl(key); ttl != noExpiry {
+			t.Error("Key without expiry has ttl", key, ttl)
+		}
+		if err := tc.expire(key, 30*time.Millisecond); err != nil {
+			t.Error("Expire fails", key, err)
+		}
+		if ttl, _ := tc.ttl(key); ttl <= 0 || ttl > 30*time.Millisecond {
+			t.Error("Ttl isn't set", key, ttl)
+		}
+	}
+
+	<-time.After(40 * time.Millisecond)
+
+	for _, key := range []string{"s", "l", "h", "z"} {
+		if _, err := tc.ttl(key); err == nil {
+			t.Error("Key doesn't expire", key)
+		}
+	}
+
+	if err := tc.expire("missing", time.Second); err == nil {
+		t.Error("Expire of missing key doesn't fail")
+	}
+	if _, err := tc.ttl("missing"); err == nil {
+		t.Error("Ttl of missing key doesn't fail")
+	}
+}
+
+func TestCache_ExpireInPast(t *testing.T) {
+	tc := NewCache(0)
+	tc.set("a", "a", 0)
+	tc.set("b", "b", 0)
+
+	tc.expire("a", 0)
+	tc.expireAt("b", time.Now().Add(-time.Second))
+
+	if tc.stats().Keys != 0 {
+		t.Error("Keys with expiry in the past aren't deleted", tc.stats().Keys)
+	}
+}
+
+func TestCache_Persist(t *testing.T) {
+	tc := NewCache(0)
+	tc.set("k", "a", 0)
+
+	if removed, _ := tc.persist("k"); removed {
+		t.Error("Persist of key without expiry removes expiry")
+	}
+
+	tc.expireAt("k", time.Now().Add(time.Hour))
+	if removed, _ := tc.persist("k"); !removed {
+		t.Error("Persist doesn't remove expiry")
+	}
+	if ttl, _ := tc.ttl("k"); ttl != noExpiry {
+		t.Error("Key still has ttl", ttl)
+	}
+
+	if _, err := tc.persist("missing"); err == nil {
+		t.Error("Persist of missing key doesn't fail")
+	}
+}
+
+func TestCache_ExpirePersistence(t *testing.T) {
+	dir := t.TempDir()
+	aofPath := filepath.Join(dir, "cache.aof")
+	snapshotPath := filepath.Join(dir, "dump.json")
+
+	tc := NewCache(0)
+	if err := tc.enableAOF(aofPath, FsyncNever, 0); err != nil {
+		t.Fatal(err)
+	}
+	tc.set("expiring", "a", 0)
+	tc.set("persisted", "b", 100)
+	tc.set("deleted", "c", 0)
+	at := time.Now().Add(time.Hour)
+	tc.expireAt("expiring", at)
+	tc.persist("persisted")
+	tc.expire("deleted", -time.Second)
+	tc.saveSnapshot(snapshotPath)
+	tc.disableAOF()
+
+	for _, load := range []func(*cache) error{
+		func(c *cache) error { return c.replayAOF(aofPath) },
+		func(c *cache) error { return c.loadSnapshot(snapshotPath) },
+	} {
+		lc := NewCache(0)
+		if err := load(lc); err != nil {
+			t.Fatal(err)
+		}
+
+		if ttl, _ := lc.ttl("expiring"); ttl <= 59*time.Minute {
+			t.Error("Expiry isn't restored", ttl)
+		}
+		if ttl, _ := lc.ttl("persisted"); ttl != noExpiry {
+			t.Error("Removed expiry is restored", ttl)
+		}
+		if _, err := lc.get("deleted"); err == nil {
+			t.Error("Expired key is restored")
+		}
+	}
+}
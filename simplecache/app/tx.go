@@ -0,0 +1,785 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// errWatchConflict is returned by tx when a watched key was changed.
+var errWatchConflict = errors.New("watched key changed")
+
+// txCommand is one command of a transaction. Op is the name of the
+// endpoint and Args is its request body, or an object with its path and
+// query parameters for endpoints that take no body.
+type txCommand struct {
+	Op   string          `json:"op"`
+	Args json.RawMessage `json:"args"`
+	// args are the decoded Args, the object of the request body or
+	// txArgs. The gRPC API sets them instead of Args.
+	args interface{}
+}
+
+// txArgs holds the arguments of the commands that are GET requests outside
+// a transaction, named like their path and query parameters.
+type txArgs struct {
+	Key     string   `json:"key"`
+	Keys    []string `json:"keys"`
+	Field   string   `json:"field"`
+	Fields  []string `json:"fields"`
+	Member  string   `json:"member"`
+	Index   int      `json:"index"`
+	Start   int      `json:"start"`
+	Stop    int      `json:"stop"`
+	Min     string   `json:"min"`
+	Max     string   `json:"max"`
+	Offset  int      `json:"offset"`
+	Count   *int     `json:"count"`
+	Rev     bool     `json:"rev"`
+	Pattern string   `json:"pattern"`
+	Cursor  string   `json:"cursor"`
+	Match   string   `json:"match"`
+	Type    string   `json:"type"`
+}
+
+func (a txArgs) count(def int) int {
+	if a.Count == nil {
+		return def
+	}
+
+	return *a.Count
+}
+
+// txResult is the result of one command, the response body of its
+// endpoint or the error.
+type txResult struct {
+	Result interface{} `json:"result"`
+	Error  string      `json:"error,omitempty"`
+}
+
+// writeJSON encodes r like json.Marshal with the stored values of the
+// result written as marshalJSON does.
+func (r txResult) writeJSON(buf *bytes.Buffer) error {
+	buf.WriteString(`{"result":`)
+	if err := writeJSON(buf, r.Result); err != nil {
+		return err
+	}
+	if r.Error != "" {
+		buf.WriteString(`,"error":`)
+		if err := writeJSON(buf, r.Error); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte('}')
+
+	return nil
+}
+
+// txError is a command that failed validation, so no command of the
+// transaction was applied.
+type txError struct {
+	index int
+	err   error
+}
+
+func (e *txError) Error() string {
+	return "command " + strconv.Itoa(e.index) + ": " + e.err.Error()
+}
+
+// txStep is a parsed command ready to run with the shards of its keys
+// locked.
+type txStep struct {
+	keys []string
+	// write is set for commands that may add data, so capacity is made for
+	// their keys before the transaction.
+	write bool
+	// all is set for commands that read the whole keyspace.
+	all   bool
+	check func(t *txTypes) error
+	run   func() (interface{}, error)
+}
+
+// txTypes tracks the types each key may have as the commands of a
+// transaction are applied one by one. The empty type means there is no
+// such key.
+type txTypes struct {
+	c     *cache
+	types map[string]map[string]bool
+	// flushed is set after a flushdb, when keys not seen yet are gone.
+	flushed bool
+}
+
+func (t *txTypes) of(key string) map[string]bool {
+	types, found := t.types[key]
+	if !found {
+		typ := ""
+		if i := t.c.getShard(key).lookupAny(key); i != nil && !t.flushed {
+			typ = typeOf(i)
+		}
+		types = map[string]bool{typ: true}
+		t.types[key] = types
+	}
+
+	return types
+}
+
+// require fails when the key may hold a value of another type.
+func (t *txTypes) require(typ string, keys ...string) error {
+	for _, k := range keys {
+		for other := range t.of(k) {
+			if other != "" && other != typ {
+				return errors.New("wrong type")
+			}
+		}
+	}
+
+	return nil
+}
+
+// becomes records that the key surely has the type after the command.
+func (t *txTypes) becomes(key string, typ string) {
+	t.types[key] = map[string]bool{typ: true}
+}
+
+// mayBecome records that the key may have the type after the command, e.g.
+// the empty type after a pop.
+func (t *txTypes) mayBecome(key string, typ string) {
+	t.of(key)[typ] = true
+}
+
+// requireAndBecome checks the type of a command that creates the key.
+func requireAndBecome(key string, typ string) func(t *txTypes) error {
+	return func(t *txTypes) error {
+		if err := t.require(typ, key); err != nil {
+			return err
+		}
+		t.becomes(key, typ)
+
+		return nil
+	}
+}
+
+// requireAndMayRemove checks the type of a command that may remove the
+// key.
+func requireAndMayRemove(key string, typ string) func(t *txTypes) error {
+	return func(t *txTypes) error {
+		if err := t.require(typ, key); err != nil {
+			return err
+		}
+		t.mayBecome(key, "")
+
+		return nil
+	}
+}
+
+func requireType(typ string, keys ...string) func(t *txTypes) error {
+	return func(t *txTypes) error {
+		return t.require(typ, keys...)
+	}
+}
+
+func noCheck(t *txTypes) error {
+	return nil
+}
+
+// tx runs the commands atomically: no other client sees the keyspace
+// between them. All commands are parsed and their types are checked
+// before the first one is applied, so a malformed command or a command on
+// a key of the wrong type aborts the whole transaction with a txError.
+// Other errors, like an index out of range, fail only their command.
+//
+// watch maps keys to the versions the client read them at, zero for a
+// missing key. When any of them has another version now, nothing is
+// applied and errWatchConflict is returned.
+func (c *cache) tx(watch map[string]int64, commands []txCommand) ([]txResult, error) {
+	steps := make([]*txStep, len(commands))
+	var keys, writeKeys []string
+	for k := range watch {
+		keys = append(keys, k)
+	}
+	all := false
+	for i, cmd := range commands {
+		step, err := c.parseTxCommand(cmd)
+		if err != nil {
+			return nil, &txError{index: i, err: err}
+		}
+		steps[i] = step
+		keys = append(keys, step.keys...)
+		if step.write {
+			writeKeys = append(writeKeys, step.keys...)
+		}
+		all = all || step.all
+	}
+
+	for _, k := range writeKeys {
+		if err := c.ensureCapacity(k); err != nil {
+			return nil, err
+		}
+	}
+
+	unlock := c.lockKeys(keys, all)
+	defer unlock()
+
+	for k, version := range watch {
+		if c.getShard(k).currentVersion(k) != version {
+			return nil, errWatchConflict
+		}
+	}
+
+	types := &txTypes{c: c, types: map[string]map[string]bool{}}
+	for i, step := range steps {
+		if err := step.check(types); err != nil {
+			return nil, &txError{index: i, err: err}
+		}
+	}
+
+	results := make([]txResult, len(steps))
+	for i, step := range steps {
+		result, err := step.run()
+		if err != nil {
+			results[i].Error = err.Error()
+		} else {
+			results[i].Result = result
+		}
+	}
+
+	return results, nil
+}
+
+// do runs a single command. The types aren't checked in advance as in a
+// transaction, so it fails with the same errors as the endpoint.
+func (c *cache) do(cmd txCommand) (interface{}, error) {
+	step, err := c.parseTxCommand(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	if step.write {
+		for _, k := range step.keys {
+			if err := c.ensureCapacity(k); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	unlock := c.lockKeys(step.keys, step.all)
+	defer unlock()
+
+	return step.run()
+}
+
+// lockKeys locks the shards of the keys, or all shards, and returns the
+// function unlocking them.
+func (c *cache) lockKeys(keys []string, all bool) func() {
+	if all {
+		c.lockAll()
+		return c.unlockAll
+	}
+
+	shards := c.shardsOf(keys...)
+	lockShards(shards)
+	return func() { unlockShards(shards) }
+}
+
+// txSuccess builds the body of a write endpoint from field and value pairs.
+func txSuccess(fields ...interface{}) map[string]interface{} {
+	result := map[string]interface{}{"result": "success"}
+	for i := 0; i+1 < len(fields); i += 2 {
+		result[fields[i].(string)] = fields[i+1]
+	}
+
+	return result
+}
+
+// parseTxCommand decodes the arguments of a command. The results of the
+// steps are the response bodies of the endpoints.
+func (c *cache) parseTxCommand(cmd txCommand) (*txStep, error) {
+	decode := func(v interface{}) error {
+		if cmd.args != nil {
+			target := reflect.ValueOf(v).Elem()
+			args := reflect.ValueOf(cmd.args)
+			if args.Type() != target.Type() {
+				return errors.New("invalid args of " + cmd.Op)
+			}
+			target.Set(args)
+			return nil
+		}
+		if len(cmd.Args) == 0 {
+			return errors.New("no args given")
+		}
+		if err := json.Unmarshal(cmd.Args, v); err != nil {
+			return errors.New("invalid args: " + err.Error())
+		}
+
+		return nil
+	}
+
+	switch cmd.Op {
+	case "set", "getset", "cas":
+		return c.parseTxStringWrite(cmd.Op, decode)
+	case "incr", "decr", "incrby":
+		var io incrObject
+		if err := decode(&io); err != nil {
+			return nil, err
+		}
+		switch cmd.Op {
+		case "incr":
+			io.Value = 1
+		case "decr":
+			io.Value = -1
+		}
+		return &txStep{keys: []string{io.Key}, write: true, check: requireAndBecome(io.Key, simpleType), run: func() (interface{}, error) {
+			value, err := c.getShard(io.Key).incrby(io.Key, io.Value, c.expiration(io.Expired), io.Refresh)
+			return txSuccess("value", value), err
+		}}, nil
+	case "incrbyfloat":
+		var io incrFloatObject
+		if err := decode(&io); err != nil {
+			return nil, err
+		}
+		return &txStep{keys: []string{io.Key}, write: true, check: requireAndBecome(io.Key, simpleType), run: func() (interface{}, error) {
+			value, err := c.getShard(io.Key).incrbyfloat(io.Key, io.Value, c.expiration(io.Expired), io.Refresh)
+			return txSuccess("value", value), err
+		}}, nil
+	case "expire", "expireat":
+		var key string
+		var at time.Time
+		if cmd.Op == "expire" {
+			var eo expireObject
+			if err := decode(&eo); err != nil {
+				return nil, err
+			}
+			key = eo.Key
+			at = time.Now().Add(time.Duration(eo.Seconds)*time.Second + time.Duration(eo.Milliseconds)*time.Millisecond)
+		} else {
+			var eo expireAtObject
+			if err := decode(&eo); err != nil {
+				return nil, err
+			}
+			key = eo.Key
+			at = time.Unix(eo.Timestamp, 0)
+			if eo.TimestampMs != 0 {
+				at = time.UnixMilli(eo.TimestampMs)
+			}
+		}
+		return &txStep{keys: []string{key}, check: func(t *txTypes) error {
+			t.mayBecome(key, "")
+			return nil
+		}, run: func() (interface{}, error) {
+			return txSuccess(), c.getShard(key).expireAt(key, at.UnixNano())
+		}}, nil
+	case "rpush", "lpush":
+		var po pushObject
+		if err := decode(&po); err != nil {
+			return nil, err
+		}
+		values := po.values()
+		return &txStep{keys: []string{po.Key}, write: true, check: requireAndBecome(po.Key, listType), run: func() (interface{}, error) {
+			length, err := c.getShard(po.Key).push(po.Key, values, cmd.Op == "lpush", c.expiration(po.Expired))
+			return txSuccess("length", length), err
+		}}, nil
+	case "lset":
+		var lo lsetObject
+		if err := decode(&lo); err != nil {
+			return nil, err
+		}
+		return &txStep{keys: []string{lo.Key}, write: true, check: requireType(listType, lo.Key), run: func() (interface{}, error) {
+			return txSuccess(), c.getShard(lo.Key).lset(lo.Key, lo.Index, jsonValue(lo.Value))
+		}}, nil
+	case "lrem":
+		var lo lremObject
+		if err := decode(&lo); err != nil {
+			return nil, err
+		}
+		return &txStep{keys: []string{lo.Key}, check: requireAndMayRemove(lo.Key, listType), run: func() (interface{}, error) {
+			removed, err := c.getShard(lo.Key).lrem(lo.Key, lo.Count, jsonValue(lo.Value))
+			return txSuccess("removed", removed), err
+		}}, nil
+	case "ltrim":
+		var lo ltrimObject
+		if err := decode(&lo); err != nil {
+			return nil, err
+		}
+		return &txStep{keys: []string{lo.Key}, check: requireAndMayRemove(lo.Key, listType), run: func() (interface{}, error) {
+			return txSuccess(), c.getShard(lo.Key).ltrim(lo.Key, lo.Start, lo.Stop)
+		}}, nil
+	case "linsert":
+		var lo linsertObject
+		if err := decode(&lo); err != nil {
+			return nil, err
+		}
+		if lo.Position != "before" && lo.Position != "after" {
+			return nil, errors.New("position must be before or after")
+		}
+		return &txStep{keys: []string{lo.Key}, write: true, check: requireType(listType, lo.Key), run: func() (interface{}, error) {
+			length, err := c.getShard(lo.Key).linsert(lo.Key, lo.Position == "before", jsonValue(lo.Pivot), jsonValue(lo.Value))
+			return txSuccess("length", length), err
+		}}, nil
+	case "hset":
+		var so setHObject
+		if err := decode(&so); err != nil {
+			return nil, err
+		}
+		return &txStep{keys: []string{so.Key}, write: true, check: requireAndBecome(so.Key, dictType), run: func() (interface{}, error) {
+			return txSuccess(), c.getShard(so.Key).hset(so.Key, jsonFields(so.Value), c.expiration(so.Expired))
+		}}, nil
+	case "hdel":
+		var ho hdelObject
+		if err := decode(&ho); err != nil {
+			return nil, err
+		}
+		return &txStep{keys: []string{ho.Key}, check: requireAndMayRemove(ho.Key, dictType), run: func() (interface{}, error) {
+			removed, err := c.getShard(ho.Key).hdel(ho.Key, ho.Fields)
+			return txSuccess("removed", removed), err
+		}}, nil
+	case "hsetnx":
+		var ho hsetnxObject
+		if err := decode(&ho); err != nil {
+			return nil, err
+		}
+		return &txStep{keys: []string{ho.Key}, write: true, check: requireAndBecome(ho.Key, dictType), run: func() (interface{}, error) {
+			set, err := c.getShard(ho.Key).hsetnx(ho.Key, ho.Field, jsonValue(ho.Value), c.expiration(ho.Expired))
+			return txSuccess("set", set), err
+		}}, nil
+	case "hincrby":
+		var ho hincrbyObject
+		if err := decode(&ho); err != nil {
+			return nil, err
+		}
+		return &txStep{keys: []string{ho.Key}, write: true, check: requireAndBecome(ho.Key, dictType), run: func() (interface{}, error) {
+			value, err := c.getShard(ho.Key).hincrby(ho.Key, ho.Field, ho.Value)
+			return txSuccess("value", value), err
+		}}, nil
+	case "hincrbyfloat":
+		var ho hincrbyfloatObject
+		if err := decode(&ho); err != nil {
+			return nil, err
+		}
+		return &txStep{keys: []string{ho.Key}, write: true, check: requireAndBecome(ho.Key, dictType), run: func() (interface{}, error) {
+			value, err := c.getShard(ho.Key).hincrbyfloat(ho.Key, ho.Field, ho.Value)
+			return txSuccess("value", value), err
+		}}, nil
+	case "sadd":
+		var so saddObject
+		if err := decode(&so); err != nil {
+			return nil, err
+		}
+		return &txStep{keys: []string{so.Key}, write: true, check: requireAndBecome(so.Key, setType), run: func() (interface{}, error) {
+			added, err := c.getShard(so.Key).sadd(so.Key, so.Value, c.expiration(so.Expired))
+			return txSuccess("added", added), err
+		}}, nil
+	case "srem":
+		var so sremObject
+		if err := decode(&so); err != nil {
+			return nil, err
+		}
+		return &txStep{keys: []string{so.Key}, check: requireAndMayRemove(so.Key, setType), run: func() (interface{}, error) {
+			removed, err := c.getShard(so.Key).srem(so.Key, so.Value)
+			return txSuccess("removed", removed), err
+		}}, nil
+	case "sinterstore", "sunionstore", "sdiffstore":
+		var so setStoreObject
+		if err := decode(&so); err != nil {
+			return nil, err
+		}
+		if len(so.Keys) == 0 {
+			return nil, errors.New("no keys given")
+		}
+		op := setOperations[cmd.Op[:len(cmd.Op)-len("store")]]
+		check := func(t *txTypes) error {
+			if err := t.require(setType, so.Keys...); err != nil {
+				return err
+			}
+			t.becomes(so.Destination, "")
+			t.mayBecome(so.Destination, setType)
+
+			return nil
+		}
+		return &txStep{keys: append([]string{so.Destination}, so.Keys...), write: true, check: check, run: func() (interface{}, error) {
+			count, err := c.storeCombined(op, so.Destination, so.Keys)
+			return txSuccess("count", count), err
+		}}, nil
+	case "zadd":
+		var zo zaddObject
+		if err := decode(&zo); err != nil {
+			return nil, err
+		}
+		flags := zaddFlags{nx: zo.NX, xx: zo.XX, incr: zo.Incr}
+		return &txStep{keys: []string{zo.Key}, write: true, check: requireAndBecome(zo.Key, zsetType), run: func() (interface{}, error) {
+			result, err := c.getShard(zo.Key).zadd(zo.Key, zo.Value, flags, c.expiration(zo.Expired))
+			if zo.Incr {
+				return txSuccess("score", result), err
+			}
+			return txSuccess("added", result), err
+		}}, nil
+	case "zrem":
+		var zo zremObject
+		if err := decode(&zo); err != nil {
+			return nil, err
+		}
+		return &txStep{keys: []string{zo.Key}, check: requireAndMayRemove(zo.Key, zsetType), run: func() (interface{}, error) {
+			removed, err := c.getShard(zo.Key).zrem(zo.Key, zo.Value)
+			return txSuccess("removed", removed), err
+		}}, nil
+	}
+
+	var args txArgs
+	if err := decode(&args); err != nil {
+		return nil, err
+	}
+
+	return c.parseTxRead(cmd.Op, args)
+}
+
+var setOperations = map[string]int{"sinter": setInter, "sunion": setUnion, "sdiff": setDiff}
+
+// parseTxStringWrite parses the commands that overwrite a string value.
+func (c *cache) parseTxStringWrite(op string, decode func(v interface{}) error) (*txStep, error) {
+	switch op {
+	case "set":
+		var so setObject
+		if err := decode(&so); err != nil {
+			return nil, err
+		}
+		if so.NX && so.XX {
+			return nil, errors.New("nx and xx options at the same time are not compatible")
+		}
+		flags := setFlags{nx: so.NX, xx: so.XX}
+		check := func(t *txTypes) error {
+			if so.NX || so.XX {
+				t.mayBecome(so.Key, simpleType)
+			} else {
+				t.becomes(so.Key, simpleType)
+			}
+			return nil
+		}
+		return &txStep{keys: []string{so.Key}, write: true, check: check, run: func() (interface{}, error) {
+			set, version, err := c.getShard(so.Key).setCond(so.Key, jsonValue(so.Value), c.expiration(so.Expired), flags)
+			if !set {
+				return txSuccess("set", false), err
+			}
+			return txSuccess("set", true, "version", version), err
+		}}, nil
+	case "getset":
+		var so setObject
+		if err := decode(&so); err != nil {
+			return nil, err
+		}
+		return &txStep{keys: []string{so.Key}, write: true, check: requireAndBecome(so.Key, simpleType), run: func() (interface{}, error) {
+			old, version, err := c.getShard(so.Key).getset(so.Key, jsonValue(so.Value), c.expiration(so.Expired))
+			return txSuccess("value", old, "version", version), err
+		}}, nil
+	default:
+		var co casObject
+		if err := decode(&co); err != nil {
+			return nil, err
+		}
+		check := func(t *txTypes) error {
+			t.mayBecome(co.Key, simpleType)
+			return nil
+		}
+		return &txStep{keys: []string{co.Key}, write: true, check: check, run: func() (interface{}, error) {
+			version, err := c.getShard(co.Key).cas(co.Key, jsonValue(co.Value), c.expiration(co.Expired), co.Version)
+			return txSuccess("version", version), err
+		}}, nil
+	}
+}
+
+// parseTxRead parses the commands that take no request body. Besides the
+// reads these are the pops and unset.
+func (c *cache) parseTxRead(op string, a txArgs) (*txStep, error) {
+	key := a.Key
+	s := c.getShard(key)
+	step := &txStep{keys: []string{key}}
+
+	switch op {
+	case "get":
+		step.check = requireType(simpleType, key)
+		step.run = func() (interface{}, error) { return s.get(key) }
+	case "gets":
+		step.check = requireType(simpleType, key)
+		step.run = func() (interface{}, error) {
+			object, version, err := s.gets(key)
+			return map[string]interface{}{"value": object, "version": version}, err
+		}
+	case "version":
+		step.check = noCheck
+		step.run = func() (interface{}, error) { return s.version(key) }
+	case "unset":
+		step.check = func(t *txTypes) error {
+			t.becomes(key, "")
+			return nil
+		}
+		step.run = func() (interface{}, error) {
+			s.deleteItem(key)
+			return txSuccess(), nil
+		}
+	case "persist":
+		step.check = noCheck
+		step.run = func() (interface{}, error) {
+			removed, err := s.persist(key)
+			return txSuccess("persisted", removed), err
+		}
+	case "ttl", "pttl":
+		unit := time.Second
+		if op == "pttl" {
+			unit = time.Millisecond
+		}
+		step.check = noCheck
+		step.run = func() (interface{}, error) {
+			ttl, err := s.ttl(key)
+			if err != nil || ttl == noExpiry {
+				return -1, err
+			}
+			return int64((ttl + unit/2) / unit), nil
+		}
+	case "keys":
+		step.keys, step.all = nil, true
+		step.check = noCheck
+		step.run = func() (interface{}, error) { return c.matchingKeys(a.Pattern), nil }
+	case "scan":
+		step.keys, step.all = nil, true
+		step.check = noCheck
+		step.run = func() (interface{}, error) {
+			cursor, keys, err := c.scanShards(a.Cursor, a.count(0), scanFilter{match: a.Match, typ: a.Type}, false)
+			return map[string]interface{}{"cursor": cursor, "keys": keys}, err
+		}
+	case "stats":
+		step.keys = nil
+		step.check = noCheck
+		step.run = func() (interface{}, error) { return c.stats(), nil }
+	case "dbsize":
+		step.keys = nil
+		step.check = noCheck
+		step.run = func() (interface{}, error) { return c.count.Load(), nil }
+	case "flushdb":
+		step.keys, step.all = nil, true
+		step.check = func(t *txTypes) error {
+			t.types = map[string]map[string]bool{}
+			t.flushed = true
+			return nil
+		}
+		step.run = func() (interface{}, error) {
+			return txSuccess("deleted", c.flushKeys()), nil
+		}
+	case "pop", "lpop":
+		step.check = requireAndMayRemove(key, listType)
+		step.run = func() (interface{}, error) { return s.pop(key, op == "lpop") }
+	case "blpop", "brpop":
+		// A transaction can't wait, so the pop fails when all lists are
+		// empty.
+		step.keys = a.Keys
+		step.check = func(t *txTypes) error {
+			if err := t.require(listType, a.Keys...); err != nil {
+				return err
+			}
+			for _, k := range a.Keys {
+				t.mayBecome(k, "")
+			}
+			return nil
+		}
+		step.run = func() (interface{}, error) {
+			for _, k := range a.Keys {
+				if object, err := c.getShard(k).pop(k, op == "blpop"); err == nil {
+					return popResult{Key: k, Value: object}, nil
+				}
+			}
+			return nil, errors.New("not found")
+		}
+	case "lgetall":
+		step.check = requireType(listType, key)
+		step.run = func() (interface{}, error) { return s.lgetall(key) }
+	case "lget":
+		step.check = requireType(listType, key)
+		step.run = func() (interface{}, error) { return s.lget(key, a.Index) }
+	case "llen":
+		step.check = requireType(listType, key)
+		step.run = func() (interface{}, error) { return s.llen(key) }
+	case "lrange":
+		step.check = requireType(listType, key)
+		step.run = func() (interface{}, error) { return s.lrange(key, a.Start, a.Stop) }
+	case "hgetall":
+		step.check = requireType(dictType, key)
+		step.run = func() (interface{}, error) { return s.hgetall(key) }
+	case "hget":
+		step.check = requireType(dictType, key)
+		step.run = func() (interface{}, error) { return s.hget(key, a.Field) }
+	case "hexists":
+		step.check = requireType(dictType, key)
+		step.run = func() (interface{}, error) { return s.hexists(key, a.Field) }
+	case "hlen":
+		step.check = requireType(dictType, key)
+		step.run = func() (interface{}, error) { return s.hlen(key) }
+	case "hkeys":
+		step.check = requireType(dictType, key)
+		step.run = func() (interface{}, error) { return s.hkeys(key) }
+	case "hvals":
+		step.check = requireType(dictType, key)
+		step.run = func() (interface{}, error) { return s.hvals(key) }
+	case "hmget":
+		step.check = requireType(dictType, key)
+		step.run = func() (interface{}, error) { return s.hmget(key, a.Fields) }
+	case "sismember":
+		step.check = requireType(setType, key)
+		step.run = func() (interface{}, error) { return s.sismember(key, a.Member) }
+	case "smembers":
+		step.check = requireType(setType, key)
+		step.run = func() (interface{}, error) { return s.smembers(key) }
+	case "scard":
+		step.check = requireType(setType, key)
+		step.run = func() (interface{}, error) { return s.scard(key) }
+	case "spop":
+		step.check = requireAndMayRemove(key, setType)
+		step.run = func() (interface{}, error) { return s.spop(key, a.count(1)) }
+	case "sinter", "sunion", "sdiff":
+		if len(a.Keys) == 0 {
+			return nil, errors.New("no keys given")
+		}
+		step.keys = a.Keys
+		step.check = requireType(setType, a.Keys...)
+		step.run = func() (interface{}, error) {
+			result, err := c.combine(setOperations[op], a.Keys)
+			if err != nil {
+				return nil, err
+			}
+			return sortedMembers(result), nil
+		}
+	case "zscore":
+		step.check = requireType(zsetType, key)
+		step.run = func() (interface{}, error) { return s.zscore(key, a.Member) }
+	case "zrank", "zrevrank":
+		step.check = requireType(zsetType, key)
+		step.run = func() (interface{}, error) { return s.zrank(key, a.Member, op == "zrevrank") }
+	case "zrange":
+		step.check = requireType(zsetType, key)
+		step.run = func() (interface{}, error) { return s.zrange(key, a.Start, a.Stop, a.Rev) }
+	case "zrangebyscore", "zcount":
+		min, max := a.Min, a.Max
+		if min == "" {
+			min = "-inf"
+		}
+		if max == "" {
+			max = "+inf"
+		}
+		sr, err := parseScoreRange(min, max)
+		if err != nil {
+			return nil, err
+		}
+		step.check = requireType(zsetType, key)
+		if op == "zcount" {
+			step.run = func() (interface{}, error) { return s.zcount(key, sr) }
+		} else {
+			step.run = func() (interface{}, error) { return s.zrangeByScore(key, sr, a.Offset, a.count(-1), a.Rev) }
+		}
+	case "zpopmin", "zpopmax":
+		step.check = requireAndMayRemove(key, zsetType)
+		step.run = func() (interface{}, error) { return s.zpop(key, a.count(1), op == "zpopmax") }
+	default:
+		return nil, errors.New("unknown command " + op)
+	}
+
+	return step, nil
+}
@@ -0,0 +1,233 @@
+package app
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func parseCommands(t *testing.T, s string) []txCommand {
+	var commands []txCommand
+	if err := json.Unmarshal([]byte(s), &commands); err != nil {
+		t.Fatal(err)
+	}
+
+	return commands
+}
+
+func TestCache_Tx(t *testing.T) {
+	tc := NewCache(0)
+
+	results, err := tc.tx(nil, parseCommands(t, `[
+		{"op": "rpush", "args": {"key": "jobs", "values": ["a", "b"]}},
+		{"op": "incr", "args": {"key": "pushed"}},
+		{"op": "hset", "args": {"key": "h", "value": {"f": "v"}}},
+		{"op": "sadd", "args": {"key": "s", "value": ["x"]}},
+		{"op": "lrange", "args": {"key": "jobs", "start": 0, "stop": -1}},
+		{"op": "hget", "args": {"key": "h", "field": "f"}},
+		{"op": "get", "args": {"key": "missing"}}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if length := results[0].Result.(map[string]interface{})["length"]; length != 2 {
+		t.Error("Rpush in tx doesn't return length 2", length)
+	}
+	if value := results[1].Result.(map[string]interface{})["value"]; value != int64(1) {
+		t.Error("Incr in tx doesn't return 1", value)
+	}
+	if !reflect.DeepEqual(results[4].Result, []interface{}{"a", "b"}) {
+		t.Error("Lrange in tx is wrong", results[4].Result)
+	}
+	if results[5].Result != "v" {
+		t.Error("Hget in tx is wrong", results[5].Result)
+	}
+	if results[6].Error == "" {
+		t.Error("Get of missing key in tx doesn't fail")
+	}
+
+	if v, _ := tc.get("pushed"); v != int64(1) {
+		t.Error("Incr in tx isn't applied", v)
+	}
+}
+
+func TestCache_TxTypeErrorAbortsAll(t *testing.T) {
+	tc := NewCache(0)
+	tc.set("s", "string", 0)
+
+	for _, commands := range []string{
+		`[{"op": "set", "args": {"key": "a", "value": 1}}, {"op": "rpush", "args": {"key": "s", "value": 1}}]`,
+		`[{"op": "set", "args": {"key": "a", "value": 1}}, {"op": "hget", "args": {"key": "s", "field": "f"}}]`,
+		`[{"op": "set", "args": {"key": "a", "value": 1}}, {"op": "sadd", "args": {"key": "l", "value": ["x"]}}, {"op": "llen", "args": {"key": "l"}}]`,
+		`[{"op": "set", "args": {"key": "a", "value": 1}}, {"op": "unknown", "args": {}}]`,
+		`[{"op": "set", "args": {"key": "a", "value": 1}}, {"op": "set", "args": {"key": "a", "nx": true, "xx": true}}]`,
+	} {
+		if _, err := tc.tx(nil, parseCommands(t, commands)); err == nil {
+			t.Error("Invalid tx doesn't fail", commands)
+		}
+		if _, err := tc.get("a"); err == nil {
+			t.Error("Invalid tx is partly applied", commands)
+		}
+	}
+
+	_, err := tc.tx(nil, parseCommands(t, `[
+		{"op": "unset", "args": {"key": "s"}},
+		{"op": "rpush", "args": {"key": "s", "value": 1}},
+		{"op": "pop", "args": {"key": "s"}},
+		{"op": "set", "args": {"key": "s", "value": "again"}}
+	]`))
+	if err != nil {
+		t.Error("Tx changing the type of a key fails", err)
+	}
+	if v, _ := tc.get("s"); v != "again" {
+		t.Error("Value of s doesn't equals again", v)
+	}
+}
+
+func TestCache_TxOtherErrorsFailOneCommand(t *testing.T) {
+	tc := NewCache(0)
+
+	results, err := tc.tx(nil, parseCommands(t, `[
+		{"op": "rpush", "args": {"key": "l", "value": "a"}},
+		{"op": "lset", "args": {"key": "l", "index": 5, "value": "b"}},
+		{"op": "rpush", "args": {"key": "l", "value": "c"}}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results[1].Error == "" {
+		t.Error("Lset out of range in tx doesn't fail")
+	}
+	if values, _ := tc.lgetall("l"); !reflect.DeepEqual(values, []interface{}{"a", "c"}) {
+		t.Error("Commands around the failed one aren't applied", values)
+	}
+}
+
+func TestCache_TxIsAtomic(t *testing.T) {
+	tc := NewCache(0)
+	tc.set("a", int64(0), 0)
+	tc.set("b", int64(0), 0)
+
+	incr := parseCommands(t, `[{"op": "incr", "args": {"key": "a"}}, {"op": "incr", "args": {"key": "b"}}]`)
+	read := parseCommands(t, `[{"op": "get", "args": {"key": "a"}}, {"op": "get", "args": {"key": "b"}}]`)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				tc.tx(nil, incr)
+			}
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	for {
+		results, _ := tc.tx(nil, read)
+		if results[0].Result != results[1].Result {
+			t.Fatal("Tx shows intermediate state", results)
+		}
+		select {
+		case <-done:
+			if v, _ := tc.get("a"); v != int64(400) {
+				t.Error("Value of a doesn't equals 400", v)
+			}
+			return
+		default:
+		}
+	}
+}
+
+func TestCache_TxPersistence(t *testing.T) {
+	dir := t.TempDir()
+	aofPath := filepath.Join(dir, "cache.aof")
+
+	tc := NewCache(0)
+	if err := tc.enableAOF(aofPath, FsyncNever, 0); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		tc.tx(nil, parseCommands(t, `[
+			{"op": "lpush", "args": {"key": "l", "value": `+strconv.Itoa(i)+`}},
+			{"op": "hincrby", "args": {"key": "h", "field": "n", "value": 2}},
+			{"op": "sunionstore", "args": {"destination": "u", "keys": ["s1", "s2"]}},
+			{"op": "sadd", "args": {"key": "s1", "value": ["x`+strconv.Itoa(i)+`"]}}
+		]`))
+	}
+	tc.disableAOF()
+
+	lc := NewCache(0)
+	if err := lc.replayAOF(aofPath); err != nil {
+		t.Fatal(err)
+	}
+
+	if values, _ := lc.lgetall("l"); !reflect.DeepEqual(values, []interface{}{json.Number("2"), json.Number("1"), json.Number("0")}) {
+		t.Error("List isn't restored", values)
+	}
+	if v, _ := lc.hget("h", "n"); v != json.Number("6") {
+		t.Error("Dict isn't restored", v)
+	}
+	if members, _ := lc.smembers("u"); !reflect.DeepEqual(members, []string{"x0", "x1"}) {
+		t.Error("Stored set isn't restored", members)
+	}
+}
+
+func TestCache_TxWatch(t *testing.T) {
+	tc := NewCache(0)
+	tc.set("balance", int64(10), 0)
+
+	withdraw := parseCommands(t, `[{"op": "decr", "args": {"key": "balance"}}]`)
+
+	versions := tc.versions([]string{"balance", "missing"})
+	if versions["balance"] == 0 || versions["missing"] != 0 {
+		t.Error("Versions are wrong", versions)
+	}
+	if _, err := tc.tx(versions, withdraw); err != nil {
+		t.Error("Tx with unchanged watched keys fails", err)
+	}
+
+	if _, err := tc.tx(versions, withdraw); err != errWatchConflict {
+		t.Error("Tx with changed watched key doesn't conflict", err)
+	}
+	if v, _ := tc.get("balance"); v != int64(9) {
+		t.Error("Conflicting tx is applied", v)
+	}
+
+	versions = tc.versions([]string{"balance", "missing"})
+	tc.set("missing", "now exists", 0)
+	if _, err := tc.tx(versions, withdraw); err != errWatchConflict {
+		t.Error("Tx with created watched key doesn't conflict", err)
+	}
+
+	versions = tc.versions([]string{"balance"})
+	tc.deleteItem("balance")
+	tc.set("balance", int64(9), 0)
+	if _, err := tc.tx(versions, withdraw); err != errWatchConflict {
+		t.Error("Tx with recreated watched key doesn't conflict", err)
+	}
+}
+
+func TestCache_TxWatchExpired(t *testing.T) {
+	tc := NewCache(time.Hour)
+	tc.ExpiredTimeMultiplier = time.Millisecond
+	tc.set("k", "a", 20)
+
+	versions := tc.versions([]string{"k"})
+	<-time.After(30 * time.Millisecond)
+
+	if _, err := tc.tx(versions, parseCommands(t, `[{"op": "set", "args": {"key": "k", "value": "b"}}]`)); err != errWatchConflict {
+		t.Error("Tx with expired watched key doesn't conflict", err)
+	}
+}
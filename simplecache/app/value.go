@@ -0,0 +1,168 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+)
+
+// jsonValue converts a JSON value of a request to the form it's stored in,
+// so it reads back exactly as it was written: strings are stored as
+// strings, numbers as json.Number, which keeps large integers precise, and
+// objects and arrays as their raw bytes, which keeps the order of their
+// fields. The append-only log and snapshots keep objects and arrays
+// without the whitespace between their tokens. raw must be valid JSON.
+func jsonValue(raw json.RawMessage) interface{} {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return nil
+	}
+
+	switch raw[0] {
+	case '"':
+		var s string
+		json.Unmarshal(raw, &s)
+		return s
+	case '{', '[':
+		// The bytes may belong to a buffer the decoder reuses.
+		return append(json.RawMessage(nil), raw...)
+	case 't':
+		return true
+	case 'f':
+		return false
+	case 'n':
+		return nil
+	}
+
+	return json.Number(raw)
+}
+
+// jsonValues converts the elements of a list like jsonValue.
+func jsonValues(raw []json.RawMessage) []interface{} {
+	values := make([]interface{}, len(raw))
+	for i, v := range raw {
+		values[i] = jsonValue(v)
+	}
+
+	return values
+}
+
+// encodeValue encodes a value stored by jsonValue back to JSON, nil when
+// it can't be encoded.
+func encodeValue(value interface{}) json.RawMessage {
+	b, err := marshalJSON(value)
+	if err != nil {
+		return nil
+	}
+
+	return b
+}
+
+// marshalJSON encodes v like json.Marshal, except that <, > and & aren't
+// escaped, and objects and arrays stored by jsonValue are written as their
+// raw bytes when they are v or are held by its maps, lists and transaction
+// results. json.Marshal would compact them, so responses wouldn't read back
+// as they were written. Inside other structs, like the commands of the
+// log, they are still compacted.
+func marshalJSON(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := writeJSON(&buf, v); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeJSON(buf *bytes.Buffer, v interface{}) error {
+	switch v := v.(type) {
+	case json.RawMessage:
+		if v == nil {
+			buf.WriteString("null")
+			return nil
+		}
+		buf.Write(v)
+		return nil
+	case map[string]interface{}:
+		if v == nil {
+			buf.WriteString("null")
+			return nil
+		}
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		buf.WriteByte('{')
+		for i, k := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeJSON(buf, k); err != nil {
+				return err
+			}
+			buf.WriteByte(':')
+			if err := writeJSON(buf, v[k]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+		return nil
+	case []interface{}:
+		if v == nil {
+			buf.WriteString("null")
+			return nil
+		}
+		buf.WriteByte('[')
+		for i, e := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeJSON(buf, e); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+		return nil
+	case []txResult:
+		if v == nil {
+			buf.WriteString("null")
+			return nil
+		}
+		buf.WriteByte('[')
+		for i, r := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := r.writeJSON(buf); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+		return nil
+	}
+
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return err
+	}
+	// Encode ends the value with a newline.
+	buf.Truncate(buf.Len() - 1)
+
+	return nil
+}
+
+// jsonFields converts the fields of a dict like jsonValue.
+func jsonFields(raw map[string]json.RawMessage) map[string]interface{} {
+	if raw == nil {
+		return nil
+	}
+
+	fields := make(map[string]interface{}, len(raw))
+	for k, v := range raw {
+		fields[k] = jsonValue(v)
+	}
+
+	return fields
+}
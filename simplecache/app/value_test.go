@@ -0,0 +1,138 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestApp_JSONValuesRoundTrip(t *testing.T) {
+	a, server := newDBTestServer(t, Config{Shards: 4})
+
+	values := map[string]string{
+		"id":  `9007199254740993`,
+		"f":   `1.50`,
+		"big": `123456789012345678901234567890`,
+		"obj": `{"z":1,"a":[1.0,{"y":null,"b":2e3}]}`,
+		"b":   `true`,
+		// Whitespace and the characters json.Marshal escapes for HTML.
+		"spaced": `{ "a": 1,
+	"s": "<b>&amp;</b>" }`,
+		"html": `["<", ">", "&"]`,
+	}
+	for key, value := range values {
+		if code := dbRequest(t, "POST", server.URL+"/set", "", `{"key":"`+key+`","value":`+value+`}`, nil); code != 201 {
+			t.Fatal("Can't set", key, code)
+		}
+		var raw json.RawMessage
+		dbRequest(t, "GET", server.URL+"/get/"+key, "", "", &raw)
+		if string(raw) != value {
+			t.Error("Value doesn't read back as it was written", key, string(raw))
+		}
+	}
+
+	doc := `{"z": 1, "a": [1.0, {"y": null, "b": 2}], "s": "<b>"}`
+	if code := dbRequest(t, "POST", server.URL+"/hset", "", `{"key":"h","value":{"doc":`+doc+`,"n":12345678901234567}}`, nil); code != 201 {
+		t.Fatal("Can't hset", code)
+	}
+	var raw json.RawMessage
+	dbRequest(t, "GET", server.URL+"/hget/h/doc", "", "", &raw)
+	if string(raw) != doc {
+		t.Error("Nested object doesn't survive a round trip", string(raw))
+	}
+	var fields map[string]json.RawMessage
+	dbRequest(t, "GET", server.URL+"/hgetall/h", "", "", &fields)
+	if string(fields["doc"]) != doc {
+		t.Error("Nested object isn't returned as written by hgetall", string(fields["doc"]))
+	}
+	var results []map[string]json.RawMessage
+	dbRequest(t, "POST", server.URL+"/tx", "", `[{"op":"get","args":{"key":"spaced"}}]`, &results)
+	if len(results) != 1 || string(results[0]["result"]) != values["spaced"] {
+		t.Error("Object isn't returned as written by a transaction", results)
+	}
+	dbRequest(t, "GET", server.URL+"/hget/h/n", "", "", &raw)
+	if string(raw) != `12345678901234567` {
+		t.Error("Large integer loses precision", string(raw))
+	}
+
+	var result map[string]json.RawMessage
+	dbRequest(t, "POST", server.URL+"/incrby", "", `{"key":"id","value":1}`, &result)
+	if string(result["value"]) != `9007199254740994` {
+		t.Error("Large integer isn't incremented exactly", string(result["value"]))
+	}
+
+	path := filepath.Join(t.TempDir(), "dump.json")
+	if err := a.cache.saveSnapshot(path); err != nil {
+		t.Fatal(err)
+	}
+	lc := NewCache(0)
+	if err := lc.loadSnapshot(path); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := lc.get("f"); v != json.Number("1.50") {
+		t.Error("Number isn't restored as it was written", v)
+	}
+	if v, _ := lc.get("obj"); !bytes.Equal(v.(json.RawMessage), []byte(values["obj"])) {
+		t.Error("Object isn't restored as it was written", v)
+	}
+	var compacted bytes.Buffer
+	json.Compact(&compacted, []byte(doc))
+	if v, _ := lc.hget("h", "doc"); !bytes.Equal(v.(json.RawMessage), compacted.Bytes()) {
+		t.Error("Nested object isn't restored as it was written", v)
+	}
+}
+
+func TestApp_ListValuesRoundTrip(t *testing.T) {
+	a, server := newDBTestServer(t, Config{Shards: 4})
+	aofPath := filepath.Join(t.TempDir(), "cache.aof")
+	if err := a.cache.enableAOF(aofPath, FsyncNever, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	requests := []struct{ path, body string }{
+		{"/rpush", `{"key":"l","values":[9007199254740993,"a",{"z":1,"a":2}]}`},
+		{"/lpush", `{"key":"l","value":1.50}`},
+		{"/lset", `{"key":"l","index":2,"value":9007199254740995}`},
+		{"/linsert", `{"key":"l","position":"after","pivot":9007199254740993,"value":18014398509481985}`},
+		{"/lrem", `{"key":"l","count":1,"value":9007199254740995}`},
+	}
+	for _, r := range requests {
+		if code := dbRequest(t, "POST", server.URL+r.path, "", r.body, nil); code != 201 && code != 200 {
+			t.Fatal("Request fails", r.path, code)
+		}
+	}
+
+	want := `[1.50,9007199254740993,18014398509481985,{"z":1,"a":2}]`
+	var raw json.RawMessage
+	dbRequest(t, "GET", server.URL+"/lgetall/l", "", "", &raw)
+	if string(raw) != want {
+		t.Error("List doesn't read back as it was written", string(raw))
+	}
+
+	values, _ := a.cache.lgetall("l")
+	if err := a.cache.disableAOF(); err != nil {
+		t.Fatal(err)
+	}
+
+	lc := NewCache(0)
+	if err := lc.replayAOF(aofPath); err != nil {
+		t.Fatal(err)
+	}
+	if replayed, _ := lc.lgetall("l"); !reflect.DeepEqual(replayed, values) {
+		t.Error("List isn't replayed as it was written", replayed)
+	}
+
+	path := filepath.Join(t.TempDir(), "dump.json")
+	if err := a.cache.saveSnapshot(path); err != nil {
+		t.Fatal(err)
+	}
+	sc := NewCache(0)
+	if err := sc.loadSnapshot(path); err != nil {
+		t.Fatal(err)
+	}
+	if loaded, _ := sc.lgetall("l"); !reflect.DeepEqual(loaded, values) {
+		t.Error("List isn't restored as it was written", loaded)
+	}
+}
@@ -0,0 +1,195 @@
+package app
+
+import (
+	"errors"
+)
+
+var errVersionMismatch = errors.New("version mismatch")
+
+type setFlags struct {
+	nx bool
+	xx bool
+}
+
+// setVersion gives the key a version restored from the log or a snapshot,
+// or a new one when version is zero.
+func (s *shard) setVersion(key string, version int64) {
+	i, found := s.items[key]
+	if !found {
+		return
+	}
+
+	if version == 0 {
+		version = s.c.lastVersion.Add(1)
+	}
+	for {
+		last := s.c.lastVersion.Load()
+		if last >= version || s.c.lastVersion.CompareAndSwap(last, version) {
+			break
+		}
+	}
+	i.getHeader().version = version
+}
+
+// setCond sets a string value only when the key doesn't exist with nx set,
+// or only when it exists with xx set. It reports whether the value was set
+// and returns the new version of the key.
+func (c *cache) setCond(key string, value interface{}, duration int, flags setFlags) (bool, int64, error) {
+	if flags.nx && flags.xx {
+		return false, 0, errors.New("nx and xx options at the same time are not compatible")
+	}
+
+	if err := c.ensureCapacity(key); err != nil {
+		return false, 0, err
+	}
+
+	e := c.expiration(duration)
+
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.setCond(key, value, e, flags)
+}
+
+func (s *shard) setCond(key string, value interface{}, e int64, flags setFlags) (bool, int64, error) {
+	item, found := s.items[key]
+	if found && s.isExpired(key, item) {
+		found = false
+	}
+
+	if flags.nx && found || flags.xx && !found {
+		return false, 0, nil
+	}
+
+	s.set(key, value, e)
+
+	return true, s.items[key].getHeader().version, nil
+}
+
+// cas sets a string value only when the key still has the expected
+// version and returns the new version. A zero version expects that the
+// key doesn't exist.
+func (c *cache) cas(key string, value interface{}, duration int, version int64) (int64, error) {
+	if err := c.ensureCapacity(key); err != nil {
+		return 0, err
+	}
+
+	e := c.expiration(duration)
+
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cas(key, value, e, version)
+}
+
+func (s *shard) cas(key string, value interface{}, e int64, version int64) (int64, error) {
+	var current int64
+	if item, found := s.items[key]; found && !s.isExpired(key, item) {
+		current = item.getHeader().version
+		if version == 0 {
+			return 0, errVersionMismatch
+		}
+	}
+
+	if current != version {
+		return 0, errVersionMismatch
+	}
+
+	s.set(key, value, e)
+
+	return s.items[key].getHeader().version, nil
+}
+
+// getset sets a string value and returns the old one, nil when there was
+// no such key.
+func (c *cache) getset(key string, value interface{}, duration int) (interface{}, int64, error) {
+	if err := c.ensureCapacity(key); err != nil {
+		return nil, 0, err
+	}
+
+	e := c.expiration(duration)
+
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.getset(key, value, e)
+}
+
+func (s *shard) getset(key string, value interface{}, e int64) (interface{}, int64, error) {
+	var old interface{}
+	if item, found := s.items[key]; found && !s.isExpired(key, item) {
+		si, ok := item.(*simpleItem)
+		if !ok {
+			return nil, 0, errors.New("wrong type")
+		}
+		old = si.object
+	}
+
+	s.set(key, value, e)
+
+	return old, s.items[key].getHeader().version, nil
+}
+
+// gets returns a string value with its version.
+func (c *cache) gets(key string) (interface{}, int64, error) {
+	s := c.getShard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.gets(key)
+}
+
+func (s *shard) gets(key string) (interface{}, int64, error) {
+	object, err := s.get(key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return object, s.items[key].getHeader().version, nil
+}
+
+// versions returns the versions of the keys read at the same moment, zero
+// for missing keys.
+func (c *cache) versions(keys []string) map[string]int64 {
+	shards := c.shardsOf(keys...)
+	rlockShards(shards)
+	defer runlockShards(shards)
+
+	versions := make(map[string]int64, len(keys))
+	for _, k := range keys {
+		versions[k] = c.getShard(k).currentVersion(k)
+	}
+
+	return versions
+}
+
+// currentVersion returns the version of the key, zero when there is no
+// such key or it has expired.
+func (s *shard) currentVersion(key string) int64 {
+	if i := s.lookupAny(key); i != nil {
+		return i.getHeader().version
+	}
+
+	return 0
+}
+
+// version returns the version of a key of any type.
+func (c *cache) version(key string) (int64, error) {
+	s := c.getShard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.version(key)
+}
+
+func (s *shard) version(key string) (int64, error) {
+	item, found := s.items[key]
+	if !found || s.isExpired(key, item) {
+		return 0, errors.New("not found")
+	}
+
+	return item.getHeader().version, nil
+}
@@ -0,0 +1,151 @@
+package app
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestCache_SetNXAndXX(t *testing.T) {
+	tc := NewCache(0)
+
+	if set, _, _ := tc.setCond("k", "a", 0, setFlags{xx: true}); set {
+		t.Error("Set with xx creates missing key")
+	}
+	if set, _, _ := tc.setCond("k", "a", 0, setFlags{nx: true}); !set {
+		t.Error("Set with nx doesn't create missing key")
+	}
+	if set, _, _ := tc.setCond("k", "b", 0, setFlags{nx: true}); set {
+		t.Error("Set with nx overwrites existing key")
+	}
+	if set, _, _ := tc.setCond("k", "c", 0, setFlags{xx: true}); !set {
+		t.Error("Set with xx doesn't overwrite existing key")
+	}
+	if v, _ := tc.get("k"); v != "c" {
+		t.Error("Value of k doesn't equals c", v)
+	}
+	if _, _, err := tc.setCond("k", "d", 0, setFlags{nx: true, xx: true}); err == nil {
+		t.Error("Set accepts nx and xx together")
+	}
+}
+
+func TestCache_Version(t *testing.T) {
+	tc := NewCache(0)
+
+	var last int64
+	changes := []func(){
+		func() { tc.set("k", "a", 0) },
+		func() { tc.set("k", "b", 0) },
+		func() { tc.deleteItem("k"); tc.rpush("k", "a", 0) },
+		func() { tc.rpush("k", "b", 0) },
+		func() { tc.lset("k", 0, "c") },
+		func() { tc.pop("k") },
+		func() { tc.deleteItem("k"); tc.hset("k", map[string]interface{}{"a": 1.0}, 0) },
+		func() { tc.hincrby("k", "a", 1) },
+		func() { tc.hdel("k", []string{"x", "a"}); tc.sadd("k", []string{"a", "b"}, 0) },
+		func() { tc.srem("k", []string{"a"}) },
+	}
+	for i, change := range changes {
+		change()
+		version, err := tc.version("k")
+		if err != nil || version <= last {
+			t.Error("Version doesn't grow after change", i, version, last, err)
+		}
+		last = version
+	}
+
+	if _, err := tc.version("missing"); err == nil {
+		t.Error("Found version of missing key")
+	}
+}
+
+func TestCache_Cas(t *testing.T) {
+	tc := NewCache(0)
+
+	version, err := tc.cas("k", "a", 0, 0)
+	if err != nil || version == 0 {
+		t.Error("Cas with zero version doesn't create missing key", version, err)
+	}
+	if _, err := tc.cas("k", "b", 0, 0); err != errVersionMismatch {
+		t.Error("Cas with zero version overwrites existing key", err)
+	}
+
+	v, current, _ := tc.gets("k")
+	if v != "a" || current != version {
+		t.Error("Gets doesn't return value and version", v, current, version)
+	}
+
+	next, err := tc.cas("k", "b", 0, version)
+	if err != nil || next <= version {
+		t.Error("Cas with current version fails", next, err)
+	}
+	if _, err := tc.cas("k", "c", 0, version); err != errVersionMismatch {
+		t.Error("Cas with old version succeeds", err)
+	}
+	if v, _ := tc.get("k"); v != "b" {
+		t.Error("Value of k doesn't equals b", v)
+	}
+}
+
+func TestCache_Getset(t *testing.T) {
+	tc := NewCache(0)
+
+	old, _, err := tc.getset("k", "a", 0)
+	if err != nil || old != nil {
+		t.Error("Getset of missing key doesn't return nil", old, err)
+	}
+	if old, _, _ := tc.getset("k", "b", 0); old != "a" {
+		t.Error("Getset doesn't return old value", old)
+	}
+	if v, _ := tc.get("k"); v != "b" {
+		t.Error("Getset doesn't set new value", v)
+	}
+
+	tc.rpush("l", "a", 0)
+	if _, _, err := tc.getset("l", "b", 0); err == nil {
+		t.Error("Getset of a list doesn't fail")
+	}
+}
+
+func TestCache_VersionPersistence(t *testing.T) {
+	dir := t.TempDir()
+	aofPath := filepath.Join(dir, "cache.aof")
+	snapshotPath := filepath.Join(dir, "dump.json")
+
+	tc := NewCache(0)
+	if err := tc.enableAOF(aofPath, FsyncNever, 0); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		tc.set("k", i, 0)
+		tc.rpush("l", i, 0)
+	}
+	tc.saveSnapshot(snapshotPath)
+	expected, _ := tc.version("k")
+	tc.disableAOF()
+
+	rewrittenPath := filepath.Join(dir, "rewritten.aof")
+	rc := NewCache(0)
+	rc.loadSnapshot(snapshotPath)
+	rc.enableAOF(rewrittenPath, FsyncNever, 0)
+	rc.disableAOF()
+
+	for _, load := range []func(*cache) error{
+		func(c *cache) error { return c.replayAOF(aofPath) },
+		func(c *cache) error { return c.loadSnapshot(snapshotPath) },
+		func(c *cache) error { return c.replayAOF(rewrittenPath) },
+	} {
+		lc := NewCache(0)
+		if err := load(lc); err != nil {
+			t.Fatal(err)
+		}
+
+		if version, _ := lc.version("k"); version != expected {
+			t.Error("Version isn't restored", version, expected)
+		}
+
+		lc.set("new", "a", 0)
+		if version, _ := lc.version("new"); version <= expected {
+			t.Error("Versions after restore don't grow", version, expected)
+		}
+	}
+}
@@ -0,0 +1,477 @@
+package app
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+type zsetItem struct {
+	header
+	dict map[string]float64
+	zsl  *skiplist
+}
+
+type zmember struct {
+	Member string  `json:"member"`
+	Score  float64 `json:"score"`
+}
+
+type zaddFlags struct {
+	nx   bool
+	xx   bool
+	incr bool
+}
+
+func newZsetItem(e int64) *zsetItem {
+	return &zsetItem{
+		header: header{expired: e},
+		dict:   map[string]float64{},
+		zsl:    newSkiplist(),
+	}
+}
+
+func sizeOfZmember(member string) int64 {
+	return 2*sizeOf(member) + 48
+}
+
+// add sets the score of the member and reports whether it is a new one.
+func (zi *zsetItem) add(member string, score float64) bool {
+	current, found := zi.dict[member]
+	if found {
+		if current != score {
+			zi.zsl.delete(current, member)
+			zi.zsl.insert(score, member)
+			zi.dict[member] = score
+		}
+		return false
+	}
+
+	zi.zsl.insert(score, member)
+	zi.dict[member] = score
+
+	return true
+}
+
+func (zi *zsetItem) remove(member string) bool {
+	score, found := zi.dict[member]
+	if !found {
+		return false
+	}
+
+	zi.zsl.delete(score, member)
+	delete(zi.dict, member)
+
+	return true
+}
+
+func (zi *zsetItem) members() []zmember {
+	members := make([]zmember, 0, zi.zsl.length)
+	for x := zi.zsl.header.level[0].forward; x != nil; x = x.level[0].forward {
+		members = append(members, zmember{Member: x.member, Score: x.score})
+	}
+
+	return members
+}
+
+// parseScoreBound parses a score range end as "1.5", "(1.5" for an
+// excluded end, "-inf" or "+inf".
+func parseScoreBound(s string) (float64, bool, error) {
+	exclusive := strings.HasPrefix(s, "(")
+	if exclusive {
+		s = s[1:]
+	}
+
+	var score float64
+	switch s {
+	case "-inf":
+		score = math.Inf(-1)
+	case "+inf", "inf":
+		score = math.Inf(1)
+	default:
+		var err error
+		score, err = strconv.ParseFloat(s, 64)
+		if err != nil || math.IsNaN(score) {
+			return 0, false, errors.New("min or max is not a float")
+		}
+	}
+
+	return score, exclusive, nil
+}
+
+func parseScoreRange(min string, max string) (scoreRange, error) {
+	var r scoreRange
+	var err error
+	if r.min, r.minEx, err = parseScoreBound(min); err != nil {
+		return r, err
+	}
+	if r.max, r.maxEx, err = parseScoreBound(max); err != nil {
+		return r, err
+	}
+
+	return r, nil
+}
+
+func validScore(score float64) bool {
+	return !math.IsNaN(score) && !math.IsInf(score, 0)
+}
+
+func (s *shard) lookupZset(key string) (*zsetItem, error) {
+	item, found := s.items[key]
+	if !found || s.isExpired(key, item) {
+		return nil, errors.New("not found")
+	}
+
+	zi, ok := item.(*zsetItem)
+	if !ok {
+		return nil, errors.New("wrong type")
+	}
+	zi.touch()
+
+	return zi, nil
+}
+
+// zadd adds members to the sorted set at key. With incr set it takes one
+// member, adds its score to the current one and returns the new score.
+// Otherwise it returns the number of added members.
+func (c *cache) zadd(key string, members []zmember, flags zaddFlags, duration int) (interface{}, error) {
+	if flags.nx && flags.xx {
+		return nil, errors.New("nx and xx options at the same time are not compatible")
+	}
+	if flags.incr && len(members) != 1 {
+		return nil, errors.New("incr option supports a single member")
+	}
+	for _, m := range members {
+		if !validScore(m.Score) {
+			return nil, errors.New("score is not a valid float")
+		}
+	}
+
+	if err := c.ensureCapacity(key); err != nil {
+		return nil, err
+	}
+
+	e := c.expiration(duration)
+
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.zadd(key, members, flags, e)
+}
+
+func (s *shard) zadd(key string, members []zmember, flags zaddFlags, e int64) (interface{}, error) {
+	item, found := s.items[key]
+	if found && s.isExpired(key, item) {
+		s.removeExpired(key)
+		found = false
+	}
+
+	var zi *zsetItem
+	if found {
+		var ok bool
+		if zi, ok = item.(*zsetItem); !ok {
+			return nil, errors.New("invalid object type")
+		}
+	} else {
+		if flags.xx {
+			if flags.incr {
+				return nil, nil
+			}
+			return 0, nil
+		}
+		zi = newZsetItem(e)
+	}
+
+	var (
+		added   int
+		delta   int64
+		changed []zmember
+	)
+	for _, m := range members {
+		current, exists := zi.dict[m.Member]
+		if exists && flags.nx || !exists && flags.xx {
+			continue
+		}
+
+		score := m.Score
+		if flags.incr && exists {
+			score += current
+			if !validScore(score) {
+				return nil, errors.New("resulting score is not a valid float")
+			}
+		}
+
+		if zi.add(m.Member, score) {
+			added++
+			delta += sizeOfZmember(m.Member)
+		}
+		changed = append(changed, zmember{Member: m.Member, Score: score})
+	}
+
+	if !found {
+		if len(changed) == 0 {
+			return 0, nil
+		}
+		s.store(key, zi)
+	} else {
+		if e > 0 {
+			zi.expired = e
+		}
+		s.grow(zi, delta)
+		zi.touch()
+	}
+
+	if len(changed) > 0 {
+		s.c.feed("zadd", key, changed, zi.expired)
+	}
+
+	if flags.incr {
+		if len(changed) == 0 {
+			return nil, nil
+		}
+		return changed[0].Score, nil
+	}
+
+	return added, nil
+}
+
+func (c *cache) zrem(key string, members []string) (int, error) {
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.zrem(key, members)
+}
+
+func (s *shard) zrem(key string, members []string) (int, error) {
+	zi, err := s.lookupZset(key)
+	if err != nil {
+		return 0, err
+	}
+
+	var removed []string
+	for _, m := range members {
+		if zi.remove(m) {
+			removed = append(removed, m)
+			s.grow(zi, -sizeOfZmember(m))
+		}
+	}
+
+	if len(removed) > 0 {
+		s.c.feed("zrem", key, removed, 0)
+	}
+	if len(zi.dict) == 0 {
+		s.remove(key)
+	}
+
+	return len(removed), nil
+}
+
+func (c *cache) zscore(key string, member string) (float64, error) {
+	s := c.getShard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.zscore(key, member)
+}
+
+func (s *shard) zscore(key string, member string) (float64, error) {
+	zi, err := s.lookupZset(key)
+	if err != nil {
+		return 0, err
+	}
+
+	score, found := zi.dict[member]
+	if !found {
+		return 0, errors.New("not found")
+	}
+
+	return score, nil
+}
+
+// zrank returns the 0-based rank of the member, ordered from the lowest
+// score or from the highest one when reverse is set.
+func (c *cache) zrank(key string, member string, reverse bool) (int, error) {
+	s := c.getShard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.zrank(key, member, reverse)
+}
+
+func (s *shard) zrank(key string, member string, reverse bool) (int, error) {
+	zi, err := s.lookupZset(key)
+	if err != nil {
+		return 0, err
+	}
+
+	score, found := zi.dict[member]
+	if !found {
+		return 0, errors.New("not found")
+	}
+
+	rank := zi.zsl.rank(score, member)
+	if reverse {
+		return zi.zsl.length - rank, nil
+	}
+
+	return rank - 1, nil
+}
+
+// zrange returns members by rank from start to stop inclusive. Negative
+// indexes count from the end.
+func (c *cache) zrange(key string, start int, stop int, reverse bool) ([]zmember, error) {
+	s := c.getShard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.zrange(key, start, stop, reverse)
+}
+
+func (s *shard) zrange(key string, start int, stop int, reverse bool) ([]zmember, error) {
+	zi, err := s.lookupZset(key)
+	if err != nil {
+		return nil, err
+	}
+
+	length := zi.zsl.length
+	start, stop = normalizeRange(start, stop, length)
+
+	result := []zmember{}
+	if start > stop {
+		return result, nil
+	}
+
+	var x *skiplistNode
+	if reverse {
+		x = zi.zsl.byRank(length - start)
+	} else {
+		x = zi.zsl.byRank(start + 1)
+	}
+
+	for n := stop - start + 1; n > 0 && x != nil; n-- {
+		result = append(result, zmember{Member: x.member, Score: x.score})
+		if reverse {
+			x = x.backward
+		} else {
+			x = x.level[0].forward
+		}
+	}
+
+	return result, nil
+}
+
+// zrangeByScore returns members with scores in the range. Offset members
+// are skipped and at most count are returned, a negative count returns all
+// of them. With reverse set members go from the highest score.
+func (c *cache) zrangeByScore(key string, r scoreRange, offset int, count int, reverse bool) ([]zmember, error) {
+	s := c.getShard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.zrangeByScore(key, r, offset, count, reverse)
+}
+
+func (s *shard) zrangeByScore(key string, r scoreRange, offset int, count int, reverse bool) ([]zmember, error) {
+	zi, err := s.lookupZset(key)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []zmember{}
+
+	var x *skiplistNode
+	if reverse {
+		x = zi.zsl.lastInRange(r)
+	} else {
+		x = zi.zsl.firstInRange(r)
+	}
+
+	for ; x != nil && offset > 0; offset-- {
+		if reverse {
+			x = x.backward
+		} else {
+			x = x.level[0].forward
+		}
+	}
+
+	for x != nil && count != 0 {
+		if reverse && !r.aboveMin(x.score) || !reverse && !r.belowMax(x.score) {
+			break
+		}
+		result = append(result, zmember{Member: x.member, Score: x.score})
+		count--
+		if reverse {
+			x = x.backward
+		} else {
+			x = x.level[0].forward
+		}
+	}
+
+	return result, nil
+}
+
+func (c *cache) zcount(key string, r scoreRange) (int, error) {
+	s := c.getShard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.zcount(key, r)
+}
+
+func (s *shard) zcount(key string, r scoreRange) (int, error) {
+	zi, err := s.lookupZset(key)
+	if err != nil {
+		return 0, err
+	}
+
+	first := zi.zsl.firstInRange(r)
+	if first == nil {
+		return 0, nil
+	}
+	last := zi.zsl.lastInRange(r)
+
+	return zi.zsl.rank(last.score, last.member) - zi.zsl.rank(first.score, first.member) + 1, nil
+}
+
+// zpop removes and returns up to count members with the lowest scores, or
+// with the highest ones when max is set.
+func (c *cache) zpop(key string, count int, max bool) ([]zmember, error) {
+	s := c.getShard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.zpop(key, count, max)
+}
+
+func (s *shard) zpop(key string, count int, max bool) ([]zmember, error) {
+	zi, err := s.lookupZset(key)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []zmember{}
+	var removed []string
+	for ; count > 0 && zi.zsl.length > 0; count-- {
+		x := zi.zsl.header.level[0].forward
+		if max {
+			x = zi.zsl.tail
+		}
+
+		result = append(result, zmember{Member: x.member, Score: x.score})
+		removed = append(removed, x.member)
+		zi.remove(x.member)
+		s.grow(zi, -sizeOfZmember(x.member))
+	}
+
+	if len(removed) > 0 {
+		s.c.feed("zrem", key, removed, 0)
+	}
+	if len(zi.dict) == 0 {
+		s.remove(key)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,253 @@
+package app
+
+import (
+	"math/rand"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSkiplist_Rank(t *testing.T) {
+	zi := newZsetItem(0)
+	scores := map[string]float64{}
+	for i := 0; i < 1000; i++ {
+		m := strconv.Itoa(rand.Intn(300))
+		score := float64(rand.Intn(50))
+		if rand.Intn(4) == 0 {
+			zi.remove(m)
+			delete(scores, m)
+			continue
+		}
+		zi.add(m, score)
+		scores[m] = score
+	}
+
+	var expected []zmember
+	for m, s := range scores {
+		expected = append(expected, zmember{Member: m, Score: s})
+	}
+	sort.Slice(expected, func(i, j int) bool {
+		return expected[i].Score < expected[j].Score ||
+			expected[i].Score == expected[j].Score && expected[i].Member < expected[j].Member
+	})
+
+	if zi.zsl.length != len(expected) {
+		t.Fatal("Length of skiplist doesn't match", zi.zsl.length, len(expected))
+	}
+
+	for i, m := range expected {
+		if rank := zi.zsl.rank(m.Score, m.Member); rank != i+1 {
+			t.Error("Wrong rank of member", m, rank, i+1)
+		}
+		if x := zi.zsl.byRank(i + 1); x == nil || x.member != m.Member {
+			t.Error("Wrong member by rank", i+1, x)
+		}
+	}
+}
+
+func TestCache_Zadd(t *testing.T) {
+	tc := NewCache(0)
+	key := "z"
+
+	added, err := tc.zadd(key, []zmember{{"a", 1}, {"b", 2}, {"c", 3}}, zaddFlags{}, 0)
+	if err != nil || added != 3 {
+		t.Error("Zadd doesn't add 3 members", added, err)
+	}
+
+	added, _ = tc.zadd(key, []zmember{{"a", 10}, {"d", 4}}, zaddFlags{nx: true}, 0)
+	if added != 1 {
+		t.Error("Zadd with nx doesn't add only the new member", added)
+	}
+	if score, _ := tc.zscore(key, "a"); score != 1 {
+		t.Error("Zadd with nx updated existing member", score)
+	}
+
+	added, _ = tc.zadd(key, []zmember{{"a", 10}, {"e", 5}}, zaddFlags{xx: true}, 0)
+	if added != 0 {
+		t.Error("Zadd with xx added a member", added)
+	}
+	if score, _ := tc.zscore(key, "a"); score != 10 {
+		t.Error("Zadd with xx doesn't update existing member", score)
+	}
+	if _, err := tc.zscore(key, "e"); err == nil {
+		t.Error("Found member e added with xx")
+	}
+
+	score, err := tc.zadd(key, []zmember{{"b", 2.5}}, zaddFlags{incr: true}, 0)
+	if err != nil || score != 4.5 {
+		t.Error("Zadd with incr doesn't return new score", score, err)
+	}
+
+	score, _ = tc.zadd(key, []zmember{{"x", 1}}, zaddFlags{incr: true, xx: true}, 0)
+	if score != nil {
+		t.Error("Zadd with incr and xx of missing member returns score", score)
+	}
+
+	if _, err := tc.zadd(key, []zmember{{"a", 1}}, zaddFlags{nx: true, xx: true}, 0); err == nil {
+		t.Error("Zadd accepts nx and xx together")
+	}
+
+	tc.set("s", "string", 0)
+	if _, err := tc.zadd("s", []zmember{{"a", 1}}, zaddFlags{}, 0); err == nil {
+		t.Error("Zadd to a string doesn't fail")
+	}
+}
+
+func TestCache_ZrangeAndRank(t *testing.T) {
+	tc := NewCache(0)
+	key := "z"
+	tc.zadd(key, []zmember{{"a", 1}, {"b", 2}, {"c", 3}, {"d", 4}, {"e", 5}}, zaddFlags{}, 0)
+
+	members, _ := tc.zrange(key, 0, -1, false)
+	if len(members) != 5 || members[0].Member != "a" || members[4].Member != "e" {
+		t.Error("Zrange 0 -1 doesn't return all members in order", members)
+	}
+
+	members, _ = tc.zrange(key, -2, -1, false)
+	if len(members) != 2 || members[0].Member != "d" || members[1].Member != "e" {
+		t.Error("Zrange with negative indexes is wrong", members)
+	}
+
+	members, _ = tc.zrange(key, 0, 1, true)
+	if len(members) != 2 || members[0].Member != "e" || members[1].Member != "d" {
+		t.Error("Reverse zrange is wrong", members)
+	}
+
+	members, _ = tc.zrange(key, 10, 20, false)
+	if len(members) != 0 {
+		t.Error("Zrange out of range isn't empty", members)
+	}
+
+	if rank, _ := tc.zrank(key, "b", false); rank != 1 {
+		t.Error("Rank of b doesn't equals 1", rank)
+	}
+	if rank, _ := tc.zrank(key, "b", true); rank != 3 {
+		t.Error("Reverse rank of b doesn't equals 3", rank)
+	}
+	if _, err := tc.zrank(key, "x", false); err == nil {
+		t.Error("Found rank of missing member")
+	}
+}
+
+func TestCache_ZrangeByScore(t *testing.T) {
+	tc := NewCache(0)
+	key := "z"
+	tc.zadd(key, []zmember{{"a", 1}, {"b", 2}, {"c", 3}, {"d", 4}, {"e", 5}}, zaddFlags{}, 0)
+
+	r, _ := parseScoreRange("(1", "4")
+	members, _ := tc.zrangeByScore(key, r, 0, -1, false)
+	if len(members) != 3 || members[0].Member != "b" || members[2].Member != "d" {
+		t.Error("Zrangebyscore (1 4 is wrong", members)
+	}
+
+	members, _ = tc.zrangeByScore(key, r, 1, 1, false)
+	if len(members) != 1 || members[0].Member != "c" {
+		t.Error("Zrangebyscore with offset and count is wrong", members)
+	}
+
+	members, _ = tc.zrangeByScore(key, r, 0, 2, true)
+	if len(members) != 2 || members[0].Member != "d" || members[1].Member != "c" {
+		t.Error("Reverse zrangebyscore is wrong", members)
+	}
+
+	r, _ = parseScoreRange("-inf", "+inf")
+	if count, _ := tc.zcount(key, r); count != 5 {
+		t.Error("Zcount -inf +inf doesn't equals 5", count)
+	}
+
+	r, _ = parseScoreRange("2", "(5")
+	if count, _ := tc.zcount(key, r); count != 3 {
+		t.Error("Zcount 2 (5 doesn't equals 3", count)
+	}
+
+	r, _ = parseScoreRange("6", "10")
+	if count, _ := tc.zcount(key, r); count != 0 {
+		t.Error("Zcount 6 10 doesn't equals 0", count)
+	}
+
+	if _, err := parseScoreRange("a", "1"); err == nil {
+		t.Error("Invalid score range is parsed")
+	}
+}
+
+func TestCache_ZremAndPop(t *testing.T) {
+	tc := NewCache(0)
+	key := "z"
+	tc.zadd(key, []zmember{{"a", 1}, {"b", 2}, {"c", 3}, {"d", 4}}, zaddFlags{}, 0)
+
+	if removed, _ := tc.zrem(key, []string{"a", "x"}); removed != 1 {
+		t.Error("Zrem doesn't remove 1 member", removed)
+	}
+
+	members, _ := tc.zpop(key, 1, false)
+	if len(members) != 1 || members[0].Member != "b" {
+		t.Error("Zpopmin doesn't return b", members)
+	}
+
+	members, _ = tc.zpop(key, 5, true)
+	if len(members) != 2 || members[0].Member != "d" || members[1].Member != "c" {
+		t.Error("Zpopmax doesn't return d and c", members)
+	}
+
+	if _, err := tc.zrange(key, 0, -1, false); err == nil {
+		t.Error("Empty sorted set wasn't deleted")
+	}
+	if tc.stats().UsedMemory != 0 {
+		t.Error("Memory of deleted sorted set isn't released", tc.stats().UsedMemory)
+	}
+}
+
+func TestCache_ZsetExpired(t *testing.T) {
+	tc := NewCache(time.Duration(time.Hour))
+	tc.ExpiredTimeMultiplier = time.Millisecond
+	key := "z"
+	tc.zadd(key, []zmember{{"a", 1}}, zaddFlags{}, 10)
+
+	<-time.After(20 * time.Millisecond)
+
+	if _, err := tc.zscore(key, "a"); err == nil {
+		t.Error("Found member of expired sorted set")
+	}
+
+	added, _ := tc.zadd(key, []zmember{{"b", 1}}, zaddFlags{}, 0)
+	if added != 1 {
+		t.Error("Zadd to expired sorted set doesn't create a new one", added)
+	}
+	if members, _ := tc.zrange(key, 0, -1, false); len(members) != 1 {
+		t.Error("Members of expired sorted set are still there", members)
+	}
+}
+
+func TestCache_ZsetPersistence(t *testing.T) {
+	dir := t.TempDir()
+	aofPath := filepath.Join(dir, "cache.aof")
+	snapshotPath := filepath.Join(dir, "dump.json")
+
+	tc := NewCache(0)
+	if err := tc.enableAOF(aofPath, FsyncNever, 0); err != nil {
+		t.Fatal(err)
+	}
+	tc.zadd("z", []zmember{{"a", 1}, {"b", 2}, {"c", 3}}, zaddFlags{}, 0)
+	tc.zadd("z", []zmember{{"a", 5}}, zaddFlags{incr: true}, 0)
+	tc.zrem("z", []string{"b"})
+	tc.zpop("z", 1, false)
+	tc.saveSnapshot(snapshotPath)
+	tc.disableAOF()
+
+	for _, load := range []func(*cache) error{
+		func(c *cache) error { return c.replayAOF(aofPath) },
+		func(c *cache) error { return c.loadSnapshot(snapshotPath) },
+	} {
+		lc := NewCache(0)
+		if err := load(lc); err != nil {
+			t.Fatal(err)
+		}
+
+		members, _ := lc.zrange("z", 0, -1, false)
+		if len(members) != 1 || members[0].Member != "a" || members[0].Score != 6 {
+			t.Error("Sorted set isn't restored", members)
+		}
+	}
+}
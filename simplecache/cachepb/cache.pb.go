@@ -0,0 +1,11366 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: cache.proto
+
+package cachepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Value is a value of a key, a list element or a field, which can be any
+// JSON value. Numbers keep their decimal text, so they don't lose
+// precision, and objects and arrays are their JSON text. A value without
+// a kind is null.
+type Value struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Kind:
+	//
+	//	*Value_NullValue
+	//	*Value_BoolValue
+	//	*Value_NumberValue
+	//	*Value_StringValue
+	//	*Value_JsonValue
+	Kind          isValue_Kind `protobuf_oneof:"kind"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Value) Reset() {
+	*x = Value{}
+	mi := &file_cache_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value) ProtoMessage() {}
+
+func (x *Value) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value.ProtoReflect.Descriptor instead.
+func (*Value) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Value) GetKind() isValue_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *Value) GetNullValue() bool {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_NullValue); ok {
+			return x.NullValue
+		}
+	}
+	return false
+}
+
+func (x *Value) GetBoolValue() bool {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return false
+}
+
+func (x *Value) GetNumberValue() string {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_NumberValue); ok {
+			return x.NumberValue
+		}
+	}
+	return ""
+}
+
+func (x *Value) GetStringValue() string {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_StringValue); ok {
+			return x.StringValue
+		}
+	}
+	return ""
+}
+
+func (x *Value) GetJsonValue() []byte {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_JsonValue); ok {
+			return x.JsonValue
+		}
+	}
+	return nil
+}
+
+type isValue_Kind interface {
+	isValue_Kind()
+}
+
+type Value_NullValue struct {
+	NullValue bool `protobuf:"varint,1,opt,name=null_value,json=nullValue,proto3,oneof"`
+}
+
+type Value_BoolValue struct {
+	BoolValue bool `protobuf:"varint,2,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type Value_NumberValue struct {
+	NumberValue string `protobuf:"bytes,3,opt,name=number_value,json=numberValue,proto3,oneof"`
+}
+
+type Value_StringValue struct {
+	StringValue string `protobuf:"bytes,4,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type Value_JsonValue struct {
+	JsonValue []byte `protobuf:"bytes,5,opt,name=json_value,json=jsonValue,proto3,oneof"`
+}
+
+func (*Value_NullValue) isValue_Kind() {}
+
+func (*Value_BoolValue) isValue_Kind() {}
+
+func (*Value_NumberValue) isValue_Kind() {}
+
+func (*Value_StringValue) isValue_Kind() {}
+
+func (*Value_JsonValue) isValue_Kind() {}
+
+type GetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRequest) Reset() {
+	*x = GetRequest{}
+	mi := &file_cache_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequest) ProtoMessage() {}
+
+func (x *GetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *Value                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResponse) Reset() {
+	*x = GetResponse{}
+	mi := &file_cache_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResponse) ProtoMessage() {}
+
+func (x *GetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResponse.ProtoReflect.Descriptor instead.
+func (*GetResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetResponse) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type GetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetsRequest) Reset() {
+	*x = GetsRequest{}
+	mi := &file_cache_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetsRequest) ProtoMessage() {}
+
+func (x *GetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetsRequest.ProtoReflect.Descriptor instead.
+func (*GetsRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetsRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *Value                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetsResponse) Reset() {
+	*x = GetsResponse{}
+	mi := &file_cache_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetsResponse) ProtoMessage() {}
+
+func (x *GetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetsResponse.ProtoReflect.Descriptor instead.
+func (*GetsResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetsResponse) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *GetsResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type VersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VersionRequest) Reset() {
+	*x = VersionRequest{}
+	mi := &file_cache_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionRequest) ProtoMessage() {}
+
+func (x *VersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionRequest.ProtoReflect.Descriptor instead.
+func (*VersionRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VersionRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type VersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int64                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VersionResponse) Reset() {
+	*x = VersionResponse{}
+	mi := &file_cache_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionResponse) ProtoMessage() {}
+
+func (x *VersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
+func (*VersionResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *VersionResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type VersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VersionsRequest) Reset() {
+	*x = VersionsRequest{}
+	mi := &file_cache_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionsRequest) ProtoMessage() {}
+
+func (x *VersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionsRequest.ProtoReflect.Descriptor instead.
+func (*VersionsRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *VersionsRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type VersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      map[string]int64       `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VersionsResponse) Reset() {
+	*x = VersionsResponse{}
+	mi := &file_cache_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionsResponse) ProtoMessage() {}
+
+func (x *VersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionsResponse.ProtoReflect.Descriptor instead.
+func (*VersionsResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *VersionsResponse) GetVersions() map[string]int64 {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type SetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value *Value                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// expired is the TTL in seconds, none when it's 0.
+	Expired       int64 `protobuf:"varint,3,opt,name=expired,proto3" json:"expired,omitempty"`
+	Nx            bool  `protobuf:"varint,4,opt,name=nx,proto3" json:"nx,omitempty"`
+	Xx            bool  `protobuf:"varint,5,opt,name=xx,proto3" json:"xx,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRequest) Reset() {
+	*x = SetRequest{}
+	mi := &file_cache_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRequest) ProtoMessage() {}
+
+func (x *SetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRequest.ProtoReflect.Descriptor instead.
+func (*SetRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetRequest) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *SetRequest) GetExpired() int64 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+func (x *SetRequest) GetNx() bool {
+	if x != nil {
+		return x.Nx
+	}
+	return false
+}
+
+func (x *SetRequest) GetXx() bool {
+	if x != nil {
+		return x.Xx
+	}
+	return false
+}
+
+type SetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// set is false when nx or xx prevented the write.
+	Set           bool  `protobuf:"varint,1,opt,name=set,proto3" json:"set,omitempty"`
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetResponse) Reset() {
+	*x = SetResponse{}
+	mi := &file_cache_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetResponse) ProtoMessage() {}
+
+func (x *SetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetResponse.ProtoReflect.Descriptor instead.
+func (*SetResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetResponse) GetSet() bool {
+	if x != nil {
+		return x.Set
+	}
+	return false
+}
+
+func (x *SetResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetSetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         *Value                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Expired       int64                  `protobuf:"varint,3,opt,name=expired,proto3" json:"expired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSetRequest) Reset() {
+	*x = GetSetRequest{}
+	mi := &file_cache_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSetRequest) ProtoMessage() {}
+
+func (x *GetSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSetRequest.ProtoReflect.Descriptor instead.
+func (*GetSetRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetSetRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GetSetRequest) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *GetSetRequest) GetExpired() int64 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+type GetSetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// value is the old value.
+	Value         *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Version       int64  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSetResponse) Reset() {
+	*x = GetSetResponse{}
+	mi := &file_cache_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSetResponse) ProtoMessage() {}
+
+func (x *GetSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSetResponse.ProtoReflect.Descriptor instead.
+func (*GetSetResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetSetResponse) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *GetSetResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type CasRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Key     string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value   *Value                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Expired int64                  `protobuf:"varint,3,opt,name=expired,proto3" json:"expired,omitempty"`
+	// version is the version the key must still have, 0 for a missing key.
+	Version       int64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CasRequest) Reset() {
+	*x = CasRequest{}
+	mi := &file_cache_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CasRequest) ProtoMessage() {}
+
+func (x *CasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CasRequest.ProtoReflect.Descriptor instead.
+func (*CasRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CasRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CasRequest) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *CasRequest) GetExpired() int64 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+func (x *CasRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type CasResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int64                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CasResponse) Reset() {
+	*x = CasResponse{}
+	mi := &file_cache_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CasResponse) ProtoMessage() {}
+
+func (x *CasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CasResponse.ProtoReflect.Descriptor instead.
+func (*CasResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CasResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type IncrRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Key     string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Expired int64                  `protobuf:"varint,2,opt,name=expired,proto3" json:"expired,omitempty"`
+	// refresh applies expired on every increment, not only when the key is
+	// created.
+	Refresh       bool `protobuf:"varint,3,opt,name=refresh,proto3" json:"refresh,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrRequest) Reset() {
+	*x = IncrRequest{}
+	mi := &file_cache_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrRequest) ProtoMessage() {}
+
+func (x *IncrRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrRequest.ProtoReflect.Descriptor instead.
+func (*IncrRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *IncrRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *IncrRequest) GetExpired() int64 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+func (x *IncrRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
+type IncrResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         int64                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrResponse) Reset() {
+	*x = IncrResponse{}
+	mi := &file_cache_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrResponse) ProtoMessage() {}
+
+func (x *IncrResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrResponse.ProtoReflect.Descriptor instead.
+func (*IncrResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *IncrResponse) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type DecrRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Expired       int64                  `protobuf:"varint,2,opt,name=expired,proto3" json:"expired,omitempty"`
+	Refresh       bool                   `protobuf:"varint,3,opt,name=refresh,proto3" json:"refresh,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecrRequest) Reset() {
+	*x = DecrRequest{}
+	mi := &file_cache_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecrRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecrRequest) ProtoMessage() {}
+
+func (x *DecrRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecrRequest.ProtoReflect.Descriptor instead.
+func (*DecrRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DecrRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DecrRequest) GetExpired() int64 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+func (x *DecrRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
+type DecrResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         int64                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecrResponse) Reset() {
+	*x = DecrResponse{}
+	mi := &file_cache_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecrResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecrResponse) ProtoMessage() {}
+
+func (x *DecrResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecrResponse.ProtoReflect.Descriptor instead.
+func (*DecrResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DecrResponse) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type IncrByRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         int64                  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	Expired       int64                  `protobuf:"varint,3,opt,name=expired,proto3" json:"expired,omitempty"`
+	Refresh       bool                   `protobuf:"varint,4,opt,name=refresh,proto3" json:"refresh,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrByRequest) Reset() {
+	*x = IncrByRequest{}
+	mi := &file_cache_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrByRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrByRequest) ProtoMessage() {}
+
+func (x *IncrByRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrByRequest.ProtoReflect.Descriptor instead.
+func (*IncrByRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *IncrByRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *IncrByRequest) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *IncrByRequest) GetExpired() int64 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+func (x *IncrByRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
+type IncrByResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         int64                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrByResponse) Reset() {
+	*x = IncrByResponse{}
+	mi := &file_cache_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrByResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrByResponse) ProtoMessage() {}
+
+func (x *IncrByResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrByResponse.ProtoReflect.Descriptor instead.
+func (*IncrByResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *IncrByResponse) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type IncrByFloatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	Expired       int64                  `protobuf:"varint,3,opt,name=expired,proto3" json:"expired,omitempty"`
+	Refresh       bool                   `protobuf:"varint,4,opt,name=refresh,proto3" json:"refresh,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrByFloatRequest) Reset() {
+	*x = IncrByFloatRequest{}
+	mi := &file_cache_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrByFloatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrByFloatRequest) ProtoMessage() {}
+
+func (x *IncrByFloatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrByFloatRequest.ProtoReflect.Descriptor instead.
+func (*IncrByFloatRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *IncrByFloatRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *IncrByFloatRequest) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *IncrByFloatRequest) GetExpired() int64 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+func (x *IncrByFloatRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
+type IncrByFloatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrByFloatResponse) Reset() {
+	*x = IncrByFloatResponse{}
+	mi := &file_cache_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrByFloatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrByFloatResponse) ProtoMessage() {}
+
+func (x *IncrByFloatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrByFloatResponse.ProtoReflect.Descriptor instead.
+func (*IncrByFloatResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *IncrByFloatResponse) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type UnsetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsetRequest) Reset() {
+	*x = UnsetRequest{}
+	mi := &file_cache_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsetRequest) ProtoMessage() {}
+
+func (x *UnsetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsetRequest.ProtoReflect.Descriptor instead.
+func (*UnsetRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UnsetRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type UnsetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsetResponse) Reset() {
+	*x = UnsetResponse{}
+	mi := &file_cache_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsetResponse) ProtoMessage() {}
+
+func (x *UnsetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsetResponse.ProtoReflect.Descriptor instead.
+func (*UnsetResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{24}
+}
+
+type ExpireRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// The TTL is seconds plus milliseconds.
+	Seconds       int64 `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	Milliseconds  int64 `protobuf:"varint,3,opt,name=milliseconds,proto3" json:"milliseconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpireRequest) Reset() {
+	*x = ExpireRequest{}
+	mi := &file_cache_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpireRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireRequest) ProtoMessage() {}
+
+func (x *ExpireRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireRequest.ProtoReflect.Descriptor instead.
+func (*ExpireRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ExpireRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ExpireRequest) GetSeconds() int64 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+func (x *ExpireRequest) GetMilliseconds() int64 {
+	if x != nil {
+		return x.Milliseconds
+	}
+	return 0
+}
+
+type ExpireResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpireResponse) Reset() {
+	*x = ExpireResponse{}
+	mi := &file_cache_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpireResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireResponse) ProtoMessage() {}
+
+func (x *ExpireResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireResponse.ProtoReflect.Descriptor instead.
+func (*ExpireResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{26}
+}
+
+type ExpireAtRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// timestamp is a unix time in seconds, timestamp_ms one in milliseconds
+	// used instead when it's set.
+	Timestamp     int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	TimestampMs   int64 `protobuf:"varint,3,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpireAtRequest) Reset() {
+	*x = ExpireAtRequest{}
+	mi := &file_cache_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpireAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireAtRequest) ProtoMessage() {}
+
+func (x *ExpireAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireAtRequest.ProtoReflect.Descriptor instead.
+func (*ExpireAtRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExpireAtRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ExpireAtRequest) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ExpireAtRequest) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+type ExpireAtResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpireAtResponse) Reset() {
+	*x = ExpireAtResponse{}
+	mi := &file_cache_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpireAtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireAtResponse) ProtoMessage() {}
+
+func (x *ExpireAtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireAtResponse.ProtoReflect.Descriptor instead.
+func (*ExpireAtResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{28}
+}
+
+type PersistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersistRequest) Reset() {
+	*x = PersistRequest{}
+	mi := &file_cache_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistRequest) ProtoMessage() {}
+
+func (x *PersistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistRequest.ProtoReflect.Descriptor instead.
+func (*PersistRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PersistRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type PersistResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// persisted is false when the key had no TTL.
+	Persisted     bool `protobuf:"varint,1,opt,name=persisted,proto3" json:"persisted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersistResponse) Reset() {
+	*x = PersistResponse{}
+	mi := &file_cache_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistResponse) ProtoMessage() {}
+
+func (x *PersistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistResponse.ProtoReflect.Descriptor instead.
+func (*PersistResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PersistResponse) GetPersisted() bool {
+	if x != nil {
+		return x.Persisted
+	}
+	return false
+}
+
+type TtlRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TtlRequest) Reset() {
+	*x = TtlRequest{}
+	mi := &file_cache_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TtlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TtlRequest) ProtoMessage() {}
+
+func (x *TtlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TtlRequest.ProtoReflect.Descriptor instead.
+func (*TtlRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TtlRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type TtlResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ttl is in seconds, -1 when the key has none.
+	Ttl           int64 `protobuf:"varint,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TtlResponse) Reset() {
+	*x = TtlResponse{}
+	mi := &file_cache_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TtlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TtlResponse) ProtoMessage() {}
+
+func (x *TtlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TtlResponse.ProtoReflect.Descriptor instead.
+func (*TtlResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *TtlResponse) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type PttlRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PttlRequest) Reset() {
+	*x = PttlRequest{}
+	mi := &file_cache_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PttlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PttlRequest) ProtoMessage() {}
+
+func (x *PttlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PttlRequest.ProtoReflect.Descriptor instead.
+func (*PttlRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PttlRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type PttlResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ttl is in milliseconds, -1 when the key has none.
+	Ttl           int64 `protobuf:"varint,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PttlResponse) Reset() {
+	*x = PttlResponse{}
+	mi := &file_cache_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PttlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PttlResponse) ProtoMessage() {}
+
+func (x *PttlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PttlResponse.ProtoReflect.Descriptor instead.
+func (*PttlResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PttlResponse) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type KeysRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// pattern is a glob, all keys match when it's empty.
+	Pattern       string `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeysRequest) Reset() {
+	*x = KeysRequest{}
+	mi := &file_cache_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeysRequest) ProtoMessage() {}
+
+func (x *KeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeysRequest.ProtoReflect.Descriptor instead.
+func (*KeysRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *KeysRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+type KeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeysResponse) Reset() {
+	*x = KeysResponse{}
+	mi := &file_cache_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeysResponse) ProtoMessage() {}
+
+func (x *KeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeysResponse.ProtoReflect.Descriptor instead.
+func (*KeysResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *KeysResponse) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type ScanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Match         string                 `protobuf:"bytes,3,opt,name=match,proto3" json:"match,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanRequest) Reset() {
+	*x = ScanRequest{}
+	mi := &file_cache_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanRequest) ProtoMessage() {}
+
+func (x *ScanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanRequest.ProtoReflect.Descriptor instead.
+func (*ScanRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ScanRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ScanRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ScanRequest) GetMatch() string {
+	if x != nil {
+		return x.Match
+	}
+	return ""
+}
+
+func (x *ScanRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type ScanResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// cursor is "0" when the scan is complete.
+	Cursor        string   `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Keys          []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanResponse) Reset() {
+	*x = ScanResponse{}
+	mi := &file_cache_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanResponse) ProtoMessage() {}
+
+func (x *ScanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanResponse.ProtoReflect.Descriptor instead.
+func (*ScanResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ScanResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ScanResponse) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type RpushRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Values        []*Value               `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	Expired       int64                  `protobuf:"varint,3,opt,name=expired,proto3" json:"expired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RpushRequest) Reset() {
+	*x = RpushRequest{}
+	mi := &file_cache_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RpushRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpushRequest) ProtoMessage() {}
+
+func (x *RpushRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpushRequest.ProtoReflect.Descriptor instead.
+func (*RpushRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RpushRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RpushRequest) GetValues() []*Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *RpushRequest) GetExpired() int64 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+type RpushResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Length        int64                  `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RpushResponse) Reset() {
+	*x = RpushResponse{}
+	mi := &file_cache_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RpushResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpushResponse) ProtoMessage() {}
+
+func (x *RpushResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpushResponse.ProtoReflect.Descriptor instead.
+func (*RpushResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RpushResponse) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type LpushRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Values        []*Value               `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	Expired       int64                  `protobuf:"varint,3,opt,name=expired,proto3" json:"expired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LpushRequest) Reset() {
+	*x = LpushRequest{}
+	mi := &file_cache_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LpushRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LpushRequest) ProtoMessage() {}
+
+func (x *LpushRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LpushRequest.ProtoReflect.Descriptor instead.
+func (*LpushRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *LpushRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LpushRequest) GetValues() []*Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *LpushRequest) GetExpired() int64 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+type LpushResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Length        int64                  `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LpushResponse) Reset() {
+	*x = LpushResponse{}
+	mi := &file_cache_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LpushResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LpushResponse) ProtoMessage() {}
+
+func (x *LpushResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LpushResponse.ProtoReflect.Descriptor instead.
+func (*LpushResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *LpushResponse) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type PopRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PopRequest) Reset() {
+	*x = PopRequest{}
+	mi := &file_cache_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PopRequest) ProtoMessage() {}
+
+func (x *PopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PopRequest.ProtoReflect.Descriptor instead.
+func (*PopRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PopRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type PopResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *Value                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PopResponse) Reset() {
+	*x = PopResponse{}
+	mi := &file_cache_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PopResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PopResponse) ProtoMessage() {}
+
+func (x *PopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PopResponse.ProtoReflect.Descriptor instead.
+func (*PopResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PopResponse) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type LpopRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LpopRequest) Reset() {
+	*x = LpopRequest{}
+	mi := &file_cache_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LpopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LpopRequest) ProtoMessage() {}
+
+func (x *LpopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LpopRequest.ProtoReflect.Descriptor instead.
+func (*LpopRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *LpopRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type LpopResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *Value                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LpopResponse) Reset() {
+	*x = LpopResponse{}
+	mi := &file_cache_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LpopResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LpopResponse) ProtoMessage() {}
+
+func (x *LpopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LpopResponse.ProtoReflect.Descriptor instead.
+func (*LpopResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *LpopResponse) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type BlpopRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Keys  []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	// timeout is in seconds, 0 waits until the call is cancelled.
+	Timeout       float64 `protobuf:"fixed64,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlpopRequest) Reset() {
+	*x = BlpopRequest{}
+	mi := &file_cache_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlpopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlpopRequest) ProtoMessage() {}
+
+func (x *BlpopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlpopRequest.ProtoReflect.Descriptor instead.
+func (*BlpopRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *BlpopRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *BlpopRequest) GetTimeout() float64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type BlpopResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         *Value                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlpopResponse) Reset() {
+	*x = BlpopResponse{}
+	mi := &file_cache_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlpopResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlpopResponse) ProtoMessage() {}
+
+func (x *BlpopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlpopResponse.ProtoReflect.Descriptor instead.
+func (*BlpopResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *BlpopResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BlpopResponse) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type BrpopRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Timeout       float64                `protobuf:"fixed64,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrpopRequest) Reset() {
+	*x = BrpopRequest{}
+	mi := &file_cache_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrpopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrpopRequest) ProtoMessage() {}
+
+func (x *BrpopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrpopRequest.ProtoReflect.Descriptor instead.
+func (*BrpopRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *BrpopRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *BrpopRequest) GetTimeout() float64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type BrpopResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         *Value                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrpopResponse) Reset() {
+	*x = BrpopResponse{}
+	mi := &file_cache_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrpopResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrpopResponse) ProtoMessage() {}
+
+func (x *BrpopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrpopResponse.ProtoReflect.Descriptor instead.
+func (*BrpopResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *BrpopResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BrpopResponse) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type LgetallRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LgetallRequest) Reset() {
+	*x = LgetallRequest{}
+	mi := &file_cache_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LgetallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LgetallRequest) ProtoMessage() {}
+
+func (x *LgetallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LgetallRequest.ProtoReflect.Descriptor instead.
+func (*LgetallRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *LgetallRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type LgetallResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []*Value               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LgetallResponse) Reset() {
+	*x = LgetallResponse{}
+	mi := &file_cache_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LgetallResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LgetallResponse) ProtoMessage() {}
+
+func (x *LgetallResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LgetallResponse.ProtoReflect.Descriptor instead.
+func (*LgetallResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *LgetallResponse) GetValues() []*Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type LgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Index         int64                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LgetRequest) Reset() {
+	*x = LgetRequest{}
+	mi := &file_cache_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LgetRequest) ProtoMessage() {}
+
+func (x *LgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LgetRequest.ProtoReflect.Descriptor instead.
+func (*LgetRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *LgetRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LgetRequest) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type LgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *Value                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LgetResponse) Reset() {
+	*x = LgetResponse{}
+	mi := &file_cache_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LgetResponse) ProtoMessage() {}
+
+func (x *LgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LgetResponse.ProtoReflect.Descriptor instead.
+func (*LgetResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *LgetResponse) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type LlenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LlenRequest) Reset() {
+	*x = LlenRequest{}
+	mi := &file_cache_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LlenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LlenRequest) ProtoMessage() {}
+
+func (x *LlenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LlenRequest.ProtoReflect.Descriptor instead.
+func (*LlenRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *LlenRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type LlenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Length        int64                  `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LlenResponse) Reset() {
+	*x = LlenResponse{}
+	mi := &file_cache_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LlenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LlenResponse) ProtoMessage() {}
+
+func (x *LlenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LlenResponse.ProtoReflect.Descriptor instead.
+func (*LlenResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *LlenResponse) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type LrangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Start         int64                  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Stop          int64                  `protobuf:"varint,3,opt,name=stop,proto3" json:"stop,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LrangeRequest) Reset() {
+	*x = LrangeRequest{}
+	mi := &file_cache_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LrangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LrangeRequest) ProtoMessage() {}
+
+func (x *LrangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LrangeRequest.ProtoReflect.Descriptor instead.
+func (*LrangeRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *LrangeRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LrangeRequest) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *LrangeRequest) GetStop() int64 {
+	if x != nil {
+		return x.Stop
+	}
+	return 0
+}
+
+type LrangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []*Value               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LrangeResponse) Reset() {
+	*x = LrangeResponse{}
+	mi := &file_cache_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LrangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LrangeResponse) ProtoMessage() {}
+
+func (x *LrangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LrangeResponse.ProtoReflect.Descriptor instead.
+func (*LrangeResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *LrangeResponse) GetValues() []*Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type LsetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Index         int64                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Value         *Value                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LsetRequest) Reset() {
+	*x = LsetRequest{}
+	mi := &file_cache_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LsetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsetRequest) ProtoMessage() {}
+
+func (x *LsetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsetRequest.ProtoReflect.Descriptor instead.
+func (*LsetRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *LsetRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LsetRequest) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *LsetRequest) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type LsetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LsetResponse) Reset() {
+	*x = LsetResponse{}
+	mi := &file_cache_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LsetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsetResponse) ProtoMessage() {}
+
+func (x *LsetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsetResponse.ProtoReflect.Descriptor instead.
+func (*LsetResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{60}
+}
+
+type LremRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Value         *Value                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LremRequest) Reset() {
+	*x = LremRequest{}
+	mi := &file_cache_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LremRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LremRequest) ProtoMessage() {}
+
+func (x *LremRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LremRequest.ProtoReflect.Descriptor instead.
+func (*LremRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *LremRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LremRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *LremRequest) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type LremResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Removed       int64                  `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LremResponse) Reset() {
+	*x = LremResponse{}
+	mi := &file_cache_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LremResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LremResponse) ProtoMessage() {}
+
+func (x *LremResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LremResponse.ProtoReflect.Descriptor instead.
+func (*LremResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *LremResponse) GetRemoved() int64 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+type LtrimRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Start         int64                  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Stop          int64                  `protobuf:"varint,3,opt,name=stop,proto3" json:"stop,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LtrimRequest) Reset() {
+	*x = LtrimRequest{}
+	mi := &file_cache_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LtrimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LtrimRequest) ProtoMessage() {}
+
+func (x *LtrimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LtrimRequest.ProtoReflect.Descriptor instead.
+func (*LtrimRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *LtrimRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LtrimRequest) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *LtrimRequest) GetStop() int64 {
+	if x != nil {
+		return x.Stop
+	}
+	return 0
+}
+
+type LtrimResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LtrimResponse) Reset() {
+	*x = LtrimResponse{}
+	mi := &file_cache_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LtrimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LtrimResponse) ProtoMessage() {}
+
+func (x *LtrimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LtrimResponse.ProtoReflect.Descriptor instead.
+func (*LtrimResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{64}
+}
+
+type LinsertRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// before inserts value before the pivot, otherwise after it.
+	Before        bool   `protobuf:"varint,2,opt,name=before,proto3" json:"before,omitempty"`
+	Pivot         *Value `protobuf:"bytes,3,opt,name=pivot,proto3" json:"pivot,omitempty"`
+	Value         *Value `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinsertRequest) Reset() {
+	*x = LinsertRequest{}
+	mi := &file_cache_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinsertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinsertRequest) ProtoMessage() {}
+
+func (x *LinsertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinsertRequest.ProtoReflect.Descriptor instead.
+func (*LinsertRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *LinsertRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LinsertRequest) GetBefore() bool {
+	if x != nil {
+		return x.Before
+	}
+	return false
+}
+
+func (x *LinsertRequest) GetPivot() *Value {
+	if x != nil {
+		return x.Pivot
+	}
+	return nil
+}
+
+func (x *LinsertRequest) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type LinsertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Length        int64                  `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinsertResponse) Reset() {
+	*x = LinsertResponse{}
+	mi := &file_cache_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinsertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinsertResponse) ProtoMessage() {}
+
+func (x *LinsertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinsertResponse.ProtoReflect.Descriptor instead.
+func (*LinsertResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *LinsertResponse) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type HsetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Fields        map[string]*Value      `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Expired       int64                  `protobuf:"varint,3,opt,name=expired,proto3" json:"expired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HsetRequest) Reset() {
+	*x = HsetRequest{}
+	mi := &file_cache_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HsetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HsetRequest) ProtoMessage() {}
+
+func (x *HsetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HsetRequest.ProtoReflect.Descriptor instead.
+func (*HsetRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *HsetRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *HsetRequest) GetFields() map[string]*Value {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *HsetRequest) GetExpired() int64 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+type HsetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HsetResponse) Reset() {
+	*x = HsetResponse{}
+	mi := &file_cache_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HsetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HsetResponse) ProtoMessage() {}
+
+func (x *HsetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HsetResponse.ProtoReflect.Descriptor instead.
+func (*HsetResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{68}
+}
+
+type HgetallRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HgetallRequest) Reset() {
+	*x = HgetallRequest{}
+	mi := &file_cache_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HgetallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HgetallRequest) ProtoMessage() {}
+
+func (x *HgetallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HgetallRequest.ProtoReflect.Descriptor instead.
+func (*HgetallRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *HgetallRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type HgetallResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fields        map[string]*Value      `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HgetallResponse) Reset() {
+	*x = HgetallResponse{}
+	mi := &file_cache_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HgetallResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HgetallResponse) ProtoMessage() {}
+
+func (x *HgetallResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HgetallResponse.ProtoReflect.Descriptor instead.
+func (*HgetallResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *HgetallResponse) GetFields() map[string]*Value {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type HgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HgetRequest) Reset() {
+	*x = HgetRequest{}
+	mi := &file_cache_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HgetRequest) ProtoMessage() {}
+
+func (x *HgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HgetRequest.ProtoReflect.Descriptor instead.
+func (*HgetRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *HgetRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *HgetRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+type HgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *Value                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HgetResponse) Reset() {
+	*x = HgetResponse{}
+	mi := &file_cache_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HgetResponse) ProtoMessage() {}
+
+func (x *HgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HgetResponse.ProtoReflect.Descriptor instead.
+func (*HgetResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *HgetResponse) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type HdelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Fields        []string               `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HdelRequest) Reset() {
+	*x = HdelRequest{}
+	mi := &file_cache_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HdelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HdelRequest) ProtoMessage() {}
+
+func (x *HdelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HdelRequest.ProtoReflect.Descriptor instead.
+func (*HdelRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *HdelRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *HdelRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type HdelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Removed       int64                  `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HdelResponse) Reset() {
+	*x = HdelResponse{}
+	mi := &file_cache_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HdelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HdelResponse) ProtoMessage() {}
+
+func (x *HdelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HdelResponse.ProtoReflect.Descriptor instead.
+func (*HdelResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *HdelResponse) GetRemoved() int64 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+type HexistsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HexistsRequest) Reset() {
+	*x = HexistsRequest{}
+	mi := &file_cache_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HexistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HexistsRequest) ProtoMessage() {}
+
+func (x *HexistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HexistsRequest.ProtoReflect.Descriptor instead.
+func (*HexistsRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *HexistsRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *HexistsRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+type HexistsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exists        bool                   `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HexistsResponse) Reset() {
+	*x = HexistsResponse{}
+	mi := &file_cache_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HexistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HexistsResponse) ProtoMessage() {}
+
+func (x *HexistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HexistsResponse.ProtoReflect.Descriptor instead.
+func (*HexistsResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *HexistsResponse) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+type HlenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HlenRequest) Reset() {
+	*x = HlenRequest{}
+	mi := &file_cache_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HlenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HlenRequest) ProtoMessage() {}
+
+func (x *HlenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HlenRequest.ProtoReflect.Descriptor instead.
+func (*HlenRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *HlenRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type HlenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Length        int64                  `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HlenResponse) Reset() {
+	*x = HlenResponse{}
+	mi := &file_cache_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HlenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HlenResponse) ProtoMessage() {}
+
+func (x *HlenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HlenResponse.ProtoReflect.Descriptor instead.
+func (*HlenResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *HlenResponse) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type HkeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HkeysRequest) Reset() {
+	*x = HkeysRequest{}
+	mi := &file_cache_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HkeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HkeysRequest) ProtoMessage() {}
+
+func (x *HkeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HkeysRequest.ProtoReflect.Descriptor instead.
+func (*HkeysRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *HkeysRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type HkeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fields        []string               `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HkeysResponse) Reset() {
+	*x = HkeysResponse{}
+	mi := &file_cache_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HkeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HkeysResponse) ProtoMessage() {}
+
+func (x *HkeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HkeysResponse.ProtoReflect.Descriptor instead.
+func (*HkeysResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *HkeysResponse) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type HvalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HvalsRequest) Reset() {
+	*x = HvalsRequest{}
+	mi := &file_cache_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HvalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HvalsRequest) ProtoMessage() {}
+
+func (x *HvalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HvalsRequest.ProtoReflect.Descriptor instead.
+func (*HvalsRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *HvalsRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type HvalsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// values are ordered by their fields.
+	Values        []*Value `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HvalsResponse) Reset() {
+	*x = HvalsResponse{}
+	mi := &file_cache_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HvalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HvalsResponse) ProtoMessage() {}
+
+func (x *HvalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HvalsResponse.ProtoReflect.Descriptor instead.
+func (*HvalsResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *HvalsResponse) GetValues() []*Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type HmgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Fields        []string               `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HmgetRequest) Reset() {
+	*x = HmgetRequest{}
+	mi := &file_cache_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HmgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HmgetRequest) ProtoMessage() {}
+
+func (x *HmgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HmgetRequest.ProtoReflect.Descriptor instead.
+func (*HmgetRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *HmgetRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *HmgetRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type HmgetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// values are null for missing fields.
+	Values        []*Value `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HmgetResponse) Reset() {
+	*x = HmgetResponse{}
+	mi := &file_cache_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HmgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HmgetResponse) ProtoMessage() {}
+
+func (x *HmgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HmgetResponse.ProtoReflect.Descriptor instead.
+func (*HmgetResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *HmgetResponse) GetValues() []*Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type HsetnxRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Value         *Value                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Expired       int64                  `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HsetnxRequest) Reset() {
+	*x = HsetnxRequest{}
+	mi := &file_cache_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HsetnxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HsetnxRequest) ProtoMessage() {}
+
+func (x *HsetnxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HsetnxRequest.ProtoReflect.Descriptor instead.
+func (*HsetnxRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *HsetnxRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *HsetnxRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *HsetnxRequest) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *HsetnxRequest) GetExpired() int64 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+type HsetnxResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Set           bool                   `protobuf:"varint,1,opt,name=set,proto3" json:"set,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HsetnxResponse) Reset() {
+	*x = HsetnxResponse{}
+	mi := &file_cache_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HsetnxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HsetnxResponse) ProtoMessage() {}
+
+func (x *HsetnxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HsetnxResponse.ProtoReflect.Descriptor instead.
+func (*HsetnxResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *HsetnxResponse) GetSet() bool {
+	if x != nil {
+		return x.Set
+	}
+	return false
+}
+
+type HincrbyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Value         int64                  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HincrbyRequest) Reset() {
+	*x = HincrbyRequest{}
+	mi := &file_cache_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HincrbyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HincrbyRequest) ProtoMessage() {}
+
+func (x *HincrbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HincrbyRequest.ProtoReflect.Descriptor instead.
+func (*HincrbyRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *HincrbyRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *HincrbyRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *HincrbyRequest) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type HincrbyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         int64                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HincrbyResponse) Reset() {
+	*x = HincrbyResponse{}
+	mi := &file_cache_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HincrbyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HincrbyResponse) ProtoMessage() {}
+
+func (x *HincrbyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HincrbyResponse.ProtoReflect.Descriptor instead.
+func (*HincrbyResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *HincrbyResponse) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type HincrbyFloatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Value         float64                `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HincrbyFloatRequest) Reset() {
+	*x = HincrbyFloatRequest{}
+	mi := &file_cache_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HincrbyFloatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HincrbyFloatRequest) ProtoMessage() {}
+
+func (x *HincrbyFloatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HincrbyFloatRequest.ProtoReflect.Descriptor instead.
+func (*HincrbyFloatRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *HincrbyFloatRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *HincrbyFloatRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *HincrbyFloatRequest) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type HincrbyFloatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HincrbyFloatResponse) Reset() {
+	*x = HincrbyFloatResponse{}
+	mi := &file_cache_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HincrbyFloatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HincrbyFloatResponse) ProtoMessage() {}
+
+func (x *HincrbyFloatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HincrbyFloatResponse.ProtoReflect.Descriptor instead.
+func (*HincrbyFloatResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *HincrbyFloatResponse) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type SaddRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Members       []string               `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	Expired       int64                  `protobuf:"varint,3,opt,name=expired,proto3" json:"expired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaddRequest) Reset() {
+	*x = SaddRequest{}
+	mi := &file_cache_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaddRequest) ProtoMessage() {}
+
+func (x *SaddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaddRequest.ProtoReflect.Descriptor instead.
+func (*SaddRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *SaddRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SaddRequest) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *SaddRequest) GetExpired() int64 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+type SaddResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Added         int64                  `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaddResponse) Reset() {
+	*x = SaddResponse{}
+	mi := &file_cache_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaddResponse) ProtoMessage() {}
+
+func (x *SaddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaddResponse.ProtoReflect.Descriptor instead.
+func (*SaddResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *SaddResponse) GetAdded() int64 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+type SremRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Members       []string               `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SremRequest) Reset() {
+	*x = SremRequest{}
+	mi := &file_cache_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SremRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SremRequest) ProtoMessage() {}
+
+func (x *SremRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SremRequest.ProtoReflect.Descriptor instead.
+func (*SremRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *SremRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SremRequest) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type SremResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Removed       int64                  `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SremResponse) Reset() {
+	*x = SremResponse{}
+	mi := &file_cache_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SremResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SremResponse) ProtoMessage() {}
+
+func (x *SremResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SremResponse.ProtoReflect.Descriptor instead.
+func (*SremResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *SremResponse) GetRemoved() int64 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+type SismemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Member        string                 `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SismemberRequest) Reset() {
+	*x = SismemberRequest{}
+	mi := &file_cache_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SismemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SismemberRequest) ProtoMessage() {}
+
+func (x *SismemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SismemberRequest.ProtoReflect.Descriptor instead.
+func (*SismemberRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *SismemberRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SismemberRequest) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+type SismemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        bool                   `protobuf:"varint,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SismemberResponse) Reset() {
+	*x = SismemberResponse{}
+	mi := &file_cache_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SismemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SismemberResponse) ProtoMessage() {}
+
+func (x *SismemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SismemberResponse.ProtoReflect.Descriptor instead.
+func (*SismemberResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *SismemberResponse) GetMember() bool {
+	if x != nil {
+		return x.Member
+	}
+	return false
+}
+
+type SmembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SmembersRequest) Reset() {
+	*x = SmembersRequest{}
+	mi := &file_cache_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SmembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SmembersRequest) ProtoMessage() {}
+
+func (x *SmembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SmembersRequest.ProtoReflect.Descriptor instead.
+func (*SmembersRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *SmembersRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type SmembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []string               `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SmembersResponse) Reset() {
+	*x = SmembersResponse{}
+	mi := &file_cache_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SmembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SmembersResponse) ProtoMessage() {}
+
+func (x *SmembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SmembersResponse.ProtoReflect.Descriptor instead.
+func (*SmembersResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SmembersResponse) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type ScardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScardRequest) Reset() {
+	*x = ScardRequest{}
+	mi := &file_cache_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScardRequest) ProtoMessage() {}
+
+func (x *ScardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScardRequest.ProtoReflect.Descriptor instead.
+func (*ScardRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ScardRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ScardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScardResponse) Reset() {
+	*x = ScardResponse{}
+	mi := &file_cache_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScardResponse) ProtoMessage() {}
+
+func (x *ScardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScardResponse.ProtoReflect.Descriptor instead.
+func (*ScardResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ScardResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SpopRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// count is 1 when it's unset.
+	Count         *int64 `protobuf:"varint,2,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpopRequest) Reset() {
+	*x = SpopRequest{}
+	mi := &file_cache_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpopRequest) ProtoMessage() {}
+
+func (x *SpopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpopRequest.ProtoReflect.Descriptor instead.
+func (*SpopRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *SpopRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SpopRequest) GetCount() int64 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+type SpopResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []string               `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpopResponse) Reset() {
+	*x = SpopResponse{}
+	mi := &file_cache_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpopResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpopResponse) ProtoMessage() {}
+
+func (x *SpopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpopResponse.ProtoReflect.Descriptor instead.
+func (*SpopResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *SpopResponse) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type SinterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SinterRequest) Reset() {
+	*x = SinterRequest{}
+	mi := &file_cache_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SinterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SinterRequest) ProtoMessage() {}
+
+func (x *SinterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SinterRequest.ProtoReflect.Descriptor instead.
+func (*SinterRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *SinterRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type SinterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []string               `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SinterResponse) Reset() {
+	*x = SinterResponse{}
+	mi := &file_cache_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SinterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SinterResponse) ProtoMessage() {}
+
+func (x *SinterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SinterResponse.ProtoReflect.Descriptor instead.
+func (*SinterResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *SinterResponse) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type SunionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SunionRequest) Reset() {
+	*x = SunionRequest{}
+	mi := &file_cache_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SunionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SunionRequest) ProtoMessage() {}
+
+func (x *SunionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SunionRequest.ProtoReflect.Descriptor instead.
+func (*SunionRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *SunionRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type SunionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []string               `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SunionResponse) Reset() {
+	*x = SunionResponse{}
+	mi := &file_cache_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SunionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SunionResponse) ProtoMessage() {}
+
+func (x *SunionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SunionResponse.ProtoReflect.Descriptor instead.
+func (*SunionResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *SunionResponse) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type SdiffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SdiffRequest) Reset() {
+	*x = SdiffRequest{}
+	mi := &file_cache_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SdiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SdiffRequest) ProtoMessage() {}
+
+func (x *SdiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SdiffRequest.ProtoReflect.Descriptor instead.
+func (*SdiffRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *SdiffRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type SdiffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []string               `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SdiffResponse) Reset() {
+	*x = SdiffResponse{}
+	mi := &file_cache_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SdiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SdiffResponse) ProtoMessage() {}
+
+func (x *SdiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SdiffResponse.ProtoReflect.Descriptor instead.
+func (*SdiffResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *SdiffResponse) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type SinterStoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Destination   string                 `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Keys          []string               `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SinterStoreRequest) Reset() {
+	*x = SinterStoreRequest{}
+	mi := &file_cache_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SinterStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SinterStoreRequest) ProtoMessage() {}
+
+func (x *SinterStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SinterStoreRequest.ProtoReflect.Descriptor instead.
+func (*SinterStoreRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *SinterStoreRequest) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *SinterStoreRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type SinterStoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SinterStoreResponse) Reset() {
+	*x = SinterStoreResponse{}
+	mi := &file_cache_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SinterStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SinterStoreResponse) ProtoMessage() {}
+
+func (x *SinterStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SinterStoreResponse.ProtoReflect.Descriptor instead.
+func (*SinterStoreResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *SinterStoreResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SunionStoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Destination   string                 `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Keys          []string               `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SunionStoreRequest) Reset() {
+	*x = SunionStoreRequest{}
+	mi := &file_cache_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SunionStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SunionStoreRequest) ProtoMessage() {}
+
+func (x *SunionStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SunionStoreRequest.ProtoReflect.Descriptor instead.
+func (*SunionStoreRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *SunionStoreRequest) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *SunionStoreRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type SunionStoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SunionStoreResponse) Reset() {
+	*x = SunionStoreResponse{}
+	mi := &file_cache_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SunionStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SunionStoreResponse) ProtoMessage() {}
+
+func (x *SunionStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SunionStoreResponse.ProtoReflect.Descriptor instead.
+func (*SunionStoreResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *SunionStoreResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SdiffStoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Destination   string                 `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Keys          []string               `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SdiffStoreRequest) Reset() {
+	*x = SdiffStoreRequest{}
+	mi := &file_cache_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SdiffStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SdiffStoreRequest) ProtoMessage() {}
+
+func (x *SdiffStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SdiffStoreRequest.ProtoReflect.Descriptor instead.
+func (*SdiffStoreRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *SdiffStoreRequest) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *SdiffStoreRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type SdiffStoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SdiffStoreResponse) Reset() {
+	*x = SdiffStoreResponse{}
+	mi := &file_cache_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SdiffStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SdiffStoreResponse) ProtoMessage() {}
+
+func (x *SdiffStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SdiffStoreResponse.ProtoReflect.Descriptor instead.
+func (*SdiffStoreResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *SdiffStoreResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ZMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        string                 `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZMember) Reset() {
+	*x = ZMember{}
+	mi := &file_cache_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZMember) ProtoMessage() {}
+
+func (x *ZMember) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZMember.ProtoReflect.Descriptor instead.
+func (*ZMember) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ZMember) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+func (x *ZMember) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type ZaddRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Key     string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Members []*ZMember             `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	Expired int64                  `protobuf:"varint,3,opt,name=expired,proto3" json:"expired,omitempty"`
+	Nx      bool                   `protobuf:"varint,4,opt,name=nx,proto3" json:"nx,omitempty"`
+	Xx      bool                   `protobuf:"varint,5,opt,name=xx,proto3" json:"xx,omitempty"`
+	// incr adds the score of the only member to its score.
+	Incr          bool `protobuf:"varint,6,opt,name=incr,proto3" json:"incr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZaddRequest) Reset() {
+	*x = ZaddRequest{}
+	mi := &file_cache_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZaddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZaddRequest) ProtoMessage() {}
+
+func (x *ZaddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZaddRequest.ProtoReflect.Descriptor instead.
+func (*ZaddRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ZaddRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ZaddRequest) GetMembers() []*ZMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ZaddRequest) GetExpired() int64 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+func (x *ZaddRequest) GetNx() bool {
+	if x != nil {
+		return x.Nx
+	}
+	return false
+}
+
+func (x *ZaddRequest) GetXx() bool {
+	if x != nil {
+		return x.Xx
+	}
+	return false
+}
+
+func (x *ZaddRequest) GetIncr() bool {
+	if x != nil {
+		return x.Incr
+	}
+	return false
+}
+
+type ZaddResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// added is the number of new members, score the new score with incr,
+	// unset when nx or xx prevented the write.
+	Added         int64    `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"`
+	Score         *float64 `protobuf:"fixed64,2,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZaddResponse) Reset() {
+	*x = ZaddResponse{}
+	mi := &file_cache_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZaddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZaddResponse) ProtoMessage() {}
+
+func (x *ZaddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZaddResponse.ProtoReflect.Descriptor instead.
+func (*ZaddResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ZaddResponse) GetAdded() int64 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+func (x *ZaddResponse) GetScore() float64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+type ZremRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Members       []string               `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZremRequest) Reset() {
+	*x = ZremRequest{}
+	mi := &file_cache_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZremRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZremRequest) ProtoMessage() {}
+
+func (x *ZremRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZremRequest.ProtoReflect.Descriptor instead.
+func (*ZremRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ZremRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ZremRequest) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type ZremResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Removed       int64                  `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZremResponse) Reset() {
+	*x = ZremResponse{}
+	mi := &file_cache_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZremResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZremResponse) ProtoMessage() {}
+
+func (x *ZremResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZremResponse.ProtoReflect.Descriptor instead.
+func (*ZremResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ZremResponse) GetRemoved() int64 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+type ZscoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Member        string                 `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZscoreRequest) Reset() {
+	*x = ZscoreRequest{}
+	mi := &file_cache_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZscoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZscoreRequest) ProtoMessage() {}
+
+func (x *ZscoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZscoreRequest.ProtoReflect.Descriptor instead.
+func (*ZscoreRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ZscoreRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ZscoreRequest) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+type ZscoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Score         float64                `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZscoreResponse) Reset() {
+	*x = ZscoreResponse{}
+	mi := &file_cache_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZscoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZscoreResponse) ProtoMessage() {}
+
+func (x *ZscoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZscoreResponse.ProtoReflect.Descriptor instead.
+func (*ZscoreResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ZscoreResponse) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type ZrankRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Member        string                 `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZrankRequest) Reset() {
+	*x = ZrankRequest{}
+	mi := &file_cache_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZrankRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZrankRequest) ProtoMessage() {}
+
+func (x *ZrankRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZrankRequest.ProtoReflect.Descriptor instead.
+func (*ZrankRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ZrankRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ZrankRequest) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+type ZrankResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int64                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZrankResponse) Reset() {
+	*x = ZrankResponse{}
+	mi := &file_cache_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZrankResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZrankResponse) ProtoMessage() {}
+
+func (x *ZrankResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZrankResponse.ProtoReflect.Descriptor instead.
+func (*ZrankResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ZrankResponse) GetRank() int64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+type ZrevrankRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Member        string                 `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZrevrankRequest) Reset() {
+	*x = ZrevrankRequest{}
+	mi := &file_cache_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZrevrankRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZrevrankRequest) ProtoMessage() {}
+
+func (x *ZrevrankRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZrevrankRequest.ProtoReflect.Descriptor instead.
+func (*ZrevrankRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *ZrevrankRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ZrevrankRequest) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+type ZrevrankResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int64                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZrevrankResponse) Reset() {
+	*x = ZrevrankResponse{}
+	mi := &file_cache_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZrevrankResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZrevrankResponse) ProtoMessage() {}
+
+func (x *ZrevrankResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZrevrankResponse.ProtoReflect.Descriptor instead.
+func (*ZrevrankResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ZrevrankResponse) GetRank() int64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+type ZrangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Start         int64                  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Stop          int64                  `protobuf:"varint,3,opt,name=stop,proto3" json:"stop,omitempty"`
+	Rev           bool                   `protobuf:"varint,4,opt,name=rev,proto3" json:"rev,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZrangeRequest) Reset() {
+	*x = ZrangeRequest{}
+	mi := &file_cache_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZrangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZrangeRequest) ProtoMessage() {}
+
+func (x *ZrangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZrangeRequest.ProtoReflect.Descriptor instead.
+func (*ZrangeRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ZrangeRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ZrangeRequest) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *ZrangeRequest) GetStop() int64 {
+	if x != nil {
+		return x.Stop
+	}
+	return 0
+}
+
+func (x *ZrangeRequest) GetRev() bool {
+	if x != nil {
+		return x.Rev
+	}
+	return false
+}
+
+type ZrangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*ZMember             `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZrangeResponse) Reset() {
+	*x = ZrangeResponse{}
+	mi := &file_cache_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZrangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZrangeResponse) ProtoMessage() {}
+
+func (x *ZrangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZrangeResponse.ProtoReflect.Descriptor instead.
+func (*ZrangeResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ZrangeResponse) GetMembers() []*ZMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type ZrangeByScoreRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// min and max are scores, -inf and +inf when they are empty, and
+	// exclusive when they start with "(".
+	Min    string `protobuf:"bytes,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max    string `protobuf:"bytes,3,opt,name=max,proto3" json:"max,omitempty"`
+	Offset int64  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// count is unlimited when it's unset.
+	Count         *int64 `protobuf:"varint,5,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	Rev           bool   `protobuf:"varint,6,opt,name=rev,proto3" json:"rev,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZrangeByScoreRequest) Reset() {
+	*x = ZrangeByScoreRequest{}
+	mi := &file_cache_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZrangeByScoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZrangeByScoreRequest) ProtoMessage() {}
+
+func (x *ZrangeByScoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZrangeByScoreRequest.ProtoReflect.Descriptor instead.
+func (*ZrangeByScoreRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ZrangeByScoreRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ZrangeByScoreRequest) GetMin() string {
+	if x != nil {
+		return x.Min
+	}
+	return ""
+}
+
+func (x *ZrangeByScoreRequest) GetMax() string {
+	if x != nil {
+		return x.Max
+	}
+	return ""
+}
+
+func (x *ZrangeByScoreRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ZrangeByScoreRequest) GetCount() int64 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *ZrangeByScoreRequest) GetRev() bool {
+	if x != nil {
+		return x.Rev
+	}
+	return false
+}
+
+type ZrangeByScoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*ZMember             `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZrangeByScoreResponse) Reset() {
+	*x = ZrangeByScoreResponse{}
+	mi := &file_cache_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZrangeByScoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZrangeByScoreResponse) ProtoMessage() {}
+
+func (x *ZrangeByScoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZrangeByScoreResponse.ProtoReflect.Descriptor instead.
+func (*ZrangeByScoreResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ZrangeByScoreResponse) GetMembers() []*ZMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type ZcountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Min           string                 `protobuf:"bytes,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max           string                 `protobuf:"bytes,3,opt,name=max,proto3" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZcountRequest) Reset() {
+	*x = ZcountRequest{}
+	mi := &file_cache_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZcountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZcountRequest) ProtoMessage() {}
+
+func (x *ZcountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZcountRequest.ProtoReflect.Descriptor instead.
+func (*ZcountRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *ZcountRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ZcountRequest) GetMin() string {
+	if x != nil {
+		return x.Min
+	}
+	return ""
+}
+
+func (x *ZcountRequest) GetMax() string {
+	if x != nil {
+		return x.Max
+	}
+	return ""
+}
+
+type ZcountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZcountResponse) Reset() {
+	*x = ZcountResponse{}
+	mi := &file_cache_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZcountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZcountResponse) ProtoMessage() {}
+
+func (x *ZcountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZcountResponse.ProtoReflect.Descriptor instead.
+func (*ZcountResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *ZcountResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ZpopMinRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// count is 1 when it's unset.
+	Count         *int64 `protobuf:"varint,2,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZpopMinRequest) Reset() {
+	*x = ZpopMinRequest{}
+	mi := &file_cache_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZpopMinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZpopMinRequest) ProtoMessage() {}
+
+func (x *ZpopMinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZpopMinRequest.ProtoReflect.Descriptor instead.
+func (*ZpopMinRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ZpopMinRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ZpopMinRequest) GetCount() int64 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+type ZpopMinResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*ZMember             `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZpopMinResponse) Reset() {
+	*x = ZpopMinResponse{}
+	mi := &file_cache_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZpopMinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZpopMinResponse) ProtoMessage() {}
+
+func (x *ZpopMinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZpopMinResponse.ProtoReflect.Descriptor instead.
+func (*ZpopMinResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ZpopMinResponse) GetMembers() []*ZMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type ZpopMaxRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count         *int64                 `protobuf:"varint,2,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZpopMaxRequest) Reset() {
+	*x = ZpopMaxRequest{}
+	mi := &file_cache_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZpopMaxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZpopMaxRequest) ProtoMessage() {}
+
+func (x *ZpopMaxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZpopMaxRequest.ProtoReflect.Descriptor instead.
+func (*ZpopMaxRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *ZpopMaxRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ZpopMaxRequest) GetCount() int64 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+type ZpopMaxResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*ZMember             `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZpopMaxResponse) Reset() {
+	*x = ZpopMaxResponse{}
+	mi := &file_cache_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZpopMaxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZpopMaxResponse) ProtoMessage() {}
+
+func (x *ZpopMaxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZpopMaxResponse.ProtoReflect.Descriptor instead.
+func (*ZpopMaxResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *ZpopMaxResponse) GetMembers() []*ZMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type StatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatsRequest) Reset() {
+	*x = StatsRequest{}
+	mi := &file_cache_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsRequest) ProtoMessage() {}
+
+func (x *StatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsRequest.ProtoReflect.Descriptor instead.
+func (*StatsRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{136}
+}
+
+type StatsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Keys           int64                  `protobuf:"varint,1,opt,name=keys,proto3" json:"keys,omitempty"`
+	UsedMemory     int64                  `protobuf:"varint,2,opt,name=used_memory,json=usedMemory,proto3" json:"used_memory,omitempty"`
+	MaxMemory      int64                  `protobuf:"varint,3,opt,name=max_memory,json=maxMemory,proto3" json:"max_memory,omitempty"`
+	MaxKeys        int64                  `protobuf:"varint,4,opt,name=max_keys,json=maxKeys,proto3" json:"max_keys,omitempty"`
+	EvictionPolicy string                 `protobuf:"bytes,5,opt,name=eviction_policy,json=evictionPolicy,proto3" json:"eviction_policy,omitempty"`
+	EvictedKeys    int64                  `protobuf:"varint,6,opt,name=evicted_keys,json=evictedKeys,proto3" json:"evicted_keys,omitempty"`
+	RejectedWrites int64                  `protobuf:"varint,7,opt,name=rejected_writes,json=rejectedWrites,proto3" json:"rejected_writes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StatsResponse) Reset() {
+	*x = StatsResponse{}
+	mi := &file_cache_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse) ProtoMessage() {}
+
+func (x *StatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse.ProtoReflect.Descriptor instead.
+func (*StatsResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *StatsResponse) GetKeys() int64 {
+	if x != nil {
+		return x.Keys
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetUsedMemory() int64 {
+	if x != nil {
+		return x.UsedMemory
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetMaxMemory() int64 {
+	if x != nil {
+		return x.MaxMemory
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetMaxKeys() int64 {
+	if x != nil {
+		return x.MaxKeys
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetEvictionPolicy() string {
+	if x != nil {
+		return x.EvictionPolicy
+	}
+	return ""
+}
+
+func (x *StatsResponse) GetEvictedKeys() int64 {
+	if x != nil {
+		return x.EvictedKeys
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetRejectedWrites() int64 {
+	if x != nil {
+		return x.RejectedWrites
+	}
+	return 0
+}
+
+type DbSizeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DbSizeRequest) Reset() {
+	*x = DbSizeRequest{}
+	mi := &file_cache_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DbSizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DbSizeRequest) ProtoMessage() {}
+
+func (x *DbSizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DbSizeRequest.ProtoReflect.Descriptor instead.
+func (*DbSizeRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{138}
+}
+
+type DbSizeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Size          int64                  `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DbSizeResponse) Reset() {
+	*x = DbSizeResponse{}
+	mi := &file_cache_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DbSizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DbSizeResponse) ProtoMessage() {}
+
+func (x *DbSizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DbSizeResponse.ProtoReflect.Descriptor instead.
+func (*DbSizeResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *DbSizeResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type FlushDbRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlushDbRequest) Reset() {
+	*x = FlushDbRequest{}
+	mi := &file_cache_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlushDbRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushDbRequest) ProtoMessage() {}
+
+func (x *FlushDbRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushDbRequest.ProtoReflect.Descriptor instead.
+func (*FlushDbRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{140}
+}
+
+type FlushDbResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       int64                  `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlushDbResponse) Reset() {
+	*x = FlushDbResponse{}
+	mi := &file_cache_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlushDbResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushDbResponse) ProtoMessage() {}
+
+func (x *FlushDbResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushDbResponse.ProtoReflect.Descriptor instead.
+func (*FlushDbResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *FlushDbResponse) GetDeleted() int64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+type MoveRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// db is the database the key is moved to.
+	Db            int64 `protobuf:"varint,2,opt,name=db,proto3" json:"db,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveRequest) Reset() {
+	*x = MoveRequest{}
+	mi := &file_cache_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveRequest) ProtoMessage() {}
+
+func (x *MoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveRequest.ProtoReflect.Descriptor instead.
+func (*MoveRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *MoveRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MoveRequest) GetDb() int64 {
+	if x != nil {
+		return x.Db
+	}
+	return 0
+}
+
+type MoveResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// moved is false when db already has the key.
+	Moved         bool `protobuf:"varint,1,opt,name=moved,proto3" json:"moved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveResponse) Reset() {
+	*x = MoveResponse{}
+	mi := &file_cache_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveResponse) ProtoMessage() {}
+
+func (x *MoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveResponse.ProtoReflect.Descriptor instead.
+func (*MoveResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *MoveResponse) GetMoved() bool {
+	if x != nil {
+		return x.Moved
+	}
+	return false
+}
+
+// Command is the request of one operation of a batch or a transaction.
+type Command struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Op:
+	//
+	//	*Command_Get
+	//	*Command_Gets
+	//	*Command_Version
+	//	*Command_Set
+	//	*Command_Getset
+	//	*Command_Cas
+	//	*Command_Incr
+	//	*Command_Decr
+	//	*Command_Incrby
+	//	*Command_Incrbyfloat
+	//	*Command_Unset
+	//	*Command_Expire
+	//	*Command_Expireat
+	//	*Command_Persist
+	//	*Command_Ttl
+	//	*Command_Pttl
+	//	*Command_Keys
+	//	*Command_Scan
+	//	*Command_Rpush
+	//	*Command_Lpush
+	//	*Command_Pop
+	//	*Command_Lpop
+	//	*Command_Blpop
+	//	*Command_Brpop
+	//	*Command_Lgetall
+	//	*Command_Lget
+	//	*Command_Llen
+	//	*Command_Lrange
+	//	*Command_Lset
+	//	*Command_Lrem
+	//	*Command_Ltrim
+	//	*Command_Linsert
+	//	*Command_Hset
+	//	*Command_Hgetall
+	//	*Command_Hget
+	//	*Command_Hdel
+	//	*Command_Hexists
+	//	*Command_Hlen
+	//	*Command_Hkeys
+	//	*Command_Hvals
+	//	*Command_Hmget
+	//	*Command_Hsetnx
+	//	*Command_Hincrby
+	//	*Command_Hincrbyfloat
+	//	*Command_Sadd
+	//	*Command_Srem
+	//	*Command_Sismember
+	//	*Command_Smembers
+	//	*Command_Scard
+	//	*Command_Spop
+	//	*Command_Sinter
+	//	*Command_Sunion
+	//	*Command_Sdiff
+	//	*Command_Sinterstore
+	//	*Command_Sunionstore
+	//	*Command_Sdiffstore
+	//	*Command_Zadd
+	//	*Command_Zrem
+	//	*Command_Zscore
+	//	*Command_Zrank
+	//	*Command_Zrevrank
+	//	*Command_Zrange
+	//	*Command_Zrangebyscore
+	//	*Command_Zcount
+	//	*Command_Zpopmin
+	//	*Command_Zpopmax
+	//	*Command_Stats
+	//	*Command_Dbsize
+	//	*Command_Flushdb
+	//	*Command_Move
+	Op            isCommand_Op `protobuf_oneof:"op"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Command) Reset() {
+	*x = Command{}
+	mi := &file_cache_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Command) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Command) ProtoMessage() {}
+
+func (x *Command) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Command.ProtoReflect.Descriptor instead.
+func (*Command) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *Command) GetOp() isCommand_Op {
+	if x != nil {
+		return x.Op
+	}
+	return nil
+}
+
+func (x *Command) GetGet() *GetRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Get); ok {
+			return x.Get
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetGets() *GetsRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Gets); ok {
+			return x.Gets
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetVersion() *VersionRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Version); ok {
+			return x.Version
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetSet() *SetRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Set); ok {
+			return x.Set
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetGetset() *GetSetRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Getset); ok {
+			return x.Getset
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetCas() *CasRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Cas); ok {
+			return x.Cas
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetIncr() *IncrRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Incr); ok {
+			return x.Incr
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetDecr() *DecrRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Decr); ok {
+			return x.Decr
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetIncrby() *IncrByRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Incrby); ok {
+			return x.Incrby
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetIncrbyfloat() *IncrByFloatRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Incrbyfloat); ok {
+			return x.Incrbyfloat
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetUnset() *UnsetRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Unset); ok {
+			return x.Unset
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetExpire() *ExpireRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Expire); ok {
+			return x.Expire
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetExpireat() *ExpireAtRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Expireat); ok {
+			return x.Expireat
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetPersist() *PersistRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Persist); ok {
+			return x.Persist
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetTtl() *TtlRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Ttl); ok {
+			return x.Ttl
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetPttl() *PttlRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Pttl); ok {
+			return x.Pttl
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetKeys() *KeysRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Keys); ok {
+			return x.Keys
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetScan() *ScanRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Scan); ok {
+			return x.Scan
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetRpush() *RpushRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Rpush); ok {
+			return x.Rpush
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetLpush() *LpushRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Lpush); ok {
+			return x.Lpush
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetPop() *PopRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Pop); ok {
+			return x.Pop
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetLpop() *LpopRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Lpop); ok {
+			return x.Lpop
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetBlpop() *BlpopRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Blpop); ok {
+			return x.Blpop
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetBrpop() *BrpopRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Brpop); ok {
+			return x.Brpop
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetLgetall() *LgetallRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Lgetall); ok {
+			return x.Lgetall
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetLget() *LgetRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Lget); ok {
+			return x.Lget
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetLlen() *LlenRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Llen); ok {
+			return x.Llen
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetLrange() *LrangeRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Lrange); ok {
+			return x.Lrange
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetLset() *LsetRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Lset); ok {
+			return x.Lset
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetLrem() *LremRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Lrem); ok {
+			return x.Lrem
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetLtrim() *LtrimRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Ltrim); ok {
+			return x.Ltrim
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetLinsert() *LinsertRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Linsert); ok {
+			return x.Linsert
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetHset() *HsetRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Hset); ok {
+			return x.Hset
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetHgetall() *HgetallRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Hgetall); ok {
+			return x.Hgetall
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetHget() *HgetRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Hget); ok {
+			return x.Hget
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetHdel() *HdelRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Hdel); ok {
+			return x.Hdel
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetHexists() *HexistsRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Hexists); ok {
+			return x.Hexists
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetHlen() *HlenRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Hlen); ok {
+			return x.Hlen
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetHkeys() *HkeysRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Hkeys); ok {
+			return x.Hkeys
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetHvals() *HvalsRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Hvals); ok {
+			return x.Hvals
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetHmget() *HmgetRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Hmget); ok {
+			return x.Hmget
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetHsetnx() *HsetnxRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Hsetnx); ok {
+			return x.Hsetnx
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetHincrby() *HincrbyRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Hincrby); ok {
+			return x.Hincrby
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetHincrbyfloat() *HincrbyFloatRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Hincrbyfloat); ok {
+			return x.Hincrbyfloat
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetSadd() *SaddRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Sadd); ok {
+			return x.Sadd
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetSrem() *SremRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Srem); ok {
+			return x.Srem
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetSismember() *SismemberRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Sismember); ok {
+			return x.Sismember
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetSmembers() *SmembersRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Smembers); ok {
+			return x.Smembers
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetScard() *ScardRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Scard); ok {
+			return x.Scard
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetSpop() *SpopRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Spop); ok {
+			return x.Spop
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetSinter() *SinterRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Sinter); ok {
+			return x.Sinter
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetSunion() *SunionRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Sunion); ok {
+			return x.Sunion
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetSdiff() *SdiffRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Sdiff); ok {
+			return x.Sdiff
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetSinterstore() *SinterStoreRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Sinterstore); ok {
+			return x.Sinterstore
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetSunionstore() *SunionStoreRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Sunionstore); ok {
+			return x.Sunionstore
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetSdiffstore() *SdiffStoreRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Sdiffstore); ok {
+			return x.Sdiffstore
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetZadd() *ZaddRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Zadd); ok {
+			return x.Zadd
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetZrem() *ZremRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Zrem); ok {
+			return x.Zrem
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetZscore() *ZscoreRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Zscore); ok {
+			return x.Zscore
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetZrank() *ZrankRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Zrank); ok {
+			return x.Zrank
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetZrevrank() *ZrevrankRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Zrevrank); ok {
+			return x.Zrevrank
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetZrange() *ZrangeRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Zrange); ok {
+			return x.Zrange
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetZrangebyscore() *ZrangeByScoreRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Zrangebyscore); ok {
+			return x.Zrangebyscore
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetZcount() *ZcountRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Zcount); ok {
+			return x.Zcount
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetZpopmin() *ZpopMinRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Zpopmin); ok {
+			return x.Zpopmin
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetZpopmax() *ZpopMaxRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Zpopmax); ok {
+			return x.Zpopmax
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetStats() *StatsRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Stats); ok {
+			return x.Stats
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetDbsize() *DbSizeRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Dbsize); ok {
+			return x.Dbsize
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetFlushdb() *FlushDbRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Flushdb); ok {
+			return x.Flushdb
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetMove() *MoveRequest {
+	if x != nil {
+		if x, ok := x.Op.(*Command_Move); ok {
+			return x.Move
+		}
+	}
+	return nil
+}
+
+type isCommand_Op interface {
+	isCommand_Op()
+}
+
+type Command_Get struct {
+	Get *GetRequest `protobuf:"bytes,1,opt,name=get,proto3,oneof"`
+}
+
+type Command_Gets struct {
+	Gets *GetsRequest `protobuf:"bytes,2,opt,name=gets,proto3,oneof"`
+}
+
+type Command_Version struct {
+	Version *VersionRequest `protobuf:"bytes,3,opt,name=version,proto3,oneof"`
+}
+
+type Command_Set struct {
+	Set *SetRequest `protobuf:"bytes,4,opt,name=set,proto3,oneof"`
+}
+
+type Command_Getset struct {
+	Getset *GetSetRequest `protobuf:"bytes,5,opt,name=getset,proto3,oneof"`
+}
+
+type Command_Cas struct {
+	Cas *CasRequest `protobuf:"bytes,6,opt,name=cas,proto3,oneof"`
+}
+
+type Command_Incr struct {
+	Incr *IncrRequest `protobuf:"bytes,7,opt,name=incr,proto3,oneof"`
+}
+
+type Command_Decr struct {
+	Decr *DecrRequest `protobuf:"bytes,8,opt,name=decr,proto3,oneof"`
+}
+
+type Command_Incrby struct {
+	Incrby *IncrByRequest `protobuf:"bytes,9,opt,name=incrby,proto3,oneof"`
+}
+
+type Command_Incrbyfloat struct {
+	Incrbyfloat *IncrByFloatRequest `protobuf:"bytes,10,opt,name=incrbyfloat,proto3,oneof"`
+}
+
+type Command_Unset struct {
+	Unset *UnsetRequest `protobuf:"bytes,11,opt,name=unset,proto3,oneof"`
+}
+
+type Command_Expire struct {
+	Expire *ExpireRequest `protobuf:"bytes,12,opt,name=expire,proto3,oneof"`
+}
+
+type Command_Expireat struct {
+	Expireat *ExpireAtRequest `protobuf:"bytes,13,opt,name=expireat,proto3,oneof"`
+}
+
+type Command_Persist struct {
+	Persist *PersistRequest `protobuf:"bytes,14,opt,name=persist,proto3,oneof"`
+}
+
+type Command_Ttl struct {
+	Ttl *TtlRequest `protobuf:"bytes,15,opt,name=ttl,proto3,oneof"`
+}
+
+type Command_Pttl struct {
+	Pttl *PttlRequest `protobuf:"bytes,16,opt,name=pttl,proto3,oneof"`
+}
+
+type Command_Keys struct {
+	Keys *KeysRequest `protobuf:"bytes,17,opt,name=keys,proto3,oneof"`
+}
+
+type Command_Scan struct {
+	Scan *ScanRequest `protobuf:"bytes,18,opt,name=scan,proto3,oneof"`
+}
+
+type Command_Rpush struct {
+	Rpush *RpushRequest `protobuf:"bytes,19,opt,name=rpush,proto3,oneof"`
+}
+
+type Command_Lpush struct {
+	Lpush *LpushRequest `protobuf:"bytes,20,opt,name=lpush,proto3,oneof"`
+}
+
+type Command_Pop struct {
+	Pop *PopRequest `protobuf:"bytes,21,opt,name=pop,proto3,oneof"`
+}
+
+type Command_Lpop struct {
+	Lpop *LpopRequest `protobuf:"bytes,22,opt,name=lpop,proto3,oneof"`
+}
+
+type Command_Blpop struct {
+	Blpop *BlpopRequest `protobuf:"bytes,23,opt,name=blpop,proto3,oneof"`
+}
+
+type Command_Brpop struct {
+	Brpop *BrpopRequest `protobuf:"bytes,24,opt,name=brpop,proto3,oneof"`
+}
+
+type Command_Lgetall struct {
+	Lgetall *LgetallRequest `protobuf:"bytes,25,opt,name=lgetall,proto3,oneof"`
+}
+
+type Command_Lget struct {
+	Lget *LgetRequest `protobuf:"bytes,26,opt,name=lget,proto3,oneof"`
+}
+
+type Command_Llen struct {
+	Llen *LlenRequest `protobuf:"bytes,27,opt,name=llen,proto3,oneof"`
+}
+
+type Command_Lrange struct {
+	Lrange *LrangeRequest `protobuf:"bytes,28,opt,name=lrange,proto3,oneof"`
+}
+
+type Command_Lset struct {
+	Lset *LsetRequest `protobuf:"bytes,29,opt,name=lset,proto3,oneof"`
+}
+
+type Command_Lrem struct {
+	Lrem *LremRequest `protobuf:"bytes,30,opt,name=lrem,proto3,oneof"`
+}
+
+type Command_Ltrim struct {
+	Ltrim *LtrimRequest `protobuf:"bytes,31,opt,name=ltrim,proto3,oneof"`
+}
+
+type Command_Linsert struct {
+	Linsert *LinsertRequest `protobuf:"bytes,32,opt,name=linsert,proto3,oneof"`
+}
+
+type Command_Hset struct {
+	Hset *HsetRequest `protobuf:"bytes,33,opt,name=hset,proto3,oneof"`
+}
+
+type Command_Hgetall struct {
+	Hgetall *HgetallRequest `protobuf:"bytes,34,opt,name=hgetall,proto3,oneof"`
+}
+
+type Command_Hget struct {
+	Hget *HgetRequest `protobuf:"bytes,35,opt,name=hget,proto3,oneof"`
+}
+
+type Command_Hdel struct {
+	Hdel *HdelRequest `protobuf:"bytes,36,opt,name=hdel,proto3,oneof"`
+}
+
+type Command_Hexists struct {
+	Hexists *HexistsRequest `protobuf:"bytes,37,opt,name=hexists,proto3,oneof"`
+}
+
+type Command_Hlen struct {
+	Hlen *HlenRequest `protobuf:"bytes,38,opt,name=hlen,proto3,oneof"`
+}
+
+type Command_Hkeys struct {
+	Hkeys *HkeysRequest `protobuf:"bytes,39,opt,name=hkeys,proto3,oneof"`
+}
+
+type Command_Hvals struct {
+	Hvals *HvalsRequest `protobuf:"bytes,40,opt,name=hvals,proto3,oneof"`
+}
+
+type Command_Hmget struct {
+	Hmget *HmgetRequest `protobuf:"bytes,41,opt,name=hmget,proto3,oneof"`
+}
+
+type Command_Hsetnx struct {
+	Hsetnx *HsetnxRequest `protobuf:"bytes,42,opt,name=hsetnx,proto3,oneof"`
+}
+
+type Command_Hincrby struct {
+	Hincrby *HincrbyRequest `protobuf:"bytes,43,opt,name=hincrby,proto3,oneof"`
+}
+
+type Command_Hincrbyfloat struct {
+	Hincrbyfloat *HincrbyFloatRequest `protobuf:"bytes,44,opt,name=hincrbyfloat,proto3,oneof"`
+}
+
+type Command_Sadd struct {
+	Sadd *SaddRequest `protobuf:"bytes,45,opt,name=sadd,proto3,oneof"`
+}
+
+type Command_Srem struct {
+	Srem *SremRequest `protobuf:"bytes,46,opt,name=srem,proto3,oneof"`
+}
+
+type Command_Sismember struct {
+	Sismember *SismemberRequest `protobuf:"bytes,47,opt,name=sismember,proto3,oneof"`
+}
+
+type Command_Smembers struct {
+	Smembers *SmembersRequest `protobuf:"bytes,48,opt,name=smembers,proto3,oneof"`
+}
+
+type Command_Scard struct {
+	Scard *ScardRequest `protobuf:"bytes,49,opt,name=scard,proto3,oneof"`
+}
+
+type Command_Spop struct {
+	Spop *SpopRequest `protobuf:"bytes,50,opt,name=spop,proto3,oneof"`
+}
+
+type Command_Sinter struct {
+	Sinter *SinterRequest `protobuf:"bytes,51,opt,name=sinter,proto3,oneof"`
+}
+
+type Command_Sunion struct {
+	Sunion *SunionRequest `protobuf:"bytes,52,opt,name=sunion,proto3,oneof"`
+}
+
+type Command_Sdiff struct {
+	Sdiff *SdiffRequest `protobuf:"bytes,53,opt,name=sdiff,proto3,oneof"`
+}
+
+type Command_Sinterstore struct {
+	Sinterstore *SinterStoreRequest `protobuf:"bytes,54,opt,name=sinterstore,proto3,oneof"`
+}
+
+type Command_Sunionstore struct {
+	Sunionstore *SunionStoreRequest `protobuf:"bytes,55,opt,name=sunionstore,proto3,oneof"`
+}
+
+type Command_Sdiffstore struct {
+	Sdiffstore *SdiffStoreRequest `protobuf:"bytes,56,opt,name=sdiffstore,proto3,oneof"`
+}
+
+type Command_Zadd struct {
+	Zadd *ZaddRequest `protobuf:"bytes,57,opt,name=zadd,proto3,oneof"`
+}
+
+type Command_Zrem struct {
+	Zrem *ZremRequest `protobuf:"bytes,58,opt,name=zrem,proto3,oneof"`
+}
+
+type Command_Zscore struct {
+	Zscore *ZscoreRequest `protobuf:"bytes,59,opt,name=zscore,proto3,oneof"`
+}
+
+type Command_Zrank struct {
+	Zrank *ZrankRequest `protobuf:"bytes,60,opt,name=zrank,proto3,oneof"`
+}
+
+type Command_Zrevrank struct {
+	Zrevrank *ZrevrankRequest `protobuf:"bytes,61,opt,name=zrevrank,proto3,oneof"`
+}
+
+type Command_Zrange struct {
+	Zrange *ZrangeRequest `protobuf:"bytes,62,opt,name=zrange,proto3,oneof"`
+}
+
+type Command_Zrangebyscore struct {
+	Zrangebyscore *ZrangeByScoreRequest `protobuf:"bytes,63,opt,name=zrangebyscore,proto3,oneof"`
+}
+
+type Command_Zcount struct {
+	Zcount *ZcountRequest `protobuf:"bytes,64,opt,name=zcount,proto3,oneof"`
+}
+
+type Command_Zpopmin struct {
+	Zpopmin *ZpopMinRequest `protobuf:"bytes,65,opt,name=zpopmin,proto3,oneof"`
+}
+
+type Command_Zpopmax struct {
+	Zpopmax *ZpopMaxRequest `protobuf:"bytes,66,opt,name=zpopmax,proto3,oneof"`
+}
+
+type Command_Stats struct {
+	Stats *StatsRequest `protobuf:"bytes,67,opt,name=stats,proto3,oneof"`
+}
+
+type Command_Dbsize struct {
+	Dbsize *DbSizeRequest `protobuf:"bytes,68,opt,name=dbsize,proto3,oneof"`
+}
+
+type Command_Flushdb struct {
+	Flushdb *FlushDbRequest `protobuf:"bytes,69,opt,name=flushdb,proto3,oneof"`
+}
+
+type Command_Move struct {
+	Move *MoveRequest `protobuf:"bytes,70,opt,name=move,proto3,oneof"`
+}
+
+func (*Command_Get) isCommand_Op() {}
+
+func (*Command_Gets) isCommand_Op() {}
+
+func (*Command_Version) isCommand_Op() {}
+
+func (*Command_Set) isCommand_Op() {}
+
+func (*Command_Getset) isCommand_Op() {}
+
+func (*Command_Cas) isCommand_Op() {}
+
+func (*Command_Incr) isCommand_Op() {}
+
+func (*Command_Decr) isCommand_Op() {}
+
+func (*Command_Incrby) isCommand_Op() {}
+
+func (*Command_Incrbyfloat) isCommand_Op() {}
+
+func (*Command_Unset) isCommand_Op() {}
+
+func (*Command_Expire) isCommand_Op() {}
+
+func (*Command_Expireat) isCommand_Op() {}
+
+func (*Command_Persist) isCommand_Op() {}
+
+func (*Command_Ttl) isCommand_Op() {}
+
+func (*Command_Pttl) isCommand_Op() {}
+
+func (*Command_Keys) isCommand_Op() {}
+
+func (*Command_Scan) isCommand_Op() {}
+
+func (*Command_Rpush) isCommand_Op() {}
+
+func (*Command_Lpush) isCommand_Op() {}
+
+func (*Command_Pop) isCommand_Op() {}
+
+func (*Command_Lpop) isCommand_Op() {}
+
+func (*Command_Blpop) isCommand_Op() {}
+
+func (*Command_Brpop) isCommand_Op() {}
+
+func (*Command_Lgetall) isCommand_Op() {}
+
+func (*Command_Lget) isCommand_Op() {}
+
+func (*Command_Llen) isCommand_Op() {}
+
+func (*Command_Lrange) isCommand_Op() {}
+
+func (*Command_Lset) isCommand_Op() {}
+
+func (*Command_Lrem) isCommand_Op() {}
+
+func (*Command_Ltrim) isCommand_Op() {}
+
+func (*Command_Linsert) isCommand_Op() {}
+
+func (*Command_Hset) isCommand_Op() {}
+
+func (*Command_Hgetall) isCommand_Op() {}
+
+func (*Command_Hget) isCommand_Op() {}
+
+func (*Command_Hdel) isCommand_Op() {}
+
+func (*Command_Hexists) isCommand_Op() {}
+
+func (*Command_Hlen) isCommand_Op() {}
+
+func (*Command_Hkeys) isCommand_Op() {}
+
+func (*Command_Hvals) isCommand_Op() {}
+
+func (*Command_Hmget) isCommand_Op() {}
+
+func (*Command_Hsetnx) isCommand_Op() {}
+
+func (*Command_Hincrby) isCommand_Op() {}
+
+func (*Command_Hincrbyfloat) isCommand_Op() {}
+
+func (*Command_Sadd) isCommand_Op() {}
+
+func (*Command_Srem) isCommand_Op() {}
+
+func (*Command_Sismember) isCommand_Op() {}
+
+func (*Command_Smembers) isCommand_Op() {}
+
+func (*Command_Scard) isCommand_Op() {}
+
+func (*Command_Spop) isCommand_Op() {}
+
+func (*Command_Sinter) isCommand_Op() {}
+
+func (*Command_Sunion) isCommand_Op() {}
+
+func (*Command_Sdiff) isCommand_Op() {}
+
+func (*Command_Sinterstore) isCommand_Op() {}
+
+func (*Command_Sunionstore) isCommand_Op() {}
+
+func (*Command_Sdiffstore) isCommand_Op() {}
+
+func (*Command_Zadd) isCommand_Op() {}
+
+func (*Command_Zrem) isCommand_Op() {}
+
+func (*Command_Zscore) isCommand_Op() {}
+
+func (*Command_Zrank) isCommand_Op() {}
+
+func (*Command_Zrevrank) isCommand_Op() {}
+
+func (*Command_Zrange) isCommand_Op() {}
+
+func (*Command_Zrangebyscore) isCommand_Op() {}
+
+func (*Command_Zcount) isCommand_Op() {}
+
+func (*Command_Zpopmin) isCommand_Op() {}
+
+func (*Command_Zpopmax) isCommand_Op() {}
+
+func (*Command_Stats) isCommand_Op() {}
+
+func (*Command_Dbsize) isCommand_Op() {}
+
+func (*Command_Flushdb) isCommand_Op() {}
+
+func (*Command_Move) isCommand_Op() {}
+
+// Result is the response of a command, with the field of its operation,
+// or its error.
+type Result struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*Result_Get
+	//	*Result_Gets
+	//	*Result_Version
+	//	*Result_Set
+	//	*Result_Getset
+	//	*Result_Cas
+	//	*Result_Incr
+	//	*Result_Decr
+	//	*Result_Incrby
+	//	*Result_Incrbyfloat
+	//	*Result_Unset
+	//	*Result_Expire
+	//	*Result_Expireat
+	//	*Result_Persist
+	//	*Result_Ttl
+	//	*Result_Pttl
+	//	*Result_Keys
+	//	*Result_Scan
+	//	*Result_Rpush
+	//	*Result_Lpush
+	//	*Result_Pop
+	//	*Result_Lpop
+	//	*Result_Blpop
+	//	*Result_Brpop
+	//	*Result_Lgetall
+	//	*Result_Lget
+	//	*Result_Llen
+	//	*Result_Lrange
+	//	*Result_Lset
+	//	*Result_Lrem
+	//	*Result_Ltrim
+	//	*Result_Linsert
+	//	*Result_Hset
+	//	*Result_Hgetall
+	//	*Result_Hget
+	//	*Result_Hdel
+	//	*Result_Hexists
+	//	*Result_Hlen
+	//	*Result_Hkeys
+	//	*Result_Hvals
+	//	*Result_Hmget
+	//	*Result_Hsetnx
+	//	*Result_Hincrby
+	//	*Result_Hincrbyfloat
+	//	*Result_Sadd
+	//	*Result_Srem
+	//	*Result_Sismember
+	//	*Result_Smembers
+	//	*Result_Scard
+	//	*Result_Spop
+	//	*Result_Sinter
+	//	*Result_Sunion
+	//	*Result_Sdiff
+	//	*Result_Sinterstore
+	//	*Result_Sunionstore
+	//	*Result_Sdiffstore
+	//	*Result_Zadd
+	//	*Result_Zrem
+	//	*Result_Zscore
+	//	*Result_Zrank
+	//	*Result_Zrevrank
+	//	*Result_Zrange
+	//	*Result_Zrangebyscore
+	//	*Result_Zcount
+	//	*Result_Zpopmin
+	//	*Result_Zpopmax
+	//	*Result_Stats
+	//	*Result_Dbsize
+	//	*Result_Flushdb
+	//	*Result_Move
+	Result isResult_Result `protobuf_oneof:"result"`
+	// error is the message of a failed command, code its status code.
+	Error         string `protobuf:"bytes,100,opt,name=error,proto3" json:"error,omitempty"`
+	Code          int32  `protobuf:"varint,101,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Result) Reset() {
+	*x = Result{}
+	mi := &file_cache_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Result) ProtoMessage() {}
+
+func (x *Result) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Result.ProtoReflect.Descriptor instead.
+func (*Result) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *Result) GetResult() isResult_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *Result) GetGet() *GetResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Get); ok {
+			return x.Get
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetGets() *GetsResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Gets); ok {
+			return x.Gets
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetVersion() *VersionResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Version); ok {
+			return x.Version
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetSet() *SetResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Set); ok {
+			return x.Set
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetGetset() *GetSetResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Getset); ok {
+			return x.Getset
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetCas() *CasResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Cas); ok {
+			return x.Cas
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetIncr() *IncrResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Incr); ok {
+			return x.Incr
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetDecr() *DecrResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Decr); ok {
+			return x.Decr
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetIncrby() *IncrByResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Incrby); ok {
+			return x.Incrby
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetIncrbyfloat() *IncrByFloatResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Incrbyfloat); ok {
+			return x.Incrbyfloat
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetUnset() *UnsetResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Unset); ok {
+			return x.Unset
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetExpire() *ExpireResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Expire); ok {
+			return x.Expire
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetExpireat() *ExpireAtResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Expireat); ok {
+			return x.Expireat
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetPersist() *PersistResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Persist); ok {
+			return x.Persist
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetTtl() *TtlResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Ttl); ok {
+			return x.Ttl
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetPttl() *PttlResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Pttl); ok {
+			return x.Pttl
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetKeys() *KeysResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Keys); ok {
+			return x.Keys
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetScan() *ScanResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Scan); ok {
+			return x.Scan
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetRpush() *RpushResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Rpush); ok {
+			return x.Rpush
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetLpush() *LpushResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Lpush); ok {
+			return x.Lpush
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetPop() *PopResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Pop); ok {
+			return x.Pop
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetLpop() *LpopResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Lpop); ok {
+			return x.Lpop
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetBlpop() *BlpopResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Blpop); ok {
+			return x.Blpop
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetBrpop() *BrpopResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Brpop); ok {
+			return x.Brpop
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetLgetall() *LgetallResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Lgetall); ok {
+			return x.Lgetall
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetLget() *LgetResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Lget); ok {
+			return x.Lget
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetLlen() *LlenResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Llen); ok {
+			return x.Llen
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetLrange() *LrangeResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Lrange); ok {
+			return x.Lrange
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetLset() *LsetResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Lset); ok {
+			return x.Lset
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetLrem() *LremResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Lrem); ok {
+			return x.Lrem
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetLtrim() *LtrimResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Ltrim); ok {
+			return x.Ltrim
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetLinsert() *LinsertResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Linsert); ok {
+			return x.Linsert
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetHset() *HsetResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Hset); ok {
+			return x.Hset
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetHgetall() *HgetallResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Hgetall); ok {
+			return x.Hgetall
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetHget() *HgetResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Hget); ok {
+			return x.Hget
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetHdel() *HdelResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Hdel); ok {
+			return x.Hdel
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetHexists() *HexistsResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Hexists); ok {
+			return x.Hexists
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetHlen() *HlenResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Hlen); ok {
+			return x.Hlen
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetHkeys() *HkeysResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Hkeys); ok {
+			return x.Hkeys
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetHvals() *HvalsResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Hvals); ok {
+			return x.Hvals
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetHmget() *HmgetResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Hmget); ok {
+			return x.Hmget
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetHsetnx() *HsetnxResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Hsetnx); ok {
+			return x.Hsetnx
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetHincrby() *HincrbyResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Hincrby); ok {
+			return x.Hincrby
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetHincrbyfloat() *HincrbyFloatResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Hincrbyfloat); ok {
+			return x.Hincrbyfloat
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetSadd() *SaddResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Sadd); ok {
+			return x.Sadd
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetSrem() *SremResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Srem); ok {
+			return x.Srem
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetSismember() *SismemberResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Sismember); ok {
+			return x.Sismember
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetSmembers() *SmembersResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Smembers); ok {
+			return x.Smembers
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetScard() *ScardResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Scard); ok {
+			return x.Scard
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetSpop() *SpopResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Spop); ok {
+			return x.Spop
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetSinter() *SinterResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Sinter); ok {
+			return x.Sinter
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetSunion() *SunionResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Sunion); ok {
+			return x.Sunion
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetSdiff() *SdiffResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Sdiff); ok {
+			return x.Sdiff
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetSinterstore() *SinterStoreResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Sinterstore); ok {
+			return x.Sinterstore
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetSunionstore() *SunionStoreResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Sunionstore); ok {
+			return x.Sunionstore
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetSdiffstore() *SdiffStoreResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Sdiffstore); ok {
+			return x.Sdiffstore
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetZadd() *ZaddResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Zadd); ok {
+			return x.Zadd
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetZrem() *ZremResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Zrem); ok {
+			return x.Zrem
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetZscore() *ZscoreResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Zscore); ok {
+			return x.Zscore
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetZrank() *ZrankResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Zrank); ok {
+			return x.Zrank
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetZrevrank() *ZrevrankResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Zrevrank); ok {
+			return x.Zrevrank
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetZrange() *ZrangeResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Zrange); ok {
+			return x.Zrange
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetZrangebyscore() *ZrangeByScoreResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Zrangebyscore); ok {
+			return x.Zrangebyscore
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetZcount() *ZcountResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Zcount); ok {
+			return x.Zcount
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetZpopmin() *ZpopMinResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Zpopmin); ok {
+			return x.Zpopmin
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetZpopmax() *ZpopMaxResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Zpopmax); ok {
+			return x.Zpopmax
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetStats() *StatsResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Stats); ok {
+			return x.Stats
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetDbsize() *DbSizeResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Dbsize); ok {
+			return x.Dbsize
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetFlushdb() *FlushDbResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Flushdb); ok {
+			return x.Flushdb
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetMove() *MoveResponse {
+	if x != nil {
+		if x, ok := x.Result.(*Result_Move); ok {
+			return x.Move
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Result) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+type isResult_Result interface {
+	isResult_Result()
+}
+
+type Result_Get struct {
+	Get *GetResponse `protobuf:"bytes,1,opt,name=get,proto3,oneof"`
+}
+
+type Result_Gets struct {
+	Gets *GetsResponse `protobuf:"bytes,2,opt,name=gets,proto3,oneof"`
+}
+
+type Result_Version struct {
+	Version *VersionResponse `protobuf:"bytes,3,opt,name=version,proto3,oneof"`
+}
+
+type Result_Set struct {
+	Set *SetResponse `protobuf:"bytes,4,opt,name=set,proto3,oneof"`
+}
+
+type Result_Getset struct {
+	Getset *GetSetResponse `protobuf:"bytes,5,opt,name=getset,proto3,oneof"`
+}
+
+type Result_Cas struct {
+	Cas *CasResponse `protobuf:"bytes,6,opt,name=cas,proto3,oneof"`
+}
+
+type Result_Incr struct {
+	Incr *IncrResponse `protobuf:"bytes,7,opt,name=incr,proto3,oneof"`
+}
+
+type Result_Decr struct {
+	Decr *DecrResponse `protobuf:"bytes,8,opt,name=decr,proto3,oneof"`
+}
+
+type Result_Incrby struct {
+	Incrby *IncrByResponse `protobuf:"bytes,9,opt,name=incrby,proto3,oneof"`
+}
+
+type Result_Incrbyfloat struct {
+	Incrbyfloat *IncrByFloatResponse `protobuf:"bytes,10,opt,name=incrbyfloat,proto3,oneof"`
+}
+
+type Result_Unset struct {
+	Unset *UnsetResponse `protobuf:"bytes,11,opt,name=unset,proto3,oneof"`
+}
+
+type Result_Expire struct {
+	Expire *ExpireResponse `protobuf:"bytes,12,opt,name=expire,proto3,oneof"`
+}
+
+type Result_Expireat struct {
+	Expireat *ExpireAtResponse `protobuf:"bytes,13,opt,name=expireat,proto3,oneof"`
+}
+
+type Result_Persist struct {
+	Persist *PersistResponse `protobuf:"bytes,14,opt,name=persist,proto3,oneof"`
+}
+
+type Result_Ttl struct {
+	Ttl *TtlResponse `protobuf:"bytes,15,opt,name=ttl,proto3,oneof"`
+}
+
+type Result_Pttl struct {
+	Pttl *PttlResponse `protobuf:"bytes,16,opt,name=pttl,proto3,oneof"`
+}
+
+type Result_Keys struct {
+	Keys *KeysResponse `protobuf:"bytes,17,opt,name=keys,proto3,oneof"`
+}
+
+type Result_Scan struct {
+	Scan *ScanResponse `protobuf:"bytes,18,opt,name=scan,proto3,oneof"`
+}
+
+type Result_Rpush struct {
+	Rpush *RpushResponse `protobuf:"bytes,19,opt,name=rpush,proto3,oneof"`
+}
+
+type Result_Lpush struct {
+	Lpush *LpushResponse `protobuf:"bytes,20,opt,name=lpush,proto3,oneof"`
+}
+
+type Result_Pop struct {
+	Pop *PopResponse `protobuf:"bytes,21,opt,name=pop,proto3,oneof"`
+}
+
+type Result_Lpop struct {
+	Lpop *LpopResponse `protobuf:"bytes,22,opt,name=lpop,proto3,oneof"`
+}
+
+type Result_Blpop struct {
+	Blpop *BlpopResponse `protobuf:"bytes,23,opt,name=blpop,proto3,oneof"`
+}
+
+type Result_Brpop struct {
+	Brpop *BrpopResponse `protobuf:"bytes,24,opt,name=brpop,proto3,oneof"`
+}
+
+type Result_Lgetall struct {
+	Lgetall *LgetallResponse `protobuf:"bytes,25,opt,name=lgetall,proto3,oneof"`
+}
+
+type Result_Lget struct {
+	Lget *LgetResponse `protobuf:"bytes,26,opt,name=lget,proto3,oneof"`
+}
+
+type Result_Llen struct {
+	Llen *LlenResponse `protobuf:"bytes,27,opt,name=llen,proto3,oneof"`
+}
+
+type Result_Lrange struct {
+	Lrange *LrangeResponse `protobuf:"bytes,28,opt,name=lrange,proto3,oneof"`
+}
+
+type Result_Lset struct {
+	Lset *LsetResponse `protobuf:"bytes,29,opt,name=lset,proto3,oneof"`
+}
+
+type Result_Lrem struct {
+	Lrem *LremResponse `protobuf:"bytes,30,opt,name=lrem,proto3,oneof"`
+}
+
+type Result_Ltrim struct {
+	Ltrim *LtrimResponse `protobuf:"bytes,31,opt,name=ltrim,proto3,oneof"`
+}
+
+type Result_Linsert struct {
+	Linsert *LinsertResponse `protobuf:"bytes,32,opt,name=linsert,proto3,oneof"`
+}
+
+type Result_Hset struct {
+	Hset *HsetResponse `protobuf:"bytes,33,opt,name=hset,proto3,oneof"`
+}
+
+type Result_Hgetall struct {
+	Hgetall *HgetallResponse `protobuf:"bytes,34,opt,name=hgetall,proto3,oneof"`
+}
+
+type Result_Hget struct {
+	Hget *HgetResponse `protobuf:"bytes,35,opt,name=hget,proto3,oneof"`
+}
+
+type Result_Hdel struct {
+	Hdel *HdelResponse `protobuf:"bytes,36,opt,name=hdel,proto3,oneof"`
+}
+
+type Result_Hexists struct {
+	Hexists *HexistsResponse `protobuf:"bytes,37,opt,name=hexists,proto3,oneof"`
+}
+
+type Result_Hlen struct {
+	Hlen *HlenResponse `protobuf:"bytes,38,opt,name=hlen,proto3,oneof"`
+}
+
+type Result_Hkeys struct {
+	Hkeys *HkeysResponse `protobuf:"bytes,39,opt,name=hkeys,proto3,oneof"`
+}
+
+type Result_Hvals struct {
+	Hvals *HvalsResponse `protobuf:"bytes,40,opt,name=hvals,proto3,oneof"`
+}
+
+type Result_Hmget struct {
+	Hmget *HmgetResponse `protobuf:"bytes,41,opt,name=hmget,proto3,oneof"`
+}
+
+type Result_Hsetnx struct {
+	Hsetnx *HsetnxResponse `protobuf:"bytes,42,opt,name=hsetnx,proto3,oneof"`
+}
+
+type Result_Hincrby struct {
+	Hincrby *HincrbyResponse `protobuf:"bytes,43,opt,name=hincrby,proto3,oneof"`
+}
+
+type Result_Hincrbyfloat struct {
+	Hincrbyfloat *HincrbyFloatResponse `protobuf:"bytes,44,opt,name=hincrbyfloat,proto3,oneof"`
+}
+
+type Result_Sadd struct {
+	Sadd *SaddResponse `protobuf:"bytes,45,opt,name=sadd,proto3,oneof"`
+}
+
+type Result_Srem struct {
+	Srem *SremResponse `protobuf:"bytes,46,opt,name=srem,proto3,oneof"`
+}
+
+type Result_Sismember struct {
+	Sismember *SismemberResponse `protobuf:"bytes,47,opt,name=sismember,proto3,oneof"`
+}
+
+type Result_Smembers struct {
+	Smembers *SmembersResponse `protobuf:"bytes,48,opt,name=smembers,proto3,oneof"`
+}
+
+type Result_Scard struct {
+	Scard *ScardResponse `protobuf:"bytes,49,opt,name=scard,proto3,oneof"`
+}
+
+type Result_Spop struct {
+	Spop *SpopResponse `protobuf:"bytes,50,opt,name=spop,proto3,oneof"`
+}
+
+type Result_Sinter struct {
+	Sinter *SinterResponse `protobuf:"bytes,51,opt,name=sinter,proto3,oneof"`
+}
+
+type Result_Sunion struct {
+	Sunion *SunionResponse `protobuf:"bytes,52,opt,name=sunion,proto3,oneof"`
+}
+
+type Result_Sdiff struct {
+	Sdiff *SdiffResponse `protobuf:"bytes,53,opt,name=sdiff,proto3,oneof"`
+}
+
+type Result_Sinterstore struct {
+	Sinterstore *SinterStoreResponse `protobuf:"bytes,54,opt,name=sinterstore,proto3,oneof"`
+}
+
+type Result_Sunionstore struct {
+	Sunionstore *SunionStoreResponse `protobuf:"bytes,55,opt,name=sunionstore,proto3,oneof"`
+}
+
+type Result_Sdiffstore struct {
+	Sdiffstore *SdiffStoreResponse `protobuf:"bytes,56,opt,name=sdiffstore,proto3,oneof"`
+}
+
+type Result_Zadd struct {
+	Zadd *ZaddResponse `protobuf:"bytes,57,opt,name=zadd,proto3,oneof"`
+}
+
+type Result_Zrem struct {
+	Zrem *ZremResponse `protobuf:"bytes,58,opt,name=zrem,proto3,oneof"`
+}
+
+type Result_Zscore struct {
+	Zscore *ZscoreResponse `protobuf:"bytes,59,opt,name=zscore,proto3,oneof"`
+}
+
+type Result_Zrank struct {
+	Zrank *ZrankResponse `protobuf:"bytes,60,opt,name=zrank,proto3,oneof"`
+}
+
+type Result_Zrevrank struct {
+	Zrevrank *ZrevrankResponse `protobuf:"bytes,61,opt,name=zrevrank,proto3,oneof"`
+}
+
+type Result_Zrange struct {
+	Zrange *ZrangeResponse `protobuf:"bytes,62,opt,name=zrange,proto3,oneof"`
+}
+
+type Result_Zrangebyscore struct {
+	Zrangebyscore *ZrangeByScoreResponse `protobuf:"bytes,63,opt,name=zrangebyscore,proto3,oneof"`
+}
+
+type Result_Zcount struct {
+	Zcount *ZcountResponse `protobuf:"bytes,64,opt,name=zcount,proto3,oneof"`
+}
+
+type Result_Zpopmin struct {
+	Zpopmin *ZpopMinResponse `protobuf:"bytes,65,opt,name=zpopmin,proto3,oneof"`
+}
+
+type Result_Zpopmax struct {
+	Zpopmax *ZpopMaxResponse `protobuf:"bytes,66,opt,name=zpopmax,proto3,oneof"`
+}
+
+type Result_Stats struct {
+	Stats *StatsResponse `protobuf:"bytes,67,opt,name=stats,proto3,oneof"`
+}
+
+type Result_Dbsize struct {
+	Dbsize *DbSizeResponse `protobuf:"bytes,68,opt,name=dbsize,proto3,oneof"`
+}
+
+type Result_Flushdb struct {
+	Flushdb *FlushDbResponse `protobuf:"bytes,69,opt,name=flushdb,proto3,oneof"`
+}
+
+type Result_Move struct {
+	Move *MoveResponse `protobuf:"bytes,70,opt,name=move,proto3,oneof"`
+}
+
+func (*Result_Get) isResult_Result() {}
+
+func (*Result_Gets) isResult_Result() {}
+
+func (*Result_Version) isResult_Result() {}
+
+func (*Result_Set) isResult_Result() {}
+
+func (*Result_Getset) isResult_Result() {}
+
+func (*Result_Cas) isResult_Result() {}
+
+func (*Result_Incr) isResult_Result() {}
+
+func (*Result_Decr) isResult_Result() {}
+
+func (*Result_Incrby) isResult_Result() {}
+
+func (*Result_Incrbyfloat) isResult_Result() {}
+
+func (*Result_Unset) isResult_Result() {}
+
+func (*Result_Expire) isResult_Result() {}
+
+func (*Result_Expireat) isResult_Result() {}
+
+func (*Result_Persist) isResult_Result() {}
+
+func (*Result_Ttl) isResult_Result() {}
+
+func (*Result_Pttl) isResult_Result() {}
+
+func (*Result_Keys) isResult_Result() {}
+
+func (*Result_Scan) isResult_Result() {}
+
+func (*Result_Rpush) isResult_Result() {}
+
+func (*Result_Lpush) isResult_Result() {}
+
+func (*Result_Pop) isResult_Result() {}
+
+func (*Result_Lpop) isResult_Result() {}
+
+func (*Result_Blpop) isResult_Result() {}
+
+func (*Result_Brpop) isResult_Result() {}
+
+func (*Result_Lgetall) isResult_Result() {}
+
+func (*Result_Lget) isResult_Result() {}
+
+func (*Result_Llen) isResult_Result() {}
+
+func (*Result_Lrange) isResult_Result() {}
+
+func (*Result_Lset) isResult_Result() {}
+
+func (*Result_Lrem) isResult_Result() {}
+
+func (*Result_Ltrim) isResult_Result() {}
+
+func (*Result_Linsert) isResult_Result() {}
+
+func (*Result_Hset) isResult_Result() {}
+
+func (*Result_Hgetall) isResult_Result() {}
+
+func (*Result_Hget) isResult_Result() {}
+
+func (*Result_Hdel) isResult_Result() {}
+
+func (*Result_Hexists) isResult_Result() {}
+
+func (*Result_Hlen) isResult_Result() {}
+
+func (*Result_Hkeys) isResult_Result() {}
+
+func (*Result_Hvals) isResult_Result() {}
+
+func (*Result_Hmget) isResult_Result() {}
+
+func (*Result_Hsetnx) isResult_Result() {}
+
+func (*Result_Hincrby) isResult_Result() {}
+
+func (*Result_Hincrbyfloat) isResult_Result() {}
+
+func (*Result_Sadd) isResult_Result() {}
+
+func (*Result_Srem) isResult_Result() {}
+
+func (*Result_Sismember) isResult_Result() {}
+
+func (*Result_Smembers) isResult_Result() {}
+
+func (*Result_Scard) isResult_Result() {}
+
+func (*Result_Spop) isResult_Result() {}
+
+func (*Result_Sinter) isResult_Result() {}
+
+func (*Result_Sunion) isResult_Result() {}
+
+func (*Result_Sdiff) isResult_Result() {}
+
+func (*Result_Sinterstore) isResult_Result() {}
+
+func (*Result_Sunionstore) isResult_Result() {}
+
+func (*Result_Sdiffstore) isResult_Result() {}
+
+func (*Result_Zadd) isResult_Result() {}
+
+func (*Result_Zrem) isResult_Result() {}
+
+func (*Result_Zscore) isResult_Result() {}
+
+func (*Result_Zrank) isResult_Result() {}
+
+func (*Result_Zrevrank) isResult_Result() {}
+
+func (*Result_Zrange) isResult_Result() {}
+
+func (*Result_Zrangebyscore) isResult_Result() {}
+
+func (*Result_Zcount) isResult_Result() {}
+
+func (*Result_Zpopmin) isResult_Result() {}
+
+func (*Result_Zpopmax) isResult_Result() {}
+
+func (*Result_Stats) isResult_Result() {}
+
+func (*Result_Dbsize) isResult_Result() {}
+
+func (*Result_Flushdb) isResult_Result() {}
+
+func (*Result_Move) isResult_Result() {}
+
+type TxRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// watch maps keys to the versions they must still have.
+	Watch         map[string]int64 `protobuf:"bytes,1,rep,name=watch,proto3" json:"watch,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Commands      []*Command       `protobuf:"bytes,2,rep,name=commands,proto3" json:"commands,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TxRequest) Reset() {
+	*x = TxRequest{}
+	mi := &file_cache_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxRequest) ProtoMessage() {}
+
+func (x *TxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxRequest.ProtoReflect.Descriptor instead.
+func (*TxRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *TxRequest) GetWatch() map[string]int64 {
+	if x != nil {
+		return x.Watch
+	}
+	return nil
+}
+
+func (x *TxRequest) GetCommands() []*Command {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+type TxResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*Result              `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TxResponse) Reset() {
+	*x = TxResponse{}
+	mi := &file_cache_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxResponse) ProtoMessage() {}
+
+func (x *TxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxResponse.ProtoReflect.Descriptor instead.
+func (*TxResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *TxResponse) GetResults() []*Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type PublishRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Message       *Value                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishRequest) Reset() {
+	*x = PublishRequest{}
+	mi := &file_cache_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishRequest) ProtoMessage() {}
+
+func (x *PublishRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishRequest.ProtoReflect.Descriptor instead.
+func (*PublishRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *PublishRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *PublishRequest) GetMessage() *Value {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type PublishResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receivers     int64                  `protobuf:"varint,1,opt,name=receivers,proto3" json:"receivers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishResponse) Reset() {
+	*x = PublishResponse{}
+	mi := &file_cache_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishResponse) ProtoMessage() {}
+
+func (x *PublishResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishResponse.ProtoReflect.Descriptor instead.
+func (*PublishResponse) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *PublishResponse) GetReceivers() int64 {
+	if x != nil {
+		return x.Receivers
+	}
+	return 0
+}
+
+type SubscribeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channels      []string               `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	Patterns      []string               `protobuf:"bytes,2,rep,name=patterns,proto3" json:"patterns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	mi := &file_cache_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *SubscribeRequest) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetPatterns() []string {
+	if x != nil {
+		return x.Patterns
+	}
+	return nil
+}
+
+type Message struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Pattern       string                 `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Payload       *Value                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	mi := &file_cache_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *Message) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *Message) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *Message) GetPayload() *Value {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type NotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pattern       string                 `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Events        []string               `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationsRequest) Reset() {
+	*x = NotificationsRequest{}
+	mi := &file_cache_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationsRequest) ProtoMessage() {}
+
+func (x *NotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationsRequest.ProtoReflect.Descriptor instead.
+func (*NotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *NotificationsRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *NotificationsRequest) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type KeyspaceEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Op            string                 `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyspaceEvent) Reset() {
+	*x = KeyspaceEvent{}
+	mi := &file_cache_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyspaceEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyspaceEvent) ProtoMessage() {}
+
+func (x *KeyspaceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyspaceEvent.ProtoReflect.Descriptor instead.
+func (*KeyspaceEvent) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *KeyspaceEvent) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KeyspaceEvent) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *KeyspaceEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *KeyspaceEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+var File_cache_proto protoreflect.FileDescriptor
+
+const file_cache_proto_rawDesc = "" +
+	"\n" +
+	"\vcache.proto\x12\vsimplecache\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbc\x01\n" +
+	"\x05Value\x12\x1f\n" +
+	"\n" +
+	"null_value\x18\x01 \x01(\bH\x00R\tnullValue\x12\x1f\n" +
+	"\n" +
+	"bool_value\x18\x02 \x01(\bH\x00R\tboolValue\x12#\n" +
+	"\fnumber_value\x18\x03 \x01(\tH\x00R\vnumberValue\x12#\n" +
+	"\fstring_value\x18\x04 \x01(\tH\x00R\vstringValue\x12\x1f\n" +
+	"\n" +
+	"json_value\x18\x05 \x01(\fH\x00R\tjsonValueB\x06\n" +
+	"\x04kind\"\x1e\n" +
+	"\n" +
+	"GetRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"7\n" +
+	"\vGetResponse\x12(\n" +
+	"\x05value\x18\x01 \x01(\v2\x12.simplecache.ValueR\x05value\"\x1f\n" +
+	"\vGetsRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"R\n" +
+	"\fGetsResponse\x12(\n" +
+	"\x05value\x18\x01 \x01(\v2\x12.simplecache.ValueR\x05value\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"\"\n" +
+	"\x0eVersionRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"+\n" +
+	"\x0fVersionResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\"%\n" +
+	"\x0fVersionsRequest\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\"\x98\x01\n" +
+	"\x10VersionsResponse\x12G\n" +
+	"\bversions\x18\x01 \x03(\v2+.simplecache.VersionsResponse.VersionsEntryR\bversions\x1a;\n" +
+	"\rVersionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\x82\x01\n" +
+	"\n" +
+	"SetRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.simplecache.ValueR\x05value\x12\x18\n" +
+	"\aexpired\x18\x03 \x01(\x03R\aexpired\x12\x0e\n" +
+	"\x02nx\x18\x04 \x01(\bR\x02nx\x12\x0e\n" +
+	"\x02xx\x18\x05 \x01(\bR\x02xx\"9\n" +
+	"\vSetResponse\x12\x10\n" +
+	"\x03set\x18\x01 \x01(\bR\x03set\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"e\n" +
+	"\rGetSetRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.simplecache.ValueR\x05value\x12\x18\n" +
+	"\aexpired\x18\x03 \x01(\x03R\aexpired\"T\n" +
+	"\x0eGetSetResponse\x12(\n" +
+	"\x05value\x18\x01 \x01(\v2\x12.simplecache.ValueR\x05value\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"|\n" +
+	"\n" +
+	"CasRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.simplecache.ValueR\x05value\x12\x18\n" +
+	"\aexpired\x18\x03 \x01(\x03R\aexpired\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x03R\aversion\"'\n" +
+	"\vCasResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\"S\n" +
+	"\vIncrRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x18\n" +
+	"\aexpired\x18\x02 \x01(\x03R\aexpired\x12\x18\n" +
+	"\arefresh\x18\x03 \x01(\bR\arefresh\"$\n" +
+	"\fIncrResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x03R\x05value\"S\n" +
+	"\vDecrRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x18\n" +
+	"\aexpired\x18\x02 \x01(\x03R\aexpired\x12\x18\n" +
+	"\arefresh\x18\x03 \x01(\bR\arefresh\"$\n" +
+	"\fDecrResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x03R\x05value\"k\n" +
+	"\rIncrByRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value\x12\x18\n" +
+	"\aexpired\x18\x03 \x01(\x03R\aexpired\x12\x18\n" +
+	"\arefresh\x18\x04 \x01(\bR\arefresh\"&\n" +
+	"\x0eIncrByResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x03R\x05value\"p\n" +
+	"\x12IncrByFloatRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12\x18\n" +
+	"\aexpired\x18\x03 \x01(\x03R\aexpired\x12\x18\n" +
+	"\arefresh\x18\x04 \x01(\bR\arefresh\"+\n" +
+	"\x13IncrByFloatResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\" \n" +
+	"\fUnsetRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"\x0f\n" +
+	"\rUnsetResponse\"_\n" +
+	"\rExpireRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x18\n" +
+	"\aseconds\x18\x02 \x01(\x03R\aseconds\x12\"\n" +
+	"\fmilliseconds\x18\x03 \x01(\x03R\fmilliseconds\"\x10\n" +
+	"\x0eExpireResponse\"d\n" +
+	"\x0fExpireAtRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\x12!\n" +
+	"\ftimestamp_ms\x18\x03 \x01(\x03R\vtimestampMs\"\x12\n" +
+	"\x10ExpireAtResponse\"\"\n" +
+	"\x0ePersistRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"/\n" +
+	"\x0fPersistResponse\x12\x1c\n" +
+	"\tpersisted\x18\x01 \x01(\bR\tpersisted\"\x1e\n" +
+	"\n" +
+	"TtlRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"\x1f\n" +
+	"\vTtlResponse\x12\x10\n" +
+	"\x03ttl\x18\x01 \x01(\x03R\x03ttl\"\x1f\n" +
+	"\vPttlRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\" \n" +
+	"\fPttlResponse\x12\x10\n" +
+	"\x03ttl\x18\x01 \x01(\x03R\x03ttl\"'\n" +
+	"\vKeysRequest\x12\x18\n" +
+	"\apattern\x18\x01 \x01(\tR\apattern\"\"\n" +
+	"\fKeysResponse\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\"e\n" +
+	"\vScanRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12\x14\n" +
+	"\x05match\x18\x03 \x01(\tR\x05match\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\":\n" +
+	"\fScanResponse\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x12\n" +
+	"\x04keys\x18\x02 \x03(\tR\x04keys\"f\n" +
+	"\fRpushRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
+	"\x06values\x18\x02 \x03(\v2\x12.simplecache.ValueR\x06values\x12\x18\n" +
+	"\aexpired\x18\x03 \x01(\x03R\aexpired\"'\n" +
+	"\rRpushResponse\x12\x16\n" +
+	"\x06length\x18\x01 \x01(\x03R\x06length\"f\n" +
+	"\fLpushRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
+	"\x06values\x18\x02 \x03(\v2\x12.simplecache.ValueR\x06values\x12\x18\n" +
+	"\aexpired\x18\x03 \x01(\x03R\aexpired\"'\n" +
+	"\rLpushResponse\x12\x16\n" +
+	"\x06length\x18\x01 \x01(\x03R\x06length\"\x1e\n" +
+	"\n" +
+	"PopRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"7\n" +
+	"\vPopResponse\x12(\n" +
+	"\x05value\x18\x01 \x01(\v2\x12.simplecache.ValueR\x05value\"\x1f\n" +
+	"\vLpopRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"8\n" +
+	"\fLpopResponse\x12(\n" +
+	"\x05value\x18\x01 \x01(\v2\x12.simplecache.ValueR\x05value\"<\n" +
+	"\fBlpopRequest\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\x12\x18\n" +
+	"\atimeout\x18\x02 \x01(\x01R\atimeout\"K\n" +
+	"\rBlpopResponse\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.simplecache.ValueR\x05value\"<\n" +
+	"\fBrpopRequest\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\x12\x18\n" +
+	"\atimeout\x18\x02 \x01(\x01R\atimeout\"K\n" +
+	"\rBrpopResponse\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.simplecache.ValueR\x05value\"\"\n" +
+	"\x0eLgetallRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"=\n" +
+	"\x0fLgetallResponse\x12*\n" +
+	"\x06values\x18\x01 \x03(\v2\x12.simplecache.ValueR\x06values\"5\n" +
+	"\vLgetRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\x03R\x05index\"8\n" +
+	"\fLgetResponse\x12(\n" +
+	"\x05value\x18\x01 \x01(\v2\x12.simplecache.ValueR\x05value\"\x1f\n" +
+	"\vLlenRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"&\n" +
+	"\fLlenResponse\x12\x16\n" +
+	"\x06length\x18\x01 \x01(\x03R\x06length\"K\n" +
+	"\rLrangeRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\x03R\x05start\x12\x12\n" +
+	"\x04stop\x18\x03 \x01(\x03R\x04stop\"<\n" +
+	"\x0eLrangeResponse\x12*\n" +
+	"\x06values\x18\x01 \x03(\v2\x12.simplecache.ValueR\x06values\"_\n" +
+	"\vLsetRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\x03R\x05index\x12(\n" +
+	"\x05value\x18\x03 \x01(\v2\x12.simplecache.ValueR\x05value\"\x0e\n" +
+	"\fLsetResponse\"_\n" +
+	"\vLremRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12(\n" +
+	"\x05value\x18\x03 \x01(\v2\x12.simplecache.ValueR\x05value\"(\n" +
+	"\fLremResponse\x12\x18\n" +
+	"\aremoved\x18\x01 \x01(\x03R\aremoved\"J\n" +
+	"\fLtrimRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\x03R\x05start\x12\x12\n" +
+	"\x04stop\x18\x03 \x01(\x03R\x04stop\"\x0f\n" +
+	"\rLtrimResponse\"\x8e\x01\n" +
+	"\x0eLinsertRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x16\n" +
+	"\x06before\x18\x02 \x01(\bR\x06before\x12(\n" +
+	"\x05pivot\x18\x03 \x01(\v2\x12.simplecache.ValueR\x05pivot\x12(\n" +
+	"\x05value\x18\x04 \x01(\v2\x12.simplecache.ValueR\x05value\")\n" +
+	"\x0fLinsertResponse\x12\x16\n" +
+	"\x06length\x18\x01 \x01(\x03R\x06length\"\xc6\x01\n" +
+	"\vHsetRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12<\n" +
+	"\x06fields\x18\x02 \x03(\v2$.simplecache.HsetRequest.FieldsEntryR\x06fields\x12\x18\n" +
+	"\aexpired\x18\x03 \x01(\x03R\aexpired\x1aM\n" +
+	"\vFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.simplecache.ValueR\x05value:\x028\x01\"\x0e\n" +
+	"\fHsetResponse\"\"\n" +
+	"\x0eHgetallRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"\xa2\x01\n" +
+	"\x0fHgetallResponse\x12@\n" +
+	"\x06fields\x18\x01 \x03(\v2(.simplecache.HgetallResponse.FieldsEntryR\x06fields\x1aM\n" +
+	"\vFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.simplecache.ValueR\x05value:\x028\x01\"5\n" +
+	"\vHgetRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\"8\n" +
+	"\fHgetResponse\x12(\n" +
+	"\x05value\x18\x01 \x01(\v2\x12.simplecache.ValueR\x05value\"7\n" +
+	"\vHdelRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x16\n" +
+	"\x06fields\x18\x02 \x03(\tR\x06fields\"(\n" +
+	"\fHdelResponse\x12\x18\n" +
+	"\aremoved\x18\x01 \x01(\x03R\aremoved\"8\n" +
+	"\x0eHexistsRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\")\n" +
+	"\x0fHexistsResponse\x12\x16\n" +
+	"\x06exists\x18\x01 \x01(\bR\x06exists\"\x1f\n" +
+	"\vHlenRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"&\n" +
+	"\fHlenResponse\x12\x16\n" +
+	"\x06length\x18\x01 \x01(\x03R\x06length\" \n" +
+	"\fHkeysRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"'\n" +
+	"\rHkeysResponse\x12\x16\n" +
+	"\x06fields\x18\x01 \x03(\tR\x06fields\" \n" +
+	"\fHvalsRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\";\n" +
+	"\rHvalsResponse\x12*\n" +
+	"\x06values\x18\x01 \x03(\v2\x12.simplecache.ValueR\x06values\"8\n" +
+	"\fHmgetRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x16\n" +
+	"\x06fields\x18\x02 \x03(\tR\x06fields\";\n" +
+	"\rHmgetResponse\x12*\n" +
+	"\x06values\x18\x01 \x03(\v2\x12.simplecache.ValueR\x06values\"{\n" +
+	"\rHsetnxRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12(\n" +
+	"\x05value\x18\x03 \x01(\v2\x12.simplecache.ValueR\x05value\x12\x18\n" +
+	"\aexpired\x18\x04 \x01(\x03R\aexpired\"\"\n" +
+	"\x0eHsetnxResponse\x12\x10\n" +
+	"\x03set\x18\x01 \x01(\bR\x03set\"N\n" +
+	"\x0eHincrbyRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x03R\x05value\"'\n" +
+	"\x0fHincrbyResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x03R\x05value\"S\n" +
+	"\x13HincrbyFloatRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x01R\x05value\",\n" +
+	"\x14HincrbyFloatResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\"S\n" +
+	"\vSaddRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x18\n" +
+	"\amembers\x18\x02 \x03(\tR\amembers\x12\x18\n" +
+	"\aexpired\x18\x03 \x01(\x03R\aexpired\"$\n" +
+	"\fSaddResponse\x12\x14\n" +
+	"\x05added\x18\x01 \x01(\x03R\x05added\"9\n" +
+	"\vSremRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x18\n" +
+	"\amembers\x18\x02 \x03(\tR\amembers\"(\n" +
+	"\fSremResponse\x12\x18\n" +
+	"\aremoved\x18\x01 \x01(\x03R\aremoved\"<\n" +
+	"\x10SismemberRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x16\n" +
+	"\x06member\x18\x02 \x01(\tR\x06member\"+\n" +
+	"\x11SismemberResponse\x12\x16\n" +
+	"\x06member\x18\x01 \x01(\bR\x06member\"#\n" +
+	"\x0fSmembersRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\",\n" +
+	"\x10SmembersResponse\x12\x18\n" +
+	"\amembers\x18\x01 \x03(\tR\amembers\" \n" +
+	"\fScardRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"%\n" +
+	"\rScardResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"D\n" +
+	"\vSpopRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x19\n" +
+	"\x05count\x18\x02 \x01(\x03H\x00R\x05count\x88\x01\x01B\b\n" +
+	"\x06_count\"(\n" +
+	"\fSpopResponse\x12\x18\n" +
+	"\amembers\x18\x01 \x03(\tR\amembers\"#\n" +
+	"\rSinterRequest\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\"*\n" +
+	"\x0eSinterResponse\x12\x18\n" +
+	"\amembers\x18\x01 \x03(\tR\amembers\"#\n" +
+	"\rSunionRequest\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\"*\n" +
+	"\x0eSunionResponse\x12\x18\n" +
+	"\amembers\x18\x01 \x03(\tR\amembers\"\"\n" +
+	"\fSdiffRequest\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\")\n" +
+	"\rSdiffResponse\x12\x18\n" +
+	"\amembers\x18\x01 \x03(\tR\amembers\"J\n" +
+	"\x12SinterStoreRequest\x12 \n" +
+	"\vdestination\x18\x01 \x01(\tR\vdestination\x12\x12\n" +
+	"\x04keys\x18\x02 \x03(\tR\x04keys\"+\n" +
+	"\x13SinterStoreResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"J\n" +
+	"\x12SunionStoreRequest\x12 \n" +
+	"\vdestination\x18\x01 \x01(\tR\vdestination\x12\x12\n" +
+	"\x04keys\x18\x02 \x03(\tR\x04keys\"+\n" +
+	"\x13SunionStoreResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"I\n" +
+	"\x11SdiffStoreRequest\x12 \n" +
+	"\vdestination\x18\x01 \x01(\tR\vdestination\x12\x12\n" +
+	"\x04keys\x18\x02 \x03(\tR\x04keys\"*\n" +
+	"\x12SdiffStoreResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"7\n" +
+	"\aZMember\x12\x16\n" +
+	"\x06member\x18\x01 \x01(\tR\x06member\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\"\x9d\x01\n" +
+	"\vZaddRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12.\n" +
+	"\amembers\x18\x02 \x03(\v2\x14.simplecache.ZMemberR\amembers\x12\x18\n" +
+	"\aexpired\x18\x03 \x01(\x03R\aexpired\x12\x0e\n" +
+	"\x02nx\x18\x04 \x01(\bR\x02nx\x12\x0e\n" +
+	"\x02xx\x18\x05 \x01(\bR\x02xx\x12\x12\n" +
+	"\x04incr\x18\x06 \x01(\bR\x04incr\"I\n" +
+	"\fZaddResponse\x12\x14\n" +
+	"\x05added\x18\x01 \x01(\x03R\x05added\x12\x19\n" +
+	"\x05score\x18\x02 \x01(\x01H\x00R\x05score\x88\x01\x01B\b\n" +
+	"\x06_score\"9\n" +
+	"\vZremRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x18\n" +
+	"\amembers\x18\x02 \x03(\tR\amembers\"(\n" +
+	"\fZremResponse\x12\x18\n" +
+	"\aremoved\x18\x01 \x01(\x03R\aremoved\"9\n" +
+	"\rZscoreRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x16\n" +
+	"\x06member\x18\x02 \x01(\tR\x06member\"&\n" +
+	"\x0eZscoreResponse\x12\x14\n" +
+	"\x05score\x18\x01 \x01(\x01R\x05score\"8\n" +
+	"\fZrankRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x16\n" +
+	"\x06member\x18\x02 \x01(\tR\x06member\"#\n" +
+	"\rZrankResponse\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x03R\x04rank\";\n" +
+	"\x0fZrevrankRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x16\n" +
+	"\x06member\x18\x02 \x01(\tR\x06member\"&\n" +
+	"\x10ZrevrankResponse\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x03R\x04rank\"]\n" +
+	"\rZrangeRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\x03R\x05start\x12\x12\n" +
+	"\x04stop\x18\x03 \x01(\x03R\x04stop\x12\x10\n" +
+	"\x03rev\x18\x04 \x01(\bR\x03rev\"@\n" +
+	"\x0eZrangeResponse\x12.\n" +
+	"\amembers\x18\x01 \x03(\v2\x14.simplecache.ZMemberR\amembers\"\x9b\x01\n" +
+	"\x14ZrangeByScoreRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x10\n" +
+	"\x03min\x18\x02 \x01(\tR\x03min\x12\x10\n" +
+	"\x03max\x18\x03 \x01(\tR\x03max\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x03R\x06offset\x12\x19\n" +
+	"\x05count\x18\x05 \x01(\x03H\x00R\x05count\x88\x01\x01\x12\x10\n" +
+	"\x03rev\x18\x06 \x01(\bR\x03revB\b\n" +
+	"\x06_count\"G\n" +
+	"\x15ZrangeByScoreResponse\x12.\n" +
+	"\amembers\x18\x01 \x03(\v2\x14.simplecache.ZMemberR\amembers\"E\n" +
+	"\rZcountRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x10\n" +
+	"\x03min\x18\x02 \x01(\tR\x03min\x12\x10\n" +
+	"\x03max\x18\x03 \x01(\tR\x03max\"&\n" +
+	"\x0eZcountResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"G\n" +
+	"\x0eZpopMinRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x19\n" +
+	"\x05count\x18\x02 \x01(\x03H\x00R\x05count\x88\x01\x01B\b\n" +
+	"\x06_count\"A\n" +
+	"\x0fZpopMinResponse\x12.\n" +
+	"\amembers\x18\x01 \x03(\v2\x14.simplecache.ZMemberR\amembers\"G\n" +
+	"\x0eZpopMaxRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x19\n" +
+	"\x05count\x18\x02 \x01(\x03H\x00R\x05count\x88\x01\x01B\b\n" +
+	"\x06_count\"A\n" +
+	"\x0fZpopMaxResponse\x12.\n" +
+	"\amembers\x18\x01 \x03(\v2\x14.simplecache.ZMemberR\amembers\"\x0e\n" +
+	"\fStatsRequest\"\xf3\x01\n" +
+	"\rStatsResponse\x12\x12\n" +
+	"\x04keys\x18\x01 \x01(\x03R\x04keys\x12\x1f\n" +
+	"\vused_memory\x18\x02 \x01(\x03R\n" +
+	"usedMemory\x12\x1d\n" +
+	"\n" +
+	"max_memory\x18\x03 \x01(\x03R\tmaxMemory\x12\x19\n" +
+	"\bmax_keys\x18\x04 \x01(\x03R\amaxKeys\x12'\n" +
+	"\x0feviction_policy\x18\x05 \x01(\tR\x0eevictionPolicy\x12!\n" +
+	"\fevicted_keys\x18\x06 \x01(\x03R\vevictedKeys\x12'\n" +
+	"\x0frejected_writes\x18\a \x01(\x03R\x0erejectedWrites\"\x0f\n" +
+	"\rDbSizeRequest\"$\n" +
+	"\x0eDbSizeResponse\x12\x12\n" +
+	"\x04size\x18\x01 \x01(\x03R\x04size\"\x10\n" +
+	"\x0eFlushDbRequest\"+\n" +
+	"\x0fFlushDbResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\x03R\adeleted\"/\n" +
+	"\vMoveRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x0e\n" +
+	"\x02db\x18\x02 \x01(\x03R\x02db\"$\n" +
+	"\fMoveResponse\x12\x14\n" +
+	"\x05moved\x18\x01 \x01(\bR\x05moved\"\x9a\x1d\n" +
+	"\aCommand\x12+\n" +
+	"\x03get\x18\x01 \x01(\v2\x17.simplecache.GetRequestH\x00R\x03get\x12.\n" +
+	"\x04gets\x18\x02 \x01(\v2\x18.simplecache.GetsRequestH\x00R\x04gets\x127\n" +
+	"\aversion\x18\x03 \x01(\v2\x1b.simplecache.VersionRequestH\x00R\aversion\x12+\n" +
+	"\x03set\x18\x04 \x01(\v2\x17.simplecache.SetRequestH\x00R\x03set\x124\n" +
+	"\x06getset\x18\x05 \x01(\v2\x1a.simplecache.GetSetRequestH\x00R\x06getset\x12+\n" +
+	"\x03cas\x18\x06 \x01(\v2\x17.simplecache.CasRequestH\x00R\x03cas\x12.\n" +
+	"\x04incr\x18\a \x01(\v2\x18.simplecache.IncrRequestH\x00R\x04incr\x12.\n" +
+	"\x04decr\x18\b \x01(\v2\x18.simplecache.DecrRequestH\x00R\x04decr\x124\n" +
+	"\x06incrby\x18\t \x01(\v2\x1a.simplecache.IncrByRequestH\x00R\x06incrby\x12C\n" +
+	"\vincrbyfloat\x18\n" +
+	" \x01(\v2\x1f.simplecache.IncrByFloatRequestH\x00R\vincrbyfloat\x121\n" +
+	"\x05unset\x18\v \x01(\v2\x19.simplecache.UnsetRequestH\x00R\x05unset\x124\n" +
+	"\x06expire\x18\f \x01(\v2\x1a.simplecache.ExpireRequestH\x00R\x06expire\x12:\n" +
+	"\bexpireat\x18\r \x01(\v2\x1c.simplecache.ExpireAtRequestH\x00R\bexpireat\x127\n" +
+	"\apersist\x18\x0e \x01(\v2\x1b.simplecache.PersistRequestH\x00R\apersist\x12+\n" +
+	"\x03ttl\x18\x0f \x01(\v2\x17.simplecache.TtlRequestH\x00R\x03ttl\x12.\n" +
+	"\x04pttl\x18\x10 \x01(\v2\x18.simplecache.PttlRequestH\x00R\x04pttl\x12.\n" +
+	"\x04keys\x18\x11 \x01(\v2\x18.simplecache.KeysRequestH\x00R\x04keys\x12.\n" +
+	"\x04scan\x18\x12 \x01(\v2\x18.simplecache.ScanRequestH\x00R\x04scan\x121\n" +
+	"\x05rpush\x18\x13 \x01(\v2\x19.simplecache.RpushRequestH\x00R\x05rpush\x121\n" +
+	"\x05lpush\x18\x14 \x01(\v2\x19.simplecache.LpushRequestH\x00R\x05lpush\x12+\n" +
+	"\x03pop\x18\x15 \x01(\v2\x17.simplecache.PopRequestH\x00R\x03pop\x12.\n" +
+	"\x04lpop\x18\x16 \x01(\v2\x18.simplecache.LpopRequestH\x00R\x04lpop\x121\n" +
+	"\x05blpop\x18\x17 \x01(\v2\x19.simplecache.BlpopRequestH\x00R\x05blpop\x121\n" +
+	"\x05brpop\x18\x18 \x01(\v2\x19.simplecache.BrpopRequestH\x00R\x05brpop\x127\n" +
+	"\algetall\x18\x19 \x01(\v2\x1b.simplecache.LgetallRequestH\x00R\algetall\x12.\n" +
+	"\x04lget\x18\x1a \x01(\v2\x18.simplecache.LgetRequestH\x00R\x04lget\x12.\n" +
+	"\x04llen\x18\x1b \x01(\v2\x18.simplecache.LlenRequestH\x00R\x04llen\x124\n" +
+	"\x06lrange\x18\x1c \x01(\v2\x1a.simplecache.LrangeRequestH\x00R\x06lrange\x12.\n" +
+	"\x04lset\x18\x1d \x01(\v2\x18.simplecache.LsetRequestH\x00R\x04lset\x12.\n" +
+	"\x04lrem\x18\x1e \x01(\v2\x18.simplecache.LremRequestH\x00R\x04lrem\x121\n" +
+	"\x05ltrim\x18\x1f \x01(\v2\x19.simplecache.LtrimRequestH\x00R\x05ltrim\x127\n" +
+	"\alinsert\x18  \x01(\v2\x1b.simplecache.LinsertRequestH\x00R\alinsert\x12.\n" +
+	"\x04hset\x18! \x01(\v2\x18.simplecache.HsetRequestH\x00R\x04hset\x127\n" +
+	"\ahgetall\x18\" \x01(\v2\x1b.simplecache.HgetallRequestH\x00R\ahgetall\x12.\n" +
+	"\x04hget\x18# \x01(\v2\x18.simplecache.HgetRequestH\x00R\x04hget\x12.\n" +
+	"\x04hdel\x18$ \x01(\v2\x18.simplecache.HdelRequestH\x00R\x04hdel\x127\n" +
+	"\ahexists\x18% \x01(\v2\x1b.simplecache.HexistsRequestH\x00R\ahexists\x12.\n" +
+	"\x04hlen\x18& \x01(\v2\x18.simplecache.HlenRequestH\x00R\x04hlen\x121\n" +
+	"\x05hkeys\x18' \x01(\v2\x19.simplecache.HkeysRequestH\x00R\x05hkeys\x121\n" +
+	"\x05hvals\x18( \x01(\v2\x19.simplecache.HvalsRequestH\x00R\x05hvals\x121\n" +
+	"\x05hmget\x18) \x01(\v2\x19.simplecache.HmgetRequestH\x00R\x05hmget\x124\n" +
+	"\x06hsetnx\x18* \x01(\v2\x1a.simplecache.HsetnxRequestH\x00R\x06hsetnx\x127\n" +
+	"\ahincrby\x18+ \x01(\v2\x1b.simplecache.HincrbyRequestH\x00R\ahincrby\x12F\n" +
+	"\fhincrbyfloat\x18, \x01(\v2 .simplecache.HincrbyFloatRequestH\x00R\fhincrbyfloat\x12.\n" +
+	"\x04sadd\x18- \x01(\v2\x18.simplecache.SaddRequestH\x00R\x04sadd\x12.\n" +
+	"\x04srem\x18. \x01(\v2\x18.simplecache.SremRequestH\x00R\x04srem\x12=\n" +
+	"\tsismember\x18/ \x01(\v2\x1d.simplecache.SismemberRequestH\x00R\tsismember\x12:\n" +
+	"\bsmembers\x180 \x01(\v2\x1c.simplecache.SmembersRequestH\x00R\bsmembers\x121\n" +
+	"\x05scard\x181 \x01(\v2\x19.simplecache.ScardRequestH\x00R\x05scard\x12.\n" +
+	"\x04spop\x182 \x01(\v2\x18.simplecache.SpopRequestH\x00R\x04spop\x124\n" +
+	"\x06sinter\x183 \x01(\v2\x1a.simplecache.SinterRequestH\x00R\x06sinter\x124\n" +
+	"\x06sunion\x184 \x01(\v2\x1a.simplecache.SunionRequestH\x00R\x06sunion\x121\n" +
+	"\x05sdiff\x185 \x01(\v2\x19.simplecache.SdiffRequestH\x00R\x05sdiff\x12C\n" +
+	"\vsinterstore\x186 \x01(\v2\x1f.simplecache.SinterStoreRequestH\x00R\vsinterstore\x12C\n" +
+	"\vsunionstore\x187 \x01(\v2\x1f.simplecache.SunionStoreRequestH\x00R\vsunionstore\x12@\n" +
+	"\n" +
+	"sdiffstore\x188 \x01(\v2\x1e.simplecache.SdiffStoreRequestH\x00R\n" +
+	"sdiffstore\x12.\n" +
+	"\x04zadd\x189 \x01(\v2\x18.simplecache.ZaddRequestH\x00R\x04zadd\x12.\n" +
+	"\x04zrem\x18: \x01(\v2\x18.simplecache.ZremRequestH\x00R\x04zrem\x124\n" +
+	"\x06zscore\x18; \x01(\v2\x1a.simplecache.ZscoreRequestH\x00R\x06zscore\x121\n" +
+	"\x05zrank\x18< \x01(\v2\x19.simplecache.ZrankRequestH\x00R\x05zrank\x12:\n" +
+	"\bzrevrank\x18= \x01(\v2\x1c.simplecache.ZrevrankRequestH\x00R\bzrevrank\x124\n" +
+	"\x06zrange\x18> \x01(\v2\x1a.simplecache.ZrangeRequestH\x00R\x06zrange\x12I\n" +
+	"\rzrangebyscore\x18? \x01(\v2!.simplecache.ZrangeByScoreRequestH\x00R\rzrangebyscore\x124\n" +
+	"\x06zcount\x18@ \x01(\v2\x1a.simplecache.ZcountRequestH\x00R\x06zcount\x127\n" +
+	"\azpopmin\x18A \x01(\v2\x1b.simplecache.ZpopMinRequestH\x00R\azpopmin\x127\n" +
+	"\azpopmax\x18B \x01(\v2\x1b.simplecache.ZpopMaxRequestH\x00R\azpopmax\x121\n" +
+	"\x05stats\x18C \x01(\v2\x19.simplecache.StatsRequestH\x00R\x05stats\x124\n" +
+	"\x06dbsize\x18D \x01(\v2\x1a.simplecache.DbSizeRequestH\x00R\x06dbsize\x127\n" +
+	"\aflushdb\x18E \x01(\v2\x1b.simplecache.FlushDbRequestH\x00R\aflushdb\x12.\n" +
+	"\x04move\x18F \x01(\v2\x18.simplecache.MoveRequestH\x00R\x04moveB\x04\n" +
+	"\x02op\"\x8d\x1e\n" +
+	"\x06Result\x12,\n" +
+	"\x03get\x18\x01 \x01(\v2\x18.simplecache.GetResponseH\x00R\x03get\x12/\n" +
+	"\x04gets\x18\x02 \x01(\v2\x19.simplecache.GetsResponseH\x00R\x04gets\x128\n" +
+	"\aversion\x18\x03 \x01(\v2\x1c.simplecache.VersionResponseH\x00R\aversion\x12,\n" +
+	"\x03set\x18\x04 \x01(\v2\x18.simplecache.SetResponseH\x00R\x03set\x125\n" +
+	"\x06getset\x18\x05 \x01(\v2\x1b.simplecache.GetSetResponseH\x00R\x06getset\x12,\n" +
+	"\x03cas\x18\x06 \x01(\v2\x18.simplecache.CasResponseH\x00R\x03cas\x12/\n" +
+	"\x04incr\x18\a \x01(\v2\x19.simplecache.IncrResponseH\x00R\x04incr\x12/\n" +
+	"\x04decr\x18\b \x01(\v2\x19.simplecache.DecrResponseH\x00R\x04decr\x125\n" +
+	"\x06incrby\x18\t \x01(\v2\x1b.simplecache.IncrByResponseH\x00R\x06incrby\x12D\n" +
+	"\vincrbyfloat\x18\n" +
+	" \x01(\v2 .simplecache.IncrByFloatResponseH\x00R\vincrbyfloat\x122\n" +
+	"\x05unset\x18\v \x01(\v2\x1a.simplecache.UnsetResponseH\x00R\x05unset\x125\n" +
+	"\x06expire\x18\f \x01(\v2\x1b.simplecache.ExpireResponseH\x00R\x06expire\x12;\n" +
+	"\bexpireat\x18\r \x01(\v2\x1d.simplecache.ExpireAtResponseH\x00R\bexpireat\x128\n" +
+	"\apersist\x18\x0e \x01(\v2\x1c.simplecache.PersistResponseH\x00R\apersist\x12,\n" +
+	"\x03ttl\x18\x0f \x01(\v2\x18.simplecache.TtlResponseH\x00R\x03ttl\x12/\n" +
+	"\x04pttl\x18\x10 \x01(\v2\x19.simplecache.PttlResponseH\x00R\x04pttl\x12/\n" +
+	"\x04keys\x18\x11 \x01(\v2\x19.simplecache.KeysResponseH\x00R\x04keys\x12/\n" +
+	"\x04scan\x18\x12 \x01(\v2\x19.simplecache.ScanResponseH\x00R\x04scan\x122\n" +
+	"\x05rpush\x18\x13 \x01(\v2\x1a.simplecache.RpushResponseH\x00R\x05rpush\x122\n" +
+	"\x05lpush\x18\x14 \x01(\v2\x1a.simplecache.LpushResponseH\x00R\x05lpush\x12,\n" +
+	"\x03pop\x18\x15 \x01(\v2\x18.simplecache.PopResponseH\x00R\x03pop\x12/\n" +
+	"\x04lpop\x18\x16 \x01(\v2\x19.simplecache.LpopResponseH\x00R\x04lpop\x122\n" +
+	"\x05blpop\x18\x17 \x01(\v2\x1a.simplecache.BlpopResponseH\x00R\x05blpop\x122\n" +
+	"\x05brpop\x18\x18 \x01(\v2\x1a.simplecache.BrpopResponseH\x00R\x05brpop\x128\n" +
+	"\algetall\x18\x19 \x01(\v2\x1c.simplecache.LgetallResponseH\x00R\algetall\x12/\n" +
+	"\x04lget\x18\x1a \x01(\v2\x19.simplecache.LgetResponseH\x00R\x04lget\x12/\n" +
+	"\x04llen\x18\x1b \x01(\v2\x19.simplecache.LlenResponseH\x00R\x04llen\x125\n" +
+	"\x06lrange\x18\x1c \x01(\v2\x1b.simplecache.LrangeResponseH\x00R\x06lrange\x12/\n" +
+	"\x04lset\x18\x1d \x01(\v2\x19.simplecache.LsetResponseH\x00R\x04lset\x12/\n" +
+	"\x04lrem\x18\x1e \x01(\v2\x19.simplecache.LremResponseH\x00R\x04lrem\x122\n" +
+	"\x05ltrim\x18\x1f \x01(\v2\x1a.simplecache.LtrimResponseH\x00R\x05ltrim\x128\n" +
+	"\alinsert\x18  \x01(\v2\x1c.simplecache.LinsertResponseH\x00R\alinsert\x12/\n" +
+	"\x04hset\x18! \x01(\v2\x19.simplecache.HsetResponseH\x00R\x04hset\x128\n" +
+	"\ahgetall\x18\" \x01(\v2\x1c.simplecache.HgetallResponseH\x00R\ahgetall\x12/\n" +
+	"\x04hget\x18# \x01(\v2\x19.simplecache.HgetResponseH\x00R\x04hget\x12/\n" +
+	"\x04hdel\x18$ \x01(\v2\x19.simplecache.HdelResponseH\x00R\x04hdel\x128\n" +
+	"\ahexists\x18% \x01(\v2\x1c.simplecache.HexistsResponseH\x00R\ahexists\x12/\n" +
+	"\x04hlen\x18& \x01(\v2\x19.simplecache.HlenResponseH\x00R\x04hlen\x122\n" +
+	"\x05hkeys\x18' \x01(\v2\x1a.simplecache.HkeysResponseH\x00R\x05hkeys\x122\n" +
+	"\x05hvals\x18( \x01(\v2\x1a.simplecache.HvalsResponseH\x00R\x05hvals\x122\n" +
+	"\x05hmget\x18) \x01(\v2\x1a.simplecache.HmgetResponseH\x00R\x05hmget\x125\n" +
+	"\x06hsetnx\x18* \x01(\v2\x1b.simplecache.HsetnxResponseH\x00R\x06hsetnx\x128\n" +
+	"\ahincrby\x18+ \x01(\v2\x1c.simplecache.HincrbyResponseH\x00R\ahincrby\x12G\n" +
+	"\fhincrbyfloat\x18, \x01(\v2!.simplecache.HincrbyFloatResponseH\x00R\fhincrbyfloat\x12/\n" +
+	"\x04sadd\x18- \x01(\v2\x19.simplecache.SaddResponseH\x00R\x04sadd\x12/\n" +
+	"\x04srem\x18. \x01(\v2\x19.simplecache.SremResponseH\x00R\x04srem\x12>\n" +
+	"\tsismember\x18/ \x01(\v2\x1e.simplecache.SismemberResponseH\x00R\tsismember\x12;\n" +
+	"\bsmembers\x180 \x01(\v2\x1d.simplecache.SmembersResponseH\x00R\bsmembers\x122\n" +
+	"\x05scard\x181 \x01(\v2\x1a.simplecache.ScardResponseH\x00R\x05scard\x12/\n" +
+	"\x04spop\x182 \x01(\v2\x19.simplecache.SpopResponseH\x00R\x04spop\x125\n" +
+	"\x06sinter\x183 \x01(\v2\x1b.simplecache.SinterResponseH\x00R\x06sinter\x125\n" +
+	"\x06sunion\x184 \x01(\v2\x1b.simplecache.SunionResponseH\x00R\x06sunion\x122\n" +
+	"\x05sdiff\x185 \x01(\v2\x1a.simplecache.SdiffResponseH\x00R\x05sdiff\x12D\n" +
+	"\vsinterstore\x186 \x01(\v2 .simplecache.SinterStoreResponseH\x00R\vsinterstore\x12D\n" +
+	"\vsunionstore\x187 \x01(\v2 .simplecache.SunionStoreResponseH\x00R\vsunionstore\x12A\n" +
+	"\n" +
+	"sdiffstore\x188 \x01(\v2\x1f.simplecache.SdiffStoreResponseH\x00R\n" +
+	"sdiffstore\x12/\n" +
+	"\x04zadd\x189 \x01(\v2\x19.simplecache.ZaddResponseH\x00R\x04zadd\x12/\n" +
+	"\x04zrem\x18: \x01(\v2\x19.simplecache.ZremResponseH\x00R\x04zrem\x125\n" +
+	"\x06zscore\x18; \x01(\v2\x1b.simplecache.ZscoreResponseH\x00R\x06zscore\x122\n" +
+	"\x05zrank\x18< \x01(\v2\x1a.simplecache.ZrankResponseH\x00R\x05zrank\x12;\n" +
+	"\bzrevrank\x18= \x01(\v2\x1d.simplecache.ZrevrankResponseH\x00R\bzrevrank\x125\n" +
+	"\x06zrange\x18> \x01(\v2\x1b.simplecache.ZrangeResponseH\x00R\x06zrange\x12J\n" +
+	"\rzrangebyscore\x18? \x01(\v2\".simplecache.ZrangeByScoreResponseH\x00R\rzrangebyscore\x125\n" +
+	"\x06zcount\x18@ \x01(\v2\x1b.simplecache.ZcountResponseH\x00R\x06zcount\x128\n" +
+	"\azpopmin\x18A \x01(\v2\x1c.simplecache.ZpopMinResponseH\x00R\azpopmin\x128\n" +
+	"\azpopmax\x18B \x01(\v2\x1c.simplecache.ZpopMaxResponseH\x00R\azpopmax\x122\n" +
+	"\x05stats\x18C \x01(\v2\x1a.simplecache.StatsResponseH\x00R\x05stats\x125\n" +
+	"\x06dbsize\x18D \x01(\v2\x1b.simplecache.DbSizeResponseH\x00R\x06dbsize\x128\n" +
+	"\aflushdb\x18E \x01(\v2\x1c.simplecache.FlushDbResponseH\x00R\aflushdb\x12/\n" +
+	"\x04move\x18F \x01(\v2\x19.simplecache.MoveResponseH\x00R\x04move\x12\x14\n" +
+	"\x05error\x18d \x01(\tR\x05error\x12\x12\n" +
+	"\x04code\x18e \x01(\x05R\x04codeB\b\n" +
+	"\x06result\"\xb0\x01\n" +
+	"\tTxRequest\x127\n" +
+	"\x05watch\x18\x01 \x03(\v2!.simplecache.TxRequest.WatchEntryR\x05watch\x120\n" +
+	"\bcommands\x18\x02 \x03(\v2\x14.simplecache.CommandR\bcommands\x1a8\n" +
+	"\n" +
+	"WatchEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\";\n" +
+	"\n" +
+	"TxResponse\x12-\n" +
+	"\aresults\x18\x01 \x03(\v2\x13.simplecache.ResultR\aresults\"X\n" +
+	"\x0ePublishRequest\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12,\n" +
+	"\amessage\x18\x02 \x01(\v2\x12.simplecache.ValueR\amessage\"/\n" +
+	"\x0fPublishResponse\x12\x1c\n" +
+	"\treceivers\x18\x01 \x01(\x03R\treceivers\"J\n" +
+	"\x10SubscribeRequest\x12\x1a\n" +
+	"\bchannels\x18\x01 \x03(\tR\bchannels\x12\x1a\n" +
+	"\bpatterns\x18\x02 \x03(\tR\bpatterns\"k\n" +
+	"\aMessage\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12\x18\n" +
+	"\apattern\x18\x02 \x01(\tR\apattern\x12,\n" +
+	"\apayload\x18\x03 \x01(\v2\x12.simplecache.ValueR\apayload\"H\n" +
+	"\x14NotificationsRequest\x12\x18\n" +
+	"\apattern\x18\x01 \x01(\tR\apattern\x12\x16\n" +
+	"\x06events\x18\x02 \x03(\tR\x06events\"\x7f\n" +
+	"\rKeyspaceEvent\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x0e\n" +
+	"\x02op\x18\x02 \x01(\tR\x02op\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x128\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\xb4'\n" +
+	"\x05Cache\x128\n" +
+	"\x03Get\x12\x17.simplecache.GetRequest\x1a\x18.simplecache.GetResponse\x12;\n" +
+	"\x04Gets\x12\x18.simplecache.GetsRequest\x1a\x19.simplecache.GetsResponse\x12D\n" +
+	"\aVersion\x12\x1b.simplecache.VersionRequest\x1a\x1c.simplecache.VersionResponse\x12G\n" +
+	"\bVersions\x12\x1c.simplecache.VersionsRequest\x1a\x1d.simplecache.VersionsResponse\x128\n" +
+	"\x03Set\x12\x17.simplecache.SetRequest\x1a\x18.simplecache.SetResponse\x12A\n" +
+	"\x06GetSet\x12\x1a.simplecache.GetSetRequest\x1a\x1b.simplecache.GetSetResponse\x128\n" +
+	"\x03Cas\x12\x17.simplecache.CasRequest\x1a\x18.simplecache.CasResponse\x12;\n" +
+	"\x04Incr\x12\x18.simplecache.IncrRequest\x1a\x19.simplecache.IncrResponse\x12;\n" +
+	"\x04Decr\x12\x18.simplecache.DecrRequest\x1a\x19.simplecache.DecrResponse\x12A\n" +
+	"\x06IncrBy\x12\x1a.simplecache.IncrByRequest\x1a\x1b.simplecache.IncrByResponse\x12P\n" +
+	"\vIncrByFloat\x12\x1f.simplecache.IncrByFloatRequest\x1a .simplecache.IncrByFloatResponse\x12>\n" +
+	"\x05Unset\x12\x19.simplecache.UnsetRequest\x1a\x1a.simplecache.UnsetResponse\x12A\n" +
+	"\x06Expire\x12\x1a.simplecache.ExpireRequest\x1a\x1b.simplecache.ExpireResponse\x12G\n" +
+	"\bExpireAt\x12\x1c.simplecache.ExpireAtRequest\x1a\x1d.simplecache.ExpireAtResponse\x12D\n" +
+	"\aPersist\x12\x1b.simplecache.PersistRequest\x1a\x1c.simplecache.PersistResponse\x128\n" +
+	"\x03Ttl\x12\x17.simplecache.TtlRequest\x1a\x18.simplecache.TtlResponse\x12;\n" +
+	"\x04Pttl\x12\x18.simplecache.PttlRequest\x1a\x19.simplecache.PttlResponse\x12;\n" +
+	"\x04Keys\x12\x18.simplecache.KeysRequest\x1a\x19.simplecache.KeysResponse\x12;\n" +
+	"\x04Scan\x12\x18.simplecache.ScanRequest\x1a\x19.simplecache.ScanResponse\x12>\n" +
+	"\x05Rpush\x12\x19.simplecache.RpushRequest\x1a\x1a.simplecache.RpushResponse\x12>\n" +
+	"\x05Lpush\x12\x19.simplecache.LpushRequest\x1a\x1a.simplecache.LpushResponse\x128\n" +
+	"\x03Pop\x12\x17.simplecache.PopRequest\x1a\x18.simplecache.PopResponse\x12;\n" +
+	"\x04Lpop\x12\x18.simplecache.LpopRequest\x1a\x19.simplecache.LpopResponse\x12>\n" +
+	"\x05Blpop\x12\x19.simplecache.BlpopRequest\x1a\x1a.simplecache.BlpopResponse\x12>\n" +
+	"\x05Brpop\x12\x19.simplecache.BrpopRequest\x1a\x1a.simplecache.BrpopResponse\x12D\n" +
+	"\aLgetall\x12\x1b.simplecache.LgetallRequest\x1a\x1c.simplecache.LgetallResponse\x12;\n" +
+	"\x04Lget\x12\x18.simplecache.LgetRequest\x1a\x19.simplecache.LgetResponse\x12;\n" +
+	"\x04Llen\x12\x18.simplecache.LlenRequest\x1a\x19.simplecache.LlenResponse\x12A\n" +
+	"\x06Lrange\x12\x1a.simplecache.LrangeRequest\x1a\x1b.simplecache.LrangeResponse\x12;\n" +
+	"\x04Lset\x12\x18.simplecache.LsetRequest\x1a\x19.simplecache.LsetResponse\x12;\n" +
+	"\x04Lrem\x12\x18.simplecache.LremRequest\x1a\x19.simplecache.LremResponse\x12>\n" +
+	"\x05Ltrim\x12\x19.simplecache.LtrimRequest\x1a\x1a.simplecache.LtrimResponse\x12D\n" +
+	"\aLinsert\x12\x1b.simplecache.LinsertRequest\x1a\x1c.simplecache.LinsertResponse\x12;\n" +
+	"\x04Hset\x12\x18.simplecache.HsetRequest\x1a\x19.simplecache.HsetResponse\x12D\n" +
+	"\aHgetall\x12\x1b.simplecache.HgetallRequest\x1a\x1c.simplecache.HgetallResponse\x12;\n" +
+	"\x04Hget\x12\x18.simplecache.HgetRequest\x1a\x19.simplecache.HgetResponse\x12;\n" +
+	"\x04Hdel\x12\x18.simplecache.HdelRequest\x1a\x19.simplecache.HdelResponse\x12D\n" +
+	"\aHexists\x12\x1b.simplecache.HexistsRequest\x1a\x1c.simplecache.HexistsResponse\x12;\n" +
+	"\x04Hlen\x12\x18.simplecache.HlenRequest\x1a\x19.simplecache.HlenResponse\x12>\n" +
+	"\x05Hkeys\x12\x19.simplecache.HkeysRequest\x1a\x1a.simplecache.HkeysResponse\x12>\n" +
+	"\x05Hvals\x12\x19.simplecache.HvalsRequest\x1a\x1a.simplecache.HvalsResponse\x12>\n" +
+	"\x05Hmget\x12\x19.simplecache.HmgetRequest\x1a\x1a.simplecache.HmgetResponse\x12A\n" +
+	"\x06Hsetnx\x12\x1a.simplecache.HsetnxRequest\x1a\x1b.simplecache.HsetnxResponse\x12D\n" +
+	"\aHincrby\x12\x1b.simplecache.HincrbyRequest\x1a\x1c.simplecache.HincrbyResponse\x12S\n" +
+	"\fHincrbyFloat\x12 .simplecache.HincrbyFloatRequest\x1a!.simplecache.HincrbyFloatResponse\x12;\n" +
+	"\x04Sadd\x12\x18.simplecache.SaddRequest\x1a\x19.simplecache.SaddResponse\x12;\n" +
+	"\x04Srem\x12\x18.simplecache.SremRequest\x1a\x19.simplecache.SremResponse\x12J\n" +
+	"\tSismember\x12\x1d.simplecache.SismemberRequest\x1a\x1e.simplecache.SismemberResponse\x12G\n" +
+	"\bSmembers\x12\x1c.simplecache.SmembersRequest\x1a\x1d.simplecache.SmembersResponse\x12>\n" +
+	"\x05Scard\x12\x19.simplecache.ScardRequest\x1a\x1a.simplecache.ScardResponse\x12;\n" +
+	"\x04Spop\x12\x18.simplecache.SpopRequest\x1a\x19.simplecache.SpopResponse\x12A\n" +
+	"\x06Sinter\x12\x1a.simplecache.SinterRequest\x1a\x1b.simplecache.SinterResponse\x12A\n" +
+	"\x06Sunion\x12\x1a.simplecache.SunionRequest\x1a\x1b.simplecache.SunionResponse\x12>\n" +
+	"\x05Sdiff\x12\x19.simplecache.SdiffRequest\x1a\x1a.simplecache.SdiffResponse\x12P\n" +
+	"\vSinterStore\x12\x1f.simplecache.SinterStoreRequest\x1a .simplecache.SinterStoreResponse\x12P\n" +
+	"\vSunionStore\x12\x1f.simplecache.SunionStoreRequest\x1a .simplecache.SunionStoreResponse\x12M\n" +
+	"\n" +
+	"SdiffStore\x12\x1e.simplecache.SdiffStoreRequest\x1a\x1f.simplecache.SdiffStoreResponse\x12;\n" +
+	"\x04Zadd\x12\x18.simplecache.ZaddRequest\x1a\x19.simplecache.ZaddResponse\x12;\n" +
+	"\x04Zrem\x12\x18.simplecache.ZremRequest\x1a\x19.simplecache.ZremResponse\x12A\n" +
+	"\x06Zscore\x12\x1a.simplecache.ZscoreRequest\x1a\x1b.simplecache.ZscoreResponse\x12>\n" +
+	"\x05Zrank\x12\x19.simplecache.ZrankRequest\x1a\x1a.simplecache.ZrankResponse\x12G\n" +
+	"\bZrevrank\x12\x1c.simplecache.ZrevrankRequest\x1a\x1d.simplecache.ZrevrankResponse\x12A\n" +
+	"\x06Zrange\x12\x1a.simplecache.ZrangeRequest\x1a\x1b.simplecache.ZrangeResponse\x12V\n" +
+	"\rZrangeByScore\x12!.simplecache.ZrangeByScoreRequest\x1a\".simplecache.ZrangeByScoreResponse\x12A\n" +
+	"\x06Zcount\x12\x1a.simplecache.ZcountRequest\x1a\x1b.simplecache.ZcountResponse\x12D\n" +
+	"\aZpopMin\x12\x1b.simplecache.ZpopMinRequest\x1a\x1c.simplecache.ZpopMinResponse\x12D\n" +
+	"\aZpopMax\x12\x1b.simplecache.ZpopMaxRequest\x1a\x1c.simplecache.ZpopMaxResponse\x12>\n" +
+	"\x05Stats\x12\x19.simplecache.StatsRequest\x1a\x1a.simplecache.StatsResponse\x12A\n" +
+	"\x06DbSize\x12\x1a.simplecache.DbSizeRequest\x1a\x1b.simplecache.DbSizeResponse\x12D\n" +
+	"\aFlushDb\x12\x1b.simplecache.FlushDbRequest\x1a\x1c.simplecache.FlushDbResponse\x12;\n" +
+	"\x04Move\x12\x18.simplecache.MoveRequest\x1a\x19.simplecache.MoveResponse\x126\n" +
+	"\x05Batch\x12\x14.simplecache.Command\x1a\x13.simplecache.Result(\x010\x01\x125\n" +
+	"\x02Tx\x12\x16.simplecache.TxRequest\x1a\x17.simplecache.TxResponse\x12D\n" +
+	"\aPublish\x12\x1b.simplecache.PublishRequest\x1a\x1c.simplecache.PublishResponse\x12B\n" +
+	"\tSubscribe\x12\x1d.simplecache.SubscribeRequest\x1a\x14.simplecache.Message0\x01\x12P\n" +
+	"\rNotifications\x12!.simplecache.NotificationsRequest\x1a\x1a.simplecache.KeyspaceEvent0\x01B-Z+github.com/iqOptionTest/simplecache/cachepbb\x06proto3"
+
+var (
+	file_cache_proto_rawDescOnce sync.Once
+	file_cache_proto_rawDescData []byte
+)
+
+func file_cache_proto_rawDescGZIP() []byte {
+	file_cache_proto_rawDescOnce.Do(func() {
+		file_cache_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cache_proto_rawDesc), len(file_cache_proto_rawDesc)))
+	})
+	return file_cache_proto_rawDescData
+}
+
+var file_cache_proto_msgTypes = make([]protoimpl.MessageInfo, 158)
+var file_cache_proto_goTypes = []any{
+	(*Value)(nil),                 // 0: simplecache.Value
+	(*GetRequest)(nil),            // 1: simplecache.GetRequest
+	(*GetResponse)(nil),           // 2: simplecache.GetResponse
+	(*GetsRequest)(nil),           // 3: simplecache.GetsRequest
+	(*GetsResponse)(nil),          // 4: simplecache.GetsResponse
+	(*VersionRequest)(nil),        // 5: simplecache.VersionRequest
+	(*VersionResponse)(nil),       // 6: simplecache.VersionResponse
+	(*VersionsRequest)(nil),       // 7: simplecache.VersionsRequest
+	(*VersionsResponse)(nil),      // 8: simplecache.VersionsResponse
+	(*SetRequest)(nil),            // 9: simplecache.SetRequest
+	(*SetResponse)(nil),           // 10: simplecache.SetResponse
+	(*GetSetRequest)(nil),         // 11: simplecache.GetSetRequest
+	(*GetSetResponse)(nil),        // 12: simplecache.GetSetResponse
+	(*CasRequest)(nil),            // 13: simplecache.CasRequest
+	(*CasResponse)(nil),           // 14: simplecache.CasResponse
+	(*IncrRequest)(nil),           // 15: simplecache.IncrRequest
+	(*IncrResponse)(nil),          // 16: simplecache.IncrResponse
+	(*DecrRequest)(nil),           // 17: simplecache.DecrRequest
+	(*DecrResponse)(nil),          // 18: simplecache.DecrResponse
+	(*IncrByRequest)(nil),         // 19: simplecache.IncrByRequest
+	(*IncrByResponse)(nil),        // 20: simplecache.IncrByResponse
+	(*IncrByFloatRequest)(nil),    // 21: simplecache.IncrByFloatRequest
+	(*IncrByFloatResponse)(nil),   // 22: simplecache.IncrByFloatResponse
+	(*UnsetRequest)(nil),          // 23: simplecache.UnsetRequest
+	(*UnsetResponse)(nil),         // 24: simplecache.UnsetResponse
+	(*ExpireRequest)(nil),         // 25: simplecache.ExpireRequest
+	(*ExpireResponse)(nil),        // 26: simplecache.ExpireResponse
+	(*ExpireAtRequest)(nil),       // 27: simplecache.ExpireAtRequest
+	(*ExpireAtResponse)(nil),      // 28: simplecache.ExpireAtResponse
+	(*PersistRequest)(nil),        // 29: simplecache.PersistRequest
+	(*PersistResponse)(nil),       // 30: simplecache.PersistResponse
+	(*TtlRequest)(nil),            // 31: simplecache.TtlRequest
+	(*TtlResponse)(nil),           // 32: simplecache.TtlResponse
+	(*PttlRequest)(nil),           // 33: simplecache.PttlRequest
+	(*PttlResponse)(nil),          // 34: simplecache.PttlResponse
+	(*KeysRequest)(nil),           // 35: simplecache.KeysRequest
+	(*KeysResponse)(nil),          // 36: simplecache.KeysResponse
+	(*ScanRequest)(nil),           // 37: simplecache.ScanRequest
+	(*ScanResponse)(nil),          // 38: simplecache.ScanResponse
+	(*RpushRequest)(nil),          // 39: simplecache.RpushRequest
+	(*RpushResponse)(nil),         // 40: simplecache.RpushResponse
+	(*LpushRequest)(nil),          // 41: simplecache.LpushRequest
+	(*LpushResponse)(nil),         // 42: simplecache.LpushResponse
+	(*PopRequest)(nil),            // 43: simplecache.PopRequest
+	(*PopResponse)(nil),           // 44: simplecache.PopResponse
+	(*LpopRequest)(nil),           // 45: simplecache.LpopRequest
+	(*LpopResponse)(nil),          // 46: simplecache.LpopResponse
+	(*BlpopRequest)(nil),          // 47: simplecache.BlpopRequest
+	(*BlpopResponse)(nil),         // 48: simplecache.BlpopResponse
+	(*BrpopRequest)(nil),          // 49: simplecache.BrpopRequest
+	(*BrpopResponse)(nil),         // 50: simplecache.BrpopResponse
+	(*LgetallRequest)(nil),        // 51: simplecache.LgetallRequest
+	(*LgetallResponse)(nil),       // 52: simplecache.LgetallResponse
+	(*LgetRequest)(nil),           // 53: simplecache.LgetRequest
+	(*LgetResponse)(nil),          // 54: simplecache.LgetResponse
+	(*LlenRequest)(nil),           // 55: simplecache.LlenRequest
+	(*LlenResponse)(nil),          // 56: simplecache.LlenResponse
+	(*LrangeRequest)(nil),         // 57: simplecache.LrangeRequest
+	(*LrangeResponse)(nil),        // 58: simplecache.LrangeResponse
+	(*LsetRequest)(nil),           // 59: simplecache.LsetRequest
+	(*LsetResponse)(nil),          // 60: simplecache.LsetResponse
+	(*LremRequest)(nil),           // 61: simplecache.LremRequest
+	(*LremResponse)(nil),          // 62: simplecache.LremResponse
+	(*LtrimRequest)(nil),          // 63: simplecache.LtrimRequest
+	(*LtrimResponse)(nil),         // 64: simplecache.LtrimResponse
+	(*LinsertRequest)(nil),        // 65: simplecache.LinsertRequest
+	(*LinsertResponse)(nil),       // 66: simplecache.LinsertResponse
+	(*HsetRequest)(nil),           // 67: simplecache.HsetRequest
+	(*HsetResponse)(nil),          // 68: simplecache.HsetResponse
+	(*HgetallRequest)(nil),        // 69: simplecache.HgetallRequest
+	(*HgetallResponse)(nil),       // 70: simplecache.HgetallResponse
+	(*HgetRequest)(nil),           // 71: simplecache.HgetRequest
+	(*HgetResponse)(nil),          // 72: simplecache.HgetResponse
+	(*HdelRequest)(nil),           // 73: simplecache.HdelRequest
+	(*HdelResponse)(nil),          // 74: simplecache.HdelResponse
+	(*HexistsRequest)(nil),        // 75: simplecache.HexistsRequest
+	(*HexistsResponse)(nil),       // 76: simplecache.HexistsResponse
+	(*HlenRequest)(nil),           // 77: simplecache.HlenRequest
+	(*HlenResponse)(nil),          // 78: simplecache.HlenResponse
+	(*HkeysRequest)(nil),          // 79: simplecache.HkeysRequest
+	(*HkeysResponse)(nil),         // 80: simplecache.HkeysResponse
+	(*HvalsRequest)(nil),          // 81: simplecache.HvalsRequest
+	(*HvalsResponse)(nil),         // 82: simplecache.HvalsResponse
+	(*HmgetRequest)(nil),          // 83: simplecache.HmgetRequest
+	(*HmgetResponse)(nil),         // 84: simplecache.HmgetResponse
+	(*HsetnxRequest)(nil),         // 85: simplecache.HsetnxRequest
+	(*HsetnxResponse)(nil),        // 86: simplecache.HsetnxResponse
+	(*HincrbyRequest)(nil),        // 87: simplecache.HincrbyRequest
+	(*HincrbyResponse)(nil),       // 88: simplecache.HincrbyResponse
+	(*HincrbyFloatRequest)(nil),   // 89: simplecache.HincrbyFloatRequest
+	(*HincrbyFloatResponse)(nil),  // 90: simplecache.HincrbyFloatResponse
+	(*SaddRequest)(nil),           // 91: simplecache.SaddRequest
+	(*SaddResponse)(nil),          // 92: simplecache.SaddResponse
+	(*SremRequest)(nil),           // 93: simplecache.SremRequest
+	(*SremResponse)(nil),          // 94: simplecache.SremResponse
+	(*SismemberRequest)(nil),      // 95: simplecache.SismemberRequest
+	(*SismemberResponse)(nil),     // 96: simplecache.SismemberResponse
+	(*SmembersRequest)(nil),       // 97: simplecache.SmembersRequest
+	(*SmembersResponse)(nil),      // 98: simplecache.SmembersResponse
+	(*ScardRequest)(nil),          // 99: simplecache.ScardRequest
+	(*ScardResponse)(nil),         // 100: simplecache.ScardResponse
+	(*SpopRequest)(nil),           // 101: simplecache.SpopRequest
+	(*SpopResponse)(nil),          // 102: simplecache.SpopResponse
+	(*SinterRequest)(nil),         // 103: simplecache.SinterRequest
+	(*SinterResponse)(nil),        // 104: simplecache.SinterResponse
+	(*SunionRequest)(nil),         // 105: simplecache.SunionRequest
+	(*SunionResponse)(nil),        // 106: simplecache.SunionResponse
+	(*SdiffRequest)(nil),          // 107: simplecache.SdiffRequest
+	(*SdiffResponse)(nil),         // 108: simplecache.SdiffResponse
+	(*SinterStoreRequest)(nil),    // 109: simplecache.SinterStoreRequest
+	(*SinterStoreResponse)(nil),   // 110: simplecache.SinterStoreResponse
+	(*SunionStoreRequest)(nil),    // 111: simplecache.SunionStoreRequest
+	(*SunionStoreResponse)(nil),   // 112: simplecache.SunionStoreResponse
+	(*SdiffStoreRequest)(nil),     // 113: simplecache.SdiffStoreRequest
+	(*SdiffStoreResponse)(nil),    // 114: simplecache.SdiffStoreResponse
+	(*ZMember)(nil),               // 115: simplecache.ZMember
+	(*ZaddRequest)(nil),           // 116: simplecache.ZaddRequest
+	(*ZaddResponse)(nil),          // 117: simplecache.ZaddResponse
+	(*ZremRequest)(nil),           // 118: simplecache.ZremRequest
+	(*ZremResponse)(nil),          // 119: simplecache.ZremResponse
+	(*ZscoreRequest)(nil),         // 120: simplecache.ZscoreRequest
+	(*ZscoreResponse)(nil),        // 121: simplecache.ZscoreResponse
+	(*ZrankRequest)(nil),          // 122: simplecache.ZrankRequest
+	(*ZrankResponse)(nil),         // 123: simplecache.ZrankResponse
+	(*ZrevrankRequest)(nil),       // 124: simplecache.ZrevrankRequest
+	(*ZrevrankResponse)(nil),      // 125: simplecache.ZrevrankResponse
+	(*ZrangeRequest)(nil),         // 126: simplecache.ZrangeRequest
+	(*ZrangeResponse)(nil),        // 127: simplecache.ZrangeResponse
+	(*ZrangeByScoreRequest)(nil),  // 128: simplecache.ZrangeByScoreRequest
+	(*ZrangeByScoreResponse)(nil), // 129: simplecache.ZrangeByScoreResponse
+	(*ZcountRequest)(nil),         // 130: simplecache.ZcountRequest
+	(*ZcountResponse)(nil),        // 131: simplecache.ZcountResponse
+	(*ZpopMinRequest)(nil),        // 132: simplecache.ZpopMinRequest
+	(*ZpopMinResponse)(nil),       // 133: simplecache.ZpopMinResponse
+	(*ZpopMaxRequest)(nil),        // 134: simplecache.ZpopMaxRequest
+	(*ZpopMaxResponse)(nil),       // 135: simplecache.ZpopMaxResponse
+	(*StatsRequest)(nil),          // 136: simplecache.StatsRequest
+	(*StatsResponse)(nil),         // 137: simplecache.StatsResponse
+	(*DbSizeRequest)(nil),         // 138: simplecache.DbSizeRequest
+	(*DbSizeResponse)(nil),        // 139: simplecache.DbSizeResponse
+	(*FlushDbRequest)(nil),        // 140: simplecache.FlushDbRequest
+	(*FlushDbResponse)(nil),       // 141: simplecache.FlushDbResponse
+	(*MoveRequest)(nil),           // 142: simplecache.MoveRequest
+	(*MoveResponse)(nil),          // 143: simplecache.MoveResponse
+	(*Command)(nil),               // 144: simplecache.Command
+	(*Result)(nil),                // 145: simplecache.Result
+	(*TxRequest)(nil),             // 146: simplecache.TxRequest
+	(*TxResponse)(nil),            // 147: simplecache.TxResponse
+	(*PublishRequest)(nil),        // 148: simplecache.PublishRequest
+	(*PublishResponse)(nil),       // 149: simplecache.PublishResponse
+	(*SubscribeRequest)(nil),      // 150: simplecache.SubscribeRequest
+	(*Message)(nil),               // 151: simplecache.Message
+	(*NotificationsRequest)(nil),  // 152: simplecache.NotificationsRequest
+	(*KeyspaceEvent)(nil),         // 153: simplecache.KeyspaceEvent
+	nil,                           // 154: simplecache.VersionsResponse.VersionsEntry
+	nil,                           // 155: simplecache.HsetRequest.FieldsEntry
+	nil,                           // 156: simplecache.HgetallResponse.FieldsEntry
+	nil,                           // 157: simplecache.TxRequest.WatchEntry
+	(*timestamppb.Timestamp)(nil), // 158: google.protobuf.Timestamp
+}
+var file_cache_proto_depIdxs = []int32{
+	0,   // 0: simplecache.GetResponse.value:type_name -> simplecache.Value
+	0,   // 1: simplecache.GetsResponse.value:type_name -> simplecache.Value
+	154, // 2: simplecache.VersionsResponse.versions:type_name -> simplecache.VersionsResponse.VersionsEntry
+	0,   // 3: simplecache.SetRequest.value:type_name -> simplecache.Value
+	0,   // 4: simplecache.GetSetRequest.value:type_name -> simplecache.Value
+	0,   // 5: simplecache.GetSetResponse.value:type_name -> simplecache.Value
+	0,   // 6: simplecache.CasRequest.value:type_name -> simplecache.Value
+	0,   // 7: simplecache.RpushRequest.values:type_name -> simplecache.Value
+	0,   // 8: simplecache.LpushRequest.values:type_name -> simplecache.Value
+	0,   // 9: simplecache.PopResponse.value:type_name -> simplecache.Value
+	0,   // 10: simplecache.LpopResponse.value:type_name -> simplecache.Value
+	0,   // 11: simplecache.BlpopResponse.value:type_name -> simplecache.Value
+	0,   // 12: simplecache.BrpopResponse.value:type_name -> simplecache.Value
+	0,   // 13: simplecache.LgetallResponse.values:type_name -> simplecache.Value
+	0,   // 14: simplecache.LgetResponse.value:type_name -> simplecache.Value
+	0,   // 15: simplecache.LrangeResponse.values:type_name -> simplecache.Value
+	0,   // 16: simplecache.LsetRequest.value:type_name -> simplecache.Value
+	0,   // 17: simplecache.LremRequest.value:type_name -> simplecache.Value
+	0,   // 18: simplecache.LinsertRequest.pivot:type_name -> simplecache.Value
+	0,   // 19: simplecache.LinsertRequest.value:type_name -> simplecache.Value
+	155, // 20: simplecache.HsetRequest.fields:type_name -> simplecache.HsetRequest.FieldsEntry
+	156, // 21: simplecache.HgetallResponse.fields:type_name -> simplecache.HgetallResponse.FieldsEntry
+	0,   // 22: simplecache.HgetResponse.value:type_name -> simplecache.Value
+	0,   // 23: simplecache.HvalsResponse.values:type_name -> simplecache.Value
+	0,   // 24: simplecache.HmgetResponse.values:type_name -> simplecache.Value
+	0,   // 25: simplecache.HsetnxRequest.value:type_name -> simplecache.Value
+	115, // 26: simplecache.ZaddRequest.members:type_name -> simplecache.ZMember
+	115, // 27: simplecache.ZrangeResponse.members:type_name -> simplecache.ZMember
+	115, // 28: simplecache.ZrangeByScoreResponse.members:type_name -> simplecache.ZMember
+	115, // 29: simplecache.ZpopMinResponse.members:type_name -> simplecache.ZMember
+	115, // 30: simplecache.ZpopMaxResponse.members:type_name -> simplecache.ZMember
+	1,   // 31: simplecache.Command.get:type_name -> simplecache.GetRequest
+	3,   // 32: simplecache.Command.gets:type_name -> simplecache.GetsRequest
+	5,   // 33: simplecache.Command.version:type_name -> simplecache.VersionRequest
+	9,   // 34: simplecache.Command.set:type_name -> simplecache.SetRequest
+	11,  // 35: simplecache.Command.getset:type_name -> simplecache.GetSetRequest
+	13,  // 36: simplecache.Command.cas:type_name -> simplecache.CasRequest
+	15,  // 37: simplecache.Command.incr:type_name -> simplecache.IncrRequest
+	17,  // 38: simplecache.Command.decr:type_name -> simplecache.DecrRequest
+	19,  // 39: simplecache.Command.incrby:type_name -> simplecache.IncrByRequest
+	21,  // 40: simplecache.Command.incrbyfloat:type_name -> simplecache.IncrByFloatRequest
+	23,  // 41: simplecache.Command.unset:type_name -> simplecache.UnsetRequest
+	25,  // 42: simplecache.Command.expire:type_name -> simplecache.ExpireRequest
+	27,  // 43: simplecache.Command.expireat:type_name -> simplecache.ExpireAtRequest
+	29,  // 44: simplecache.Command.persist:type_name -> simplecache.PersistRequest
+	31,  // 45: simplecache.Command.ttl:type_name -> simplecache.TtlRequest
+	33,  // 46: simplecache.Command.pttl:type_name -> simplecache.PttlRequest
+	35,  // 47: simplecache.Command.keys:type_name -> simplecache.KeysRequest
+	37,  // 48: simplecache.Command.scan:type_name -> simplecache.ScanRequest
+	39,  // 49: simplecache.Command.rpush:type_name -> simplecache.RpushRequest
+	41,  // 50: simplecache.Command.lpush:type_name -> simplecache.LpushRequest
+	43,  // 51: simplecache.Command.pop:type_name -> simplecache.PopRequest
+	45,  // 52: simplecache.Command.lpop:type_name -> simplecache.LpopRequest
+	47,  // 53: simplecache.Command.blpop:type_name -> simplecache.BlpopRequest
+	49,  // 54: simplecache.Command.brpop:type_name -> simplecache.BrpopRequest
+	51,  // 55: simplecache.Command.lgetall:type_name -> simplecache.LgetallRequest
+	53,  // 56: simplecache.Command.lget:type_name -> simplecache.LgetRequest
+	55,  // 57: simplecache.Command.llen:type_name -> simplecache.LlenRequest
+	57,  // 58: simplecache.Command.lrange:type_name -> simplecache.LrangeRequest
+	59,  // 59: simplecache.Command.lset:type_name -> simplecache.LsetRequest
+	61,  // 60: simplecache.Command.lrem:type_name -> simplecache.LremRequest
+	63,  // 61: simplecache.Command.ltrim:type_name -> simplecache.LtrimRequest
+	65,  // 62: simplecache.Command.linsert:type_name -> simplecache.LinsertRequest
+	67,  // 63: simplecache.Command.hset:type_name -> simplecache.HsetRequest
+	69,  // 64: simplecache.Command.hgetall:type_name -> simplecache.HgetallRequest
+	71,  // 65: simplecache.Command.hget:type_name -> simplecache.HgetRequest
+	73,  // 66: simplecache.Command.hdel:type_name -> simplecache.HdelRequest
+	75,  // 67: simplecache.Command.hexists:type_name -> simplecache.HexistsRequest
+	77,  // 68: simplecache.Command.hlen:type_name -> simplecache.HlenRequest
+	79,  // 69: simplecache.Command.hkeys:type_name -> simplecache.HkeysRequest
+	81,  // 70: simplecache.Command.hvals:type_name -> simplecache.HvalsRequest
+	83,  // 71: simplecache.Command.hmget:type_name -> simplecache.HmgetRequest
+	85,  // 72: simplecache.Command.hsetnx:type_name -> simplecache.HsetnxRequest
+	87,  // 73: simplecache.Command.hincrby:type_name -> simplecache.HincrbyRequest
+	89,  // 74: simplecache.Command.hincrbyfloat:type_name -> simplecache.HincrbyFloatRequest
+	91,  // 75: simplecache.Command.sadd:type_name -> simplecache.SaddRequest
+	93,  // 76: simplecache.Command.srem:type_name -> simplecache.SremRequest
+	95,  // 77: simplecache.Command.sismember:type_name -> simplecache.SismemberRequest
+	97,  // 78: simplecache.Command.smembers:type_name -> simplecache.SmembersRequest
+	99,  // 79: simplecache.Command.scard:type_name -> simplecache.ScardRequest
+	101, // 80: simplecache.Command.spop:type_name -> simplecache.SpopRequest
+	103, // 81: simplecache.Command.sinter:type_name -> simplecache.SinterRequest
+	105, // 82: simplecache.Command.sunion:type_name -> simplecache.SunionRequest
+	107, // 83: simplecache.Command.sdiff:type_name -> simplecache.SdiffRequest
+	109, // 84: simplecache.Command.sinterstore:type_name -> simplecache.SinterStoreRequest
+	111, // 85: simplecache.Command.sunionstore:type_name -> simplecache.SunionStoreRequest
+	113, // 86: simplecache.Command.sdiffstore:type_name -> simplecache.SdiffStoreRequest
+	116, // 87: simplecache.Command.zadd:type_name -> simplecache.ZaddRequest
+	118, // 88: simplecache.Command.zrem:type_name -> simplecache.ZremRequest
+	120, // 89: simplecache.Command.zscore:type_name -> simplecache.ZscoreRequest
+	122, // 90: simplecache.Command.zrank:type_name -> simplecache.ZrankRequest
+	124, // 91: simplecache.Command.zrevrank:type_name -> simplecache.ZrevrankRequest
+	126, // 92: simplecache.Command.zrange:type_name -> simplecache.ZrangeRequest
+	128, // 93: simplecache.Command.zrangebyscore:type_name -> simplecache.ZrangeByScoreRequest
+	130, // 94: simplecache.Command.zcount:type_name -> simplecache.ZcountRequest
+	132, // 95: simplecache.Command.zpopmin:type_name -> simplecache.ZpopMinRequest
+	134, // 96: simplecache.Command.zpopmax:type_name -> simplecache.ZpopMaxRequest
+	136, // 97: simplecache.Command.stats:type_name -> simplecache.StatsRequest
+	138, // 98: simplecache.Command.dbsize:type_name -> simplecache.DbSizeRequest
+	140, // 99: simplecache.Command.flushdb:type_name -> simplecache.FlushDbRequest
+	142, // 100: simplecache.Command.move:type_name -> simplecache.MoveRequest
+	2,   // 101: simplecache.Result.get:type_name -> simplecache.GetResponse
+	4,   // 102: simplecache.Result.gets:type_name -> simplecache.GetsResponse
+	6,   // 103: simplecache.Result.version:type_name -> simplecache.VersionResponse
+	10,  // 104: simplecache.Result.set:type_name -> simplecache.SetResponse
+	12,  // 105: simplecache.Result.getset:type_name -> simplecache.GetSetResponse
+	14,  // 106: simplecache.Result.cas:type_name -> simplecache.CasResponse
+	16,  // 107: simplecache.Result.incr:type_name -> simplecache.IncrResponse
+	18,  // 108: simplecache.Result.decr:type_name -> simplecache.DecrResponse
+	20,  // 109: simplecache.Result.incrby:type_name -> simplecache.IncrByResponse
+	22,  // 110: simplecache.Result.incrbyfloat:type_name -> simplecache.IncrByFloatResponse
+	24,  // 111: simplecache.Result.unset:type_name -> simplecache.UnsetResponse
+	26,  // 112: simplecache.Result.expire:type_name -> simplecache.ExpireResponse
+	28,  // 113: simplecache.Result.expireat:type_name -> simplecache.ExpireAtResponse
+	30,  // 114: simplecache.Result.persist:type_name -> simplecache.PersistResponse
+	32,  // 115: simplecache.Result.ttl:type_name -> simplecache.TtlResponse
+	34,  // 116: simplecache.Result.pttl:type_name -> simplecache.PttlResponse
+	36,  // 117: simplecache.Result.keys:type_name -> simplecache.KeysResponse
+	38,  // 118: simplecache.Result.scan:type_name -> simplecache.ScanResponse
+	40,  // 119: simplecache.Result.rpush:type_name -> simplecache.RpushResponse
+	42,  // 120: simplecache.Result.lpush:type_name -> simplecache.LpushResponse
+	44,  // 121: simplecache.Result.pop:type_name -> simplecache.PopResponse
+	46,  // 122: simplecache.Result.lpop:type_name -> simplecache.LpopResponse
+	48,  // 123: simplecache.Result.blpop:type_name -> simplecache.BlpopResponse
+	50,  // 124: simplecache.Result.brpop:type_name -> simplecache.BrpopResponse
+	52,  // 125: simplecache.Result.lgetall:type_name -> simplecache.LgetallResponse
+	54,  // 126: simplecache.Result.lget:type_name -> simplecache.LgetResponse
+	56,  // 127: simplecache.Result.llen:type_name -> simplecache.LlenResponse
+	58,  // 128: simplecache.Result.lrange:type_name -> simplecache.LrangeResponse
+	60,  // 129: simplecache.Result.lset:type_name -> simplecache.LsetResponse
+	62,  // 130: simplecache.Result.lrem:type_name -> simplecache.LremResponse
+	64,  // 131: simplecache.Result.ltrim:type_name -> simplecache.LtrimResponse
+	66,  // 132: simplecache.Result.linsert:type_name -> simplecache.LinsertResponse
+	68,  // 133: simplecache.Result.hset:type_name -> simplecache.HsetResponse
+	70,  // 134: simplecache.Result.hgetall:type_name -> simplecache.HgetallResponse
+	72,  // 135: simplecache.Result.hget:type_name -> simplecache.HgetResponse
+	74,  // 136: simplecache.Result.hdel:type_name -> simplecache.HdelResponse
+	76,  // 137: simplecache.Result.hexists:type_name -> simplecache.HexistsResponse
+	78,  // 138: simplecache.Result.hlen:type_name -> simplecache.HlenResponse
+	80,  // 139: simplecache.Result.hkeys:type_name -> simplecache.HkeysResponse
+	82,  // 140: simplecache.Result.hvals:type_name -> simplecache.HvalsResponse
+	84,  // 141: simplecache.Result.hmget:type_name -> simplecache.HmgetResponse
+	86,  // 142: simplecache.Result.hsetnx:type_name -> simplecache.HsetnxResponse
+	88,  // 143: simplecache.Result.hincrby:type_name -> simplecache.HincrbyResponse
+	90,  // 144: simplecache.Result.hincrbyfloat:type_name -> simplecache.HincrbyFloatResponse
+	92,  // 145: simplecache.Result.sadd:type_name -> simplecache.SaddResponse
+	94,  // 146: simplecache.Result.srem:type_name -> simplecache.SremResponse
+	96,  // 147: simplecache.Result.sismember:type_name -> simplecache.SismemberResponse
+	98,  // 148: simplecache.Result.smembers:type_name -> simplecache.SmembersResponse
+	100, // 149: simplecache.Result.scard:type_name -> simplecache.ScardResponse
+	102, // 150: simplecache.Result.spop:type_name -> simplecache.SpopResponse
+	104, // 151: simplecache.Result.sinter:type_name -> simplecache.SinterResponse
+	106, // 152: simplecache.Result.sunion:type_name -> simplecache.SunionResponse
+	108, // 153: simplecache.Result.sdiff:type_name -> simplecache.SdiffResponse
+	110, // 154: simplecache.Result.sinterstore:type_name -> simplecache.SinterStoreResponse
+	112, // 155: simplecache.Result.sunionstore:type_name -> simplecache.SunionStoreResponse
+	114, // 156: simplecache.Result.sdiffstore:type_name -> simplecache.SdiffStoreResponse
+	117, // 157: simplecache.Result.zadd:type_name -> simplecache.ZaddResponse
+	119, // 158: simplecache.Result.zrem:type_name -> simplecache.ZremResponse
+	121, // 159: simplecache.Result.zscore:type_name -> simplecache.ZscoreResponse
+	123, // 160: simplecache.Result.zrank:type_name -> simplecache.ZrankResponse
+	125, // 161: simplecache.Result.zrevrank:type_name -> simplecache.ZrevrankResponse
+	127, // 162: simplecache.Result.zrange:type_name -> simplecache.ZrangeResponse
+	129, // 163: simplecache.Result.zrangebyscore:type_name -> simplecache.ZrangeByScoreResponse
+	131, // 164: simplecache.Result.zcount:type_name -> simplecache.ZcountResponse
+	133, // 165: simplecache.Result.zpopmin:type_name -> simplecache.ZpopMinResponse
+	135, // 166: simplecache.Result.zpopmax:type_name -> simplecache.ZpopMaxResponse
+	137, // 167: simplecache.Result.stats:type_name -> simplecache.StatsResponse
+	139, // 168: simplecache.Result.dbsize:type_name -> simplecache.DbSizeResponse
+	141, // 169: simplecache.Result.flushdb:type_name -> simplecache.FlushDbResponse
+	143, // 170: simplecache.Result.move:type_name -> simplecache.MoveResponse
+	157, // 171: simplecache.TxRequest.watch:type_name -> simplecache.TxRequest.WatchEntry
+	144, // 172: simplecache.TxRequest.commands:type_name -> simplecache.Command
+	145, // 173: simplecache.TxResponse.results:type_name -> simplecache.Result
+	0,   // 174: simplecache.PublishRequest.message:type_name -> simplecache.Value
+	0,   // 175: simplecache.Message.payload:type_name -> simplecache.Value
+	158, // 176: simplecache.KeyspaceEvent.timestamp:type_name -> google.protobuf.Timestamp
+	0,   // 177: simplecache.HsetRequest.FieldsEntry.value:type_name -> simplecache.Value
+	0,   // 178: simplecache.HgetallResponse.FieldsEntry.value:type_name -> simplecache.Value
+	1,   // 179: simplecache.Cache.Get:input_type -> simplecache.GetRequest
+	3,   // 180: simplecache.Cache.Gets:input_type -> simplecache.GetsRequest
+	5,   // 181: simplecache.Cache.Version:input_type -> simplecache.VersionRequest
+	7,   // 182: simplecache.Cache.Versions:input_type -> simplecache.VersionsRequest
+	9,   // 183: simplecache.Cache.Set:input_type -> simplecache.SetRequest
+	11,  // 184: simplecache.Cache.GetSet:input_type -> simplecache.GetSetRequest
+	13,  // 185: simplecache.Cache.Cas:input_type -> simplecache.CasRequest
+	15,  // 186: simplecache.Cache.Incr:input_type -> simplecache.IncrRequest
+	17,  // 187: simplecache.Cache.Decr:input_type -> simplecache.DecrRequest
+	19,  // 188: simplecache.Cache.IncrBy:input_type -> simplecache.IncrByRequest
+	21,  // 189: simplecache.Cache.IncrByFloat:input_type -> simplecache.IncrByFloatRequest
+	23,  // 190: simplecache.Cache.Unset:input_type -> simplecache.UnsetRequest
+	25,  // 191: simplecache.Cache.Expire:input_type -> simplecache.ExpireRequest
+	27,  // 192: simplecache.Cache.ExpireAt:input_type -> simplecache.ExpireAtRequest
+	29,  // 193: simplecache.Cache.Persist:input_type -> simplecache.PersistRequest
+	31,  // 194: simplecache.Cache.Ttl:input_type -> simplecache.TtlRequest
+	33,  // 195: simplecache.Cache.Pttl:input_type -> simplecache.PttlRequest
+	35,  // 196: simplecache.Cache.Keys:input_type -> simplecache.KeysRequest
+	37,  // 197: simplecache.Cache.Scan:input_type -> simplecache.ScanRequest
+	39,  // 198: simplecache.Cache.Rpush:input_type -> simplecache.RpushRequest
+	41,  // 199: simplecache.Cache.Lpush:input_type -> simplecache.LpushRequest
+	43,  // 200: simplecache.Cache.Pop:input_type -> simplecache.PopRequest
+	45,  // 201: simplecache.Cache.Lpop:input_type -> simplecache.LpopRequest
+	47,  // 202: simplecache.Cache.Blpop:input_type -> simplecache.BlpopRequest
+	49,  // 203: simplecache.Cache.Brpop:input_type -> simplecache.BrpopRequest
+	51,  // 204: simplecache.Cache.Lgetall:input_type -> simplecache.LgetallRequest
+	53,  // 205: simplecache.Cache.Lget:input_type -> simplecache.LgetRequest
+	55,  // 206: simplecache.Cache.Llen:input_type -> simplecache.LlenRequest
+	57,  // 207: simplecache.Cache.Lrange:input_type -> simplecache.LrangeRequest
+	59,  // 208: simplecache.Cache.Lset:input_type -> simplecache.LsetRequest
+	61,  // 209: simplecache.Cache.Lrem:input_type -> simplecache.LremRequest
+	63,  // 210: simplecache.Cache.Ltrim:input_type -> simplecache.LtrimRequest
+	65,  // 211: simplecache.Cache.Linsert:input_type -> simplecache.LinsertRequest
+	67,  // 212: simplecache.Cache.Hset:input_type -> simplecache.HsetRequest
+	69,  // 213: simplecache.Cache.Hgetall:input_type -> simplecache.HgetallRequest
+	71,  // 214: simplecache.Cache.Hget:input_type -> simplecache.HgetRequest
+	73,  // 215: simplecache.Cache.Hdel:input_type -> simplecache.HdelRequest
+	75,  // 216: simplecache.Cache.Hexists:input_type -> simplecache.HexistsRequest
+	77,  // 217: simplecache.Cache.Hlen:input_type -> simplecache.HlenRequest
+	79,  // 218: simplecache.Cache.Hkeys:input_type -> simplecache.HkeysRequest
+	81,  // 219: simplecache.Cache.Hvals:input_type -> simplecache.HvalsRequest
+	83,  // 220: simplecache.Cache.Hmget:input_type -> simplecache.HmgetRequest
+	85,  // 221: simplecache.Cache.Hsetnx:input_type -> simplecache.HsetnxRequest
+	87,  // 222: simplecache.Cache.Hincrby:input_type -> simplecache.HincrbyRequest
+	89,  // 223: simplecache.Cache.HincrbyFloat:input_type -> simplecache.HincrbyFloatRequest
+	91,  // 224: simplecache.Cache.Sadd:input_type -> simplecache.SaddRequest
+	93,  // 225: simplecache.Cache.Srem:input_type -> simplecache.SremRequest
+	95,  // 226: simplecache.Cache.Sismember:input_type -> simplecache.SismemberRequest
+	97,  // 227: simplecache.Cache.Smembers:input_type -> simplecache.SmembersRequest
+	99,  // 228: simplecache.Cache.Scard:input_type -> simplecache.ScardRequest
+	101, // 229: simplecache.Cache.Spop:input_type -> simplecache.SpopRequest
+	103, // 230: simplecache.Cache.Sinter:input_type -> simplecache.SinterRequest
+	105, // 231: simplecache.Cache.Sunion:input_type -> simplecache.SunionRequest
+	107, // 232: simplecache.Cache.Sdiff:input_type -> simplecache.SdiffRequest
+	109, // 233: simplecache.Cache.SinterStore:input_type -> simplecache.SinterStoreRequest
+	111, // 234: simplecache.Cache.SunionStore:input_type -> simplecache.SunionStoreRequest
+	113, // 235: simplecache.Cache.SdiffStore:input_type -> simplecache.SdiffStoreRequest
+	116, // 236: simplecache.Cache.Zadd:input_type -> simplecache.ZaddRequest
+	118, // 237: simplecache.Cache.Zrem:input_type -> simplecache.ZremRequest
+	120, // 238: simplecache.Cache.Zscore:input_type -> simplecache.ZscoreRequest
+	122, // 239: simplecache.Cache.Zrank:input_type -> simplecache.ZrankRequest
+	124, // 240: simplecache.Cache.Zrevrank:input_type -> simplecache.ZrevrankRequest
+	126, // 241: simplecache.Cache.Zrange:input_type -> simplecache.ZrangeRequest
+	128, // 242: simplecache.Cache.ZrangeByScore:input_type -> simplecache.ZrangeByScoreRequest
+	130, // 243: simplecache.Cache.Zcount:input_type -> simplecache.ZcountRequest
+	132, // 244: simplecache.Cache.ZpopMin:input_type -> simplecache.ZpopMinRequest
+	134, // 245: simplecache.Cache.ZpopMax:input_type -> simplecache.ZpopMaxRequest
+	136, // 246: simplecache.Cache.Stats:input_type -> simplecache.StatsRequest
+	138, // 247: simplecache.Cache.DbSize:input_type -> simplecache.DbSizeRequest
+	140, // 248: simplecache.Cache.FlushDb:input_type -> simplecache.FlushDbRequest
+	142, // 249: simplecache.Cache.Move:input_type -> simplecache.MoveRequest
+	144, // 250: simplecache.Cache.Batch:input_type -> simplecache.Command
+	146, // 251: simplecache.Cache.Tx:input_type -> simplecache.TxRequest
+	148, // 252: simplecache.Cache.Publish:input_type -> simplecache.PublishRequest
+	150, // 253: simplecache.Cache.Subscribe:input_type -> simplecache.SubscribeRequest
+	152, // 254: simplecache.Cache.Notifications:input_type -> simplecache.NotificationsRequest
+	2,   // 255: simplecache.Cache.Get:output_type -> simplecache.GetResponse
+	4,   // 256: simplecache.Cache.Gets:output_type -> simplecache.GetsResponse
+	6,   // 257: simplecache.Cache.Version:output_type -> simplecache.VersionResponse
+	8,   // 258: simplecache.Cache.Versions:output_type -> simplecache.VersionsResponse
+	10,  // 259: simplecache.Cache.Set:output_type -> simplecache.SetResponse
+	12,  // 260: simplecache.Cache.GetSet:output_type -> simplecache.GetSetResponse
+	14,  // 261: simplecache.Cache.Cas:output_type -> simplecache.CasResponse
+	16,  // 262: simplecache.Cache.Incr:output_type -> simplecache.IncrResponse
+	18,  // 263: simplecache.Cache.Decr:output_type -> simplecache.DecrResponse
+	20,  // 264: simplecache.Cache.IncrBy:output_type -> simplecache.IncrByResponse
+	22,  // 265: simplecache.Cache.IncrByFloat:output_type -> simplecache.IncrByFloatResponse
+	24,  // 266: simplecache.Cache.Unset:output_type -> simplecache.UnsetResponse
+	26,  // 267: simplecache.Cache.Expire:output_type -> simplecache.ExpireResponse
+	28,  // 268: simplecache.Cache.ExpireAt:output_type -> simplecache.ExpireAtResponse
+	30,  // 269: simplecache.Cache.Persist:output_type -> simplecache.PersistResponse
+	32,  // 270: simplecache.Cache.Ttl:output_type -> simplecache.TtlResponse
+	34,  // 271: simplecache.Cache.Pttl:output_type -> simplecache.PttlResponse
+	36,  // 272: simplecache.Cache.Keys:output_type -> simplecache.KeysResponse
+	38,  // 273: simplecache.Cache.Scan:output_type -> simplecache.ScanResponse
+	40,  // 274: simplecache.Cache.Rpush:output_type -> simplecache.RpushResponse
+	42,  // 275: simplecache.Cache.Lpush:output_type -> simplecache.LpushResponse
+	44,  // 276: simplecache.Cache.Pop:output_type -> simplecache.PopResponse
+	46,  // 277: simplecache.Cache.Lpop:output_type -> simplecache.LpopResponse
+	48,  // 278: simplecache.Cache.Blpop:output_type -> simplecache.BlpopResponse
+	50,  // 279: simplecache.Cache.Brpop:output_type -> simplecache.BrpopResponse
+	52,  // 280: simplecache.Cache.Lgetall:output_type -> simplecache.LgetallResponse
+	54,  // 281: simplecache.Cache.Lget:output_type -> simplecache.LgetResponse
+	56,  // 282: simplecache.Cache.Llen:output_type -> simplecache.LlenResponse
+	58,  // 283: simplecache.Cache.Lrange:output_type -> simplecache.LrangeResponse
+	60,  // 284: simplecache.Cache.Lset:output_type -> simplecache.LsetResponse
+	62,  // 285: simplecache.Cache.Lrem:output_type -> simplecache.LremResponse
+	64,  // 286: simplecache.Cache.Ltrim:output_type -> simplecache.LtrimResponse
+	66,  // 287: simplecache.Cache.Linsert:output_type -> simplecache.LinsertResponse
+	68,  // 288: simplecache.Cache.Hset:output_type -> simplecache.HsetResponse
+	70,  // 289: simplecache.Cache.Hgetall:output_type -> simplecache.HgetallResponse
+	72,  // 290: simplecache.Cache.Hget:output_type -> simplecache.HgetResponse
+	74,  // 291: simplecache.Cache.Hdel:output_type -> simplecache.HdelResponse
+	76,  // 292: simplecache.Cache.Hexists:output_type -> simplecache.HexistsResponse
+	78,  // 293: simplecache.Cache.Hlen:output_type -> simplecache.HlenResponse
+	80,  // 294: simplecache.Cache.Hkeys:output_type -> simplecache.HkeysResponse
+	82,  // 295: simplecache.Cache.Hvals:output_type -> simplecache.HvalsResponse
+	84,  // 296: simplecache.Cache.Hmget:output_type -> simplecache.HmgetResponse
+	86,  // 297: simplecache.Cache.Hsetnx:output_type -> simplecache.HsetnxResponse
+	88,  // 298: simplecache.Cache.Hincrby:output_type -> simplecache.HincrbyResponse
+	90,  // 299: simplecache.Cache.HincrbyFloat:output_type -> simplecache.HincrbyFloatResponse
+	92,  // 300: simplecache.Cache.Sadd:output_type -> simplecache.SaddResponse
+	94,  // 301: simplecache.Cache.Srem:output_type -> simplecache.SremResponse
+	96,  // 302: simplecache.Cache.Sismember:output_type -> simplecache.SismemberResponse
+	98,  // 303: simplecache.Cache.Smembers:output_type -> simplecache.SmembersResponse
+	100, // 304: simplecache.Cache.Scard:output_type -> simplecache.ScardResponse
+	102, // 305: simplecache.Cache.Spop:output_type -> simplecache.SpopResponse
+	104, // 306: simplecache.Cache.Sinter:output_type -> simplecache.SinterResponse
+	106, // 307: simplecache.Cache.Sunion:output_type -> simplecache.SunionResponse
+	108, // 308: simplecache.Cache.Sdiff:output_type -> simplecache.SdiffResponse
+	110, // 309: simplecache.Cache.SinterStore:output_type -> simplecache.SinterStoreResponse
+	112, // 310: simplecache.Cache.SunionStore:output_type -> simplecache.SunionStoreResponse
+	114, // 311: simplecache.Cache.SdiffStore:output_type -> simplecache.SdiffStoreResponse
+	117, // 312: simplecache.Cache.Zadd:output_type -> simplecache.ZaddResponse
+	119, // 313: simplecache.Cache.Zrem:output_type -> simplecache.ZremResponse
+	121, // 314: simplecache.Cache.Zscore:output_type -> simplecache.ZscoreResponse
+	123, // 315: simplecache.Cache.Zrank:output_type -> simplecache.ZrankResponse
+	125, // 316: simplecache.Cache.Zrevrank:output_type -> simplecache.ZrevrankResponse
+	127, // 317: simplecache.Cache.Zrange:output_type -> simplecache.ZrangeResponse
+	129, // 318: simplecache.Cache.ZrangeByScore:output_type -> simplecache.ZrangeByScoreResponse
+	131, // 319: simplecache.Cache.Zcount:output_type -> simplecache.ZcountResponse
+	133, // 320: simplecache.Cache.ZpopMin:output_type -> simplecache.ZpopMinResponse
+	135, // 321: simplecache.Cache.ZpopMax:output_type -> simplecache.ZpopMaxResponse
+	137, // 322: simplecache.Cache.Stats:output_type -> simplecache.StatsResponse
+	139, // 323: simplecache.Cache.DbSize:output_type -> simplecache.DbSizeResponse
+	141, // 324: simplecache.Cache.FlushDb:output_type -> simplecache.FlushDbResponse
+	143, // 325: simplecache.Cache.Move:output_type -> simplecache.MoveResponse
+	145, // 326: simplecache.Cache.Batch:output_type -> simplecache.Result
+	147, // 327: simplecache.Cache.Tx:output_type -> simplecache.TxResponse
+	149, // 328: simplecache.Cache.Publish:output_type -> simplecache.PublishResponse
+	151, // 329: simplecache.Cache.Subscribe:output_type -> simplecache.Message
+	153, // 330: simplecache.Cache.Notifications:output_type -> simplecache.KeyspaceEvent
+	255, // [255:331] is the sub-list for method output_type
+	179, // [179:255] is the sub-list for method input_type
+	179, // [179:179] is the sub-list for extension type_name
+	179, // [179:179] is the sub-list for extension extendee
+	0,   // [0:179] is the sub-list for field type_name
+}
+
+func init() { file_cache_proto_init() }
+func file_cache_proto_init() {
+	if File_cache_proto != nil {
+		return
+	}
+	file_cache_proto_msgTypes[0].OneofWrappers = []any{
+		(*Value_NullValue)(nil),
+		(*Value_BoolValue)(nil),
+		(*Value_NumberValue)(nil),
+		(*Value_StringValue)(nil),
+		(*Value_JsonValue)(nil),
+	}
+	file_cache_proto_msgTypes[101].OneofWrappers = []any{}
+	file_cache_proto_msgTypes[117].OneofWrappers = []any{}
+	file_cache_proto_msgTypes[128].OneofWrappers = []any{}
+	file_cache_proto_msgTypes[132].OneofWrappers = []any{}
+	file_cache_proto_msgTypes[134].OneofWrappers = []any{}
+	file_cache_proto_msgTypes[144].OneofWrappers = []any{
+		(*Command_Get)(nil),
+		(*Command_Gets)(nil),
+		(*Command_Version)(nil),
+		(*Command_Set)(nil),
+		(*Command_Getset)(nil),
+		(*Command_Cas)(nil),
+		(*Command_Incr)(nil),
+		(*Command_Decr)(nil),
+		(*Command_Incrby)(nil),
+		(*Command_Incrbyfloat)(nil),
+		(*Command_Unset)(nil),
+		(*Command_Expire)(nil),
+		(*Command_Expireat)(nil),
+		(*Command_Persist)(nil),
+		(*Command_Ttl)(nil),
+		(*Command_Pttl)(nil),
+		(*Command_Keys)(nil),
+		(*Command_Scan)(nil),
+		(*Command_Rpush)(nil),
+		(*Command_Lpush)(nil),
+		(*Command_Pop)(nil),
+		(*Command_Lpop)(nil),
+		(*Command_Blpop)(nil),
+		(*Command_Brpop)(nil),
+		(*Command_Lgetall)(nil),
+		(*Command_Lget)(nil),
+		(*Command_Llen)(nil),
+		(*Command_Lrange)(nil),
+		(*Command_Lset)(nil),
+		(*Command_Lrem)(nil),
+		(*Command_Ltrim)(nil),
+		(*Command_Linsert)(nil),
+		(*Command_Hset)(nil),
+		(*Command_Hgetall)(nil),
+		(*Command_Hget)(nil),
+		(*Command_Hdel)(nil),
+		(*Command_Hexists)(nil),
+		(*Command_Hlen)(nil),
+		(*Command_Hkeys)(nil),
+		(*Command_Hvals)(nil),
+		(*Command_Hmget)(nil),
+		(*Command_Hsetnx)(nil),
+		(*Command_Hincrby)(nil),
+		(*Command_Hincrbyfloat)(nil),
+		(*Command_Sadd)(nil),
+		(*Command_Srem)(nil),
+		(*Command_Sismember)(nil),
+		(*Command_Smembers)(nil),
+		(*Command_Scard)(nil),
+		(*Command_Spop)(nil),
+		(*Command_Sinter)(nil),
+		(*Command_Sunion)(nil),
+		(*Command_Sdiff)(nil),
+		(*Command_Sinterstore)(nil),
+		(*Command_Sunionstore)(nil),
+		(*Command_Sdiffstore)(nil),
+		(*Command_Zadd)(nil),
+		(*Command_Zrem)(nil),
+		(*Command_Zscore)(nil),
+		(*Command_Zrank)(nil),
+		(*Command_Zrevrank)(nil),
+		(*Command_Zrange)(nil),
+		(*Command_Zrangebyscore)(nil),
+		(*Command_Zcount)(nil),
+		(*Command_Zpopmin)(nil),
+		(*Command_Zpopmax)(nil),
+		(*Command_Stats)(nil),
+		(*Command_Dbsize)(nil),
+		(*Command_Flushdb)(nil),
+		(*Command_Move)(nil),
+	}
+	file_cache_proto_msgTypes[145].OneofWrappers = []any{
+		(*Result_Get)(nil),
+		(*Result_Gets)(nil),
+		(*Result_Version)(nil),
+		(*Result_Set)(nil),
+		(*Result_Getset)(nil),
+		(*Result_Cas)(nil),
+		(*Result_Incr)(nil),
+		(*Result_Decr)(nil),
+		(*Result_Incrby)(nil),
+		(*Result_Incrbyfloat)(nil),
+		(*Result_Unset)(nil),
+		(*Result_Expire)(nil),
+		(*Result_Expireat)(nil),
+		(*Result_Persist)(nil),
+		(*Result_Ttl)(nil),
+		(*Result_Pttl)(nil),
+		(*Result_Keys)(nil),
+		(*Result_Scan)(nil),
+		(*Result_Rpush)(nil),
+		(*Result_Lpush)(nil),
+		(*Result_Pop)(nil),
+		(*Result_Lpop)(nil),
+		(*Result_Blpop)(nil),
+		(*Result_Brpop)(nil),
+		(*Result_Lgetall)(nil),
+		(*Result_Lget)(nil),
+		(*Result_Llen)(nil),
+		(*Result_Lrange)(nil),
+		(*Result_Lset)(nil),
+		(*Result_Lrem)(nil),
+		(*Result_Ltrim)(nil),
+		(*Result_Linsert)(nil),
+		(*Result_Hset)(nil),
+		(*Result_Hgetall)(nil),
+		(*Result_Hget)(nil),
+		(*Result_Hdel)(nil),
+		(*Result_Hexists)(nil),
+		(*Result_Hlen)(nil),
+		(*Result_Hkeys)(nil),
+		(*Result_Hvals)(nil),
+		(*Result_Hmget)(nil),
+		(*Result_Hsetnx)(nil),
+		(*Result_Hincrby)(nil),
+		(*Result_Hincrbyfloat)(nil),
+		(*Result_Sadd)(nil),
+		(*Result_Srem)(nil),
+		(*Result_Sismember)(nil),
+		(*Result_Smembers)(nil),
+		(*Result_Scard)(nil),
+		(*Result_Spop)(nil),
+		(*Result_Sinter)(nil),
+		(*Result_Sunion)(nil),
+		(*Result_Sdiff)(nil),
+		(*Result_Sinterstore)(nil),
+		(*Result_Sunionstore)(nil),
+		(*Result_Sdiffstore)(nil),
+		(*Result_Zadd)(nil),
+		(*Result_Zrem)(nil),
+		(*Result_Zscore)(nil),
+		(*Result_Zrank)(nil),
+		(*Result_Zrevrank)(nil),
+		(*Result_Zrange)(nil),
+		(*Result_Zrangebyscore)(nil),
+		(*Result_Zcount)(nil),
+		(*Result_Zpopmin)(nil),
+		(*Result_Zpopmax)(nil),
+		(*Result_Stats)(nil),
+		(*Result_Dbsize)(nil),
+		(*Result_Flushdb)(nil),
+		(*Result_Move)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cache_proto_rawDesc), len(file_cache_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   158,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cache_proto_goTypes,
+		DependencyIndexes: file_cache_proto_depIdxs,
+		MessageInfos:      file_cache_proto_msgTypes,
+	}.Build()
+	File_cache_proto = out.File
+	file_cache_proto_goTypes = nil
+	file_cache_proto_depIdxs = nil
+}
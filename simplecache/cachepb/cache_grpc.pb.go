@@ -0,0 +1,3012 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: cache.proto
+
+package cachepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Cache_Get_FullMethodName           = "/simplecache.Cache/Get"
+	Cache_Gets_FullMethodName          = "/simplecache.Cache/Gets"
+	Cache_Version_FullMethodName       = "/simplecache.Cache/Version"
+	Cache_Versions_FullMethodName      = "/simplecache.Cache/Versions"
+	Cache_Set_FullMethodName           = "/simplecache.Cache/Set"
+	Cache_GetSet_FullMethodName        = "/simplecache.Cache/GetSet"
+	Cache_Cas_FullMethodName           = "/simplecache.Cache/Cas"
+	Cache_Incr_FullMethodName          = "/simplecache.Cache/Incr"
+	Cache_Decr_FullMethodName          = "/simplecache.Cache/Decr"
+	Cache_IncrBy_FullMethodName        = "/simplecache.Cache/IncrBy"
+	Cache_IncrByFloat_FullMethodName   = "/simplecache.Cache/IncrByFloat"
+	Cache_Unset_FullMethodName         = "/simplecache.Cache/Unset"
+	Cache_Expire_FullMethodName        = "/simplecache.Cache/Expire"
+	Cache_ExpireAt_FullMethodName      = "/simplecache.Cache/ExpireAt"
+	Cache_Persist_FullMethodName       = "/simplecache.Cache/Persist"
+	Cache_Ttl_FullMethodName           = "/simplecache.Cache/Ttl"
+	Cache_Pttl_FullMethodName          = "/simplecache.Cache/Pttl"
+	Cache_Keys_FullMethodName          = "/simplecache.Cache/Keys"
+	Cache_Scan_FullMethodName          = "/simplecache.Cache/Scan"
+	Cache_Rpush_FullMethodName         = "/simplecache.Cache/Rpush"
+	Cache_Lpush_FullMethodName         = "/simplecache.Cache/Lpush"
+	Cache_Pop_FullMethodName           = "/simplecache.Cache/Pop"
+	Cache_Lpop_FullMethodName          = "/simplecache.Cache/Lpop"
+	Cache_Blpop_FullMethodName         = "/simplecache.Cache/Blpop"
+	Cache_Brpop_FullMethodName         = "/simplecache.Cache/Brpop"
+	Cache_Lgetall_FullMethodName       = "/simplecache.Cache/Lgetall"
+	Cache_Lget_FullMethodName          = "/simplecache.Cache/Lget"
+	Cache_Llen_FullMethodName          = "/simplecache.Cache/Llen"
+	Cache_Lrange_FullMethodName        = "/simplecache.Cache/Lrange"
+	Cache_Lset_FullMethodName          = "/simplecache.Cache/Lset"
+	Cache_Lrem_FullMethodName          = "/simplecache.Cache/Lrem"
+	Cache_Ltrim_FullMethodName         = "/simplecache.Cache/Ltrim"
+	Cache_Linsert_FullMethodName       = "/simplecache.Cache/Linsert"
+	Cache_Hset_FullMethodName          = "/simplecache.Cache/Hset"
+	Cache_Hgetall_FullMethodName       = "/simplecache.Cache/Hgetall"
+	Cache_Hget_FullMethodName          = "/simplecache.Cache/Hget"
+	Cache_Hdel_FullMethodName          = "/simplecache.Cache/Hdel"
+	Cache_Hexists_FullMethodName       = "/simplecache.Cache/Hexists"
+	Cache_Hlen_FullMethodName          = "/simplecache.Cache/Hlen"
+	Cache_Hkeys_FullMethodName         = "/simplecache.Cache/Hkeys"
+	Cache_Hvals_FullMethodName         = "/simplecache.Cache/Hvals"
+	Cache_Hmget_FullMethodName         = "/simplecache.Cache/Hmget"
+	Cache_Hsetnx_FullMethodName        = "/simplecache.Cache/Hsetnx"
+	Cache_Hincrby_FullMethodName       = "/simplecache.Cache/Hincrby"
+	Cache_HincrbyFloat_FullMethodName  = "/simplecache.Cache/HincrbyFloat"
+	Cache_Sadd_FullMethodName          = "/simplecache.Cache/Sadd"
+	Cache_Srem_FullMethodName          = "/simplecache.Cache/Srem"
+	Cache_Sismember_FullMethodName     = "/simplecache.Cache/Sismember"
+	Cache_Smembers_FullMethodName      = "/simplecache.Cache/Smembers"
+	Cache_Scard_FullMethodName         = "/simplecache.Cache/Scard"
+	Cache_Spop_FullMethodName          = "/simplecache.Cache/Spop"
+	Cache_Sinter_FullMethodName        = "/simplecache.Cache/Sinter"
+	Cache_Sunion_FullMethodName        = "/simplecache.Cache/Sunion"
+	Cache_Sdiff_FullMethodName         = "/simplecache.Cache/Sdiff"
+	Cache_SinterStore_FullMethodName   = "/simplecache.Cache/SinterStore"
+	Cache_SunionStore_FullMethodName   = "/simplecache.Cache/SunionStore"
+	Cache_SdiffStore_FullMethodName    = "/simplecache.Cache/SdiffStore"
+	Cache_Zadd_FullMethodName          = "/simplecache.Cache/Zadd"
+	Cache_Zrem_FullMethodName          = "/simplecache.Cache/Zrem"
+	Cache_Zscore_FullMethodName        = "/simplecache.Cache/Zscore"
+	Cache_Zrank_FullMethodName         = "/simplecache.Cache/Zrank"
+	Cache_Zrevrank_FullMethodName      = "/simplecache.Cache/Zrevrank"
+	Cache_Zrange_FullMethodName        = "/simplecache.Cache/Zrange"
+	Cache_ZrangeByScore_FullMethodName = "/simplecache.Cache/ZrangeByScore"
+	Cache_Zcount_FullMethodName        = "/simplecache.Cache/Zcount"
+	Cache_ZpopMin_FullMethodName       = "/simplecache.Cache/ZpopMin"
+	Cache_ZpopMax_FullMethodName       = "/simplecache.Cache/ZpopMax"
+	Cache_Stats_FullMethodName         = "/simplecache.Cache/Stats"
+	Cache_DbSize_FullMethodName        = "/simplecache.Cache/DbSize"
+	Cache_FlushDb_FullMethodName       = "/simplecache.Cache/FlushDb"
+	Cache_Move_FullMethodName          = "/simplecache.Cache/Move"
+	Cache_Batch_FullMethodName         = "/simplecache.Cache/Batch"
+	Cache_Tx_FullMethodName            = "/simplecache.Cache/Tx"
+	Cache_Publish_FullMethodName       = "/simplecache.Cache/Publish"
+	Cache_Subscribe_FullMethodName     = "/simplecache.Cache/Subscribe"
+	Cache_Notifications_FullMethodName = "/simplecache.Cache/Notifications"
+)
+
+// CacheClient is the client API for Cache service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Cache is the gRPC API of simplecache. Every operation of the HTTP API is
+// an RPC named like its endpoint, with the fields of its request body or
+// of its path and query parameters. Errors are returned as a status:
+// NOT_FOUND for missing keys and elements, FAILED_PRECONDITION for a wrong
+// type or a redirect of the cluster, RESOURCE_EXHAUSTED when the memory
+// limit is reached. The x-cache-db metadata selects the database of a call
+// like the X-Cache-DB header.
+type CacheClient interface {
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	Gets(ctx context.Context, in *GetsRequest, opts ...grpc.CallOption) (*GetsResponse, error)
+	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
+	Versions(ctx context.Context, in *VersionsRequest, opts ...grpc.CallOption) (*VersionsResponse, error)
+	Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error)
+	GetSet(ctx context.Context, in *GetSetRequest, opts ...grpc.CallOption) (*GetSetResponse, error)
+	// Cas fails with ABORTED when the key has another version.
+	Cas(ctx context.Context, in *CasRequest, opts ...grpc.CallOption) (*CasResponse, error)
+	Incr(ctx context.Context, in *IncrRequest, opts ...grpc.CallOption) (*IncrResponse, error)
+	Decr(ctx context.Context, in *DecrRequest, opts ...grpc.CallOption) (*DecrResponse, error)
+	IncrBy(ctx context.Context, in *IncrByRequest, opts ...grpc.CallOption) (*IncrByResponse, error)
+	IncrByFloat(ctx context.Context, in *IncrByFloatRequest, opts ...grpc.CallOption) (*IncrByFloatResponse, error)
+	Unset(ctx context.Context, in *UnsetRequest, opts ...grpc.CallOption) (*UnsetResponse, error)
+	Expire(ctx context.Context, in *ExpireRequest, opts ...grpc.CallOption) (*ExpireResponse, error)
+	ExpireAt(ctx context.Context, in *ExpireAtRequest, opts ...grpc.CallOption) (*ExpireAtResponse, error)
+	Persist(ctx context.Context, in *PersistRequest, opts ...grpc.CallOption) (*PersistResponse, error)
+	Ttl(ctx context.Context, in *TtlRequest, opts ...grpc.CallOption) (*TtlResponse, error)
+	Pttl(ctx context.Context, in *PttlRequest, opts ...grpc.CallOption) (*PttlResponse, error)
+	Keys(ctx context.Context, in *KeysRequest, opts ...grpc.CallOption) (*KeysResponse, error)
+	Scan(ctx context.Context, in *ScanRequest, opts ...grpc.CallOption) (*ScanResponse, error)
+	Rpush(ctx context.Context, in *RpushRequest, opts ...grpc.CallOption) (*RpushResponse, error)
+	Lpush(ctx context.Context, in *LpushRequest, opts ...grpc.CallOption) (*LpushResponse, error)
+	Pop(ctx context.Context, in *PopRequest, opts ...grpc.CallOption) (*PopResponse, error)
+	Lpop(ctx context.Context, in *LpopRequest, opts ...grpc.CallOption) (*LpopResponse, error)
+	// Blpop and Brpop wait like their endpoints and fail with NOT_FOUND
+	// when the timeout passes.
+	Blpop(ctx context.Context, in *BlpopRequest, opts ...grpc.CallOption) (*BlpopResponse, error)
+	Brpop(ctx context.Context, in *BrpopRequest, opts ...grpc.CallOption) (*BrpopResponse, error)
+	Lgetall(ctx context.Context, in *LgetallRequest, opts ...grpc.CallOption) (*LgetallResponse, error)
+	Lget(ctx context.Context, in *LgetRequest, opts ...grpc.CallOption) (*LgetResponse, error)
+	Llen(ctx context.Context, in *LlenRequest, opts ...grpc.CallOption) (*LlenResponse, error)
+	Lrange(ctx context.Context, in *LrangeRequest, opts ...grpc.CallOption) (*LrangeResponse, error)
+	Lset(ctx context.Context, in *LsetRequest, opts ...grpc.CallOption) (*LsetResponse, error)
+	Lrem(ctx context.Context, in *LremRequest, opts ...grpc.CallOption) (*LremResponse, error)
+	Ltrim(ctx context.Context, in *LtrimRequest, opts ...grpc.CallOption) (*LtrimResponse, error)
+	Linsert(ctx context.Context, in *LinsertRequest, opts ...grpc.CallOption) (*LinsertResponse, error)
+	Hset(ctx context.Context, in *HsetRequest, opts ...grpc.CallOption) (*HsetResponse, error)
+	Hgetall(ctx context.Context, in *HgetallRequest, opts ...grpc.CallOption) (*HgetallResponse, error)
+	Hget(ctx context.Context, in *HgetRequest, opts ...grpc.CallOption) (*HgetResponse, error)
+	Hdel(ctx context.Context, in *HdelRequest, opts ...grpc.CallOption) (*HdelResponse, error)
+	Hexists(ctx context.Context, in *HexistsRequest, opts ...grpc.CallOption) (*HexistsResponse, error)
+	Hlen(ctx context.Context, in *HlenRequest, opts ...grpc.CallOption) (*HlenResponse, error)
+	Hkeys(ctx context.Context, in *HkeysRequest, opts ...grpc.CallOption) (*HkeysResponse, error)
+	Hvals(ctx context.Context, in *HvalsRequest, opts ...grpc.CallOption) (*HvalsResponse, error)
+	Hmget(ctx context.Context, in *HmgetRequest, opts ...grpc.CallOption) (*HmgetResponse, error)
+	Hsetnx(ctx context.Context, in *HsetnxRequest, opts ...grpc.CallOption) (*HsetnxResponse, error)
+	Hincrby(ctx context.Context, in *HincrbyRequest, opts ...grpc.CallOption) (*HincrbyResponse, error)
+	HincrbyFloat(ctx context.Context, in *HincrbyFloatRequest, opts ...grpc.CallOption) (*HincrbyFloatResponse, error)
+	Sadd(ctx context.Context, in *SaddRequest, opts ...grpc.CallOption) (*SaddResponse, error)
+	Srem(ctx context.Context, in *SremRequest, opts ...grpc.CallOption) (*SremResponse, error)
+	Sismember(ctx context.Context, in *SismemberRequest, opts ...grpc.CallOption) (*SismemberResponse, error)
+	Smembers(ctx context.Context, in *SmembersRequest, opts ...grpc.CallOption) (*SmembersResponse, error)
+	Scard(ctx context.Context, in *ScardRequest, opts ...grpc.CallOption) (*ScardResponse, error)
+	Spop(ctx context.Context, in *SpopRequest, opts ...grpc.CallOption) (*SpopResponse, error)
+	Sinter(ctx context.Context, in *SinterRequest, opts ...grpc.CallOption) (*SinterResponse, error)
+	Sunion(ctx context.Context, in *SunionRequest, opts ...grpc.CallOption) (*SunionResponse, error)
+	Sdiff(ctx context.Context, in *SdiffRequest, opts ...grpc.CallOption) (*SdiffResponse, error)
+	SinterStore(ctx context.Context, in *SinterStoreRequest, opts ...grpc.CallOption) (*SinterStoreResponse, error)
+	SunionStore(ctx context.Context, in *SunionStoreRequest, opts ...grpc.CallOption) (*SunionStoreResponse, error)
+	SdiffStore(ctx context.Context, in *SdiffStoreRequest, opts ...grpc.CallOption) (*SdiffStoreResponse, error)
+	Zadd(ctx context.Context, in *ZaddRequest, opts ...grpc.CallOption) (*ZaddResponse, error)
+	Zrem(ctx context.Context, in *ZremRequest, opts ...grpc.CallOption) (*ZremResponse, error)
+	Zscore(ctx context.Context, in *ZscoreRequest, opts ...grpc.CallOption) (*ZscoreResponse, error)
+	Zrank(ctx context.Context, in *ZrankRequest, opts ...grpc.CallOption) (*ZrankResponse, error)
+	Zrevrank(ctx context.Context, in *ZrevrankRequest, opts ...grpc.CallOption) (*ZrevrankResponse, error)
+	Zrange(ctx context.Context, in *ZrangeRequest, opts ...grpc.CallOption) (*ZrangeResponse, error)
+	ZrangeByScore(ctx context.Context, in *ZrangeByScoreRequest, opts ...grpc.CallOption) (*ZrangeByScoreResponse, error)
+	Zcount(ctx context.Context, in *ZcountRequest, opts ...grpc.CallOption) (*ZcountResponse, error)
+	ZpopMin(ctx context.Context, in *ZpopMinRequest, opts ...grpc.CallOption) (*ZpopMinResponse, error)
+	ZpopMax(ctx context.Context, in *ZpopMaxRequest, opts ...grpc.CallOption) (*ZpopMaxResponse, error)
+	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error)
+	DbSize(ctx context.Context, in *DbSizeRequest, opts ...grpc.CallOption) (*DbSizeResponse, error)
+	FlushDb(ctx context.Context, in *FlushDbRequest, opts ...grpc.CallOption) (*FlushDbResponse, error)
+	Move(ctx context.Context, in *MoveRequest, opts ...grpc.CallOption) (*MoveResponse, error)
+	// Batch runs the commands in the order they arrive and sends back a
+	// result for each of them, in the same order. Blocking pops wait.
+	Batch(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[Command, Result], error)
+	// Tx runs the commands atomically, see /tx. A changed watched key fails
+	// with ABORTED, a malformed command or a type error with
+	// FAILED_PRECONDITION.
+	Tx(ctx context.Context, in *TxRequest, opts ...grpc.CallOption) (*TxResponse, error)
+	Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*PublishResponse, error)
+	// Subscribe streams the messages of the channels and patterns. A
+	// subscriber that falls behind gets RESOURCE_EXHAUSTED.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Message], error)
+	// Notifications streams the keyspace events of the keys matching the
+	// pattern, only of the given events when there are any.
+	Notifications(ctx context.Context, in *NotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[KeyspaceEvent], error)
+}
+
+type cacheClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCacheClient(cc grpc.ClientConnInterface) CacheClient {
+	return &cacheClient{cc}
+}
+
+func (c *cacheClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetResponse)
+	err := c.cc.Invoke(ctx, Cache_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Gets(ctx context.Context, in *GetsRequest, opts ...grpc.CallOption) (*GetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetsResponse)
+	err := c.cc.Invoke(ctx, Cache_Gets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VersionResponse)
+	err := c.cc.Invoke(ctx, Cache_Version_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Versions(ctx context.Context, in *VersionsRequest, opts ...grpc.CallOption) (*VersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VersionsResponse)
+	err := c.cc.Invoke(ctx, Cache_Versions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetResponse)
+	err := c.cc.Invoke(ctx, Cache_Set_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) GetSet(ctx context.Context, in *GetSetRequest, opts ...grpc.CallOption) (*GetSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSetResponse)
+	err := c.cc.Invoke(ctx, Cache_GetSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Cas(ctx context.Context, in *CasRequest, opts ...grpc.CallOption) (*CasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CasResponse)
+	err := c.cc.Invoke(ctx, Cache_Cas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Incr(ctx context.Context, in *IncrRequest, opts ...grpc.CallOption) (*IncrResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IncrResponse)
+	err := c.cc.Invoke(ctx, Cache_Incr_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Decr(ctx context.Context, in *DecrRequest, opts ...grpc.CallOption) (*DecrResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DecrResponse)
+	err := c.cc.Invoke(ctx, Cache_Decr_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) IncrBy(ctx context.Context, in *IncrByRequest, opts ...grpc.CallOption) (*IncrByResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IncrByResponse)
+	err := c.cc.Invoke(ctx, Cache_IncrBy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) IncrByFloat(ctx context.Context, in *IncrByFloatRequest, opts ...grpc.CallOption) (*IncrByFloatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IncrByFloatResponse)
+	err := c.cc.Invoke(ctx, Cache_IncrByFloat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Unset(ctx context.Context, in *UnsetRequest, opts ...grpc.CallOption) (*UnsetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnsetResponse)
+	err := c.cc.Invoke(ctx, Cache_Unset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Expire(ctx context.Context, in *ExpireRequest, opts ...grpc.CallOption) (*ExpireResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpireResponse)
+	err := c.cc.Invoke(ctx, Cache_Expire_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) ExpireAt(ctx context.Context, in *ExpireAtRequest, opts ...grpc.CallOption) (*ExpireAtResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpireAtResponse)
+	err := c.cc.Invoke(ctx, Cache_ExpireAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Persist(ctx context.Context, in *PersistRequest, opts ...grpc.CallOption) (*PersistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PersistResponse)
+	err := c.cc.Invoke(ctx, Cache_Persist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Ttl(ctx context.Context, in *TtlRequest, opts ...grpc.CallOption) (*TtlResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TtlResponse)
+	err := c.cc.Invoke(ctx, Cache_Ttl_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Pttl(ctx context.Context, in *PttlRequest, opts ...grpc.CallOption) (*PttlResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PttlResponse)
+	err := c.cc.Invoke(ctx, Cache_Pttl_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Keys(ctx context.Context, in *KeysRequest, opts ...grpc.CallOption) (*KeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KeysResponse)
+	err := c.cc.Invoke(ctx, Cache_Keys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Scan(ctx context.Context, in *ScanRequest, opts ...grpc.CallOption) (*ScanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScanResponse)
+	err := c.cc.Invoke(ctx, Cache_Scan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Rpush(ctx context.Context, in *RpushRequest, opts ...grpc.CallOption) (*RpushResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RpushResponse)
+	err := c.cc.Invoke(ctx, Cache_Rpush_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Lpush(ctx context.Context, in *LpushRequest, opts ...grpc.CallOption) (*LpushResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LpushResponse)
+	err := c.cc.Invoke(ctx, Cache_Lpush_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Pop(ctx context.Context, in *PopRequest, opts ...grpc.CallOption) (*PopResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PopResponse)
+	err := c.cc.Invoke(ctx, Cache_Pop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Lpop(ctx context.Context, in *LpopRequest, opts ...grpc.CallOption) (*LpopResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LpopResponse)
+	err := c.cc.Invoke(ctx, Cache_Lpop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Blpop(ctx context.Context, in *BlpopRequest, opts ...grpc.CallOption) (*BlpopResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlpopResponse)
+	err := c.cc.Invoke(ctx, Cache_Blpop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Brpop(ctx context.Context, in *BrpopRequest, opts ...grpc.CallOption) (*BrpopResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BrpopResponse)
+	err := c.cc.Invoke(ctx, Cache_Brpop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Lgetall(ctx context.Context, in *LgetallRequest, opts ...grpc.CallOption) (*LgetallResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LgetallResponse)
+	err := c.cc.Invoke(ctx, Cache_Lgetall_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Lget(ctx context.Context, in *LgetRequest, opts ...grpc.CallOption) (*LgetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LgetResponse)
+	err := c.cc.Invoke(ctx, Cache_Lget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Llen(ctx context.Context, in *LlenRequest, opts ...grpc.CallOption) (*LlenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LlenResponse)
+	err := c.cc.Invoke(ctx, Cache_Llen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Lrange(ctx context.Context, in *LrangeRequest, opts ...grpc.CallOption) (*LrangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LrangeResponse)
+	err := c.cc.Invoke(ctx, Cache_Lrange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Lset(ctx context.Context, in *LsetRequest, opts ...grpc.CallOption) (*LsetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LsetResponse)
+	err := c.cc.Invoke(ctx, Cache_Lset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Lrem(ctx context.Context, in *LremRequest, opts ...grpc.CallOption) (*LremResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LremResponse)
+	err := c.cc.Invoke(ctx, Cache_Lrem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Ltrim(ctx context.Context, in *LtrimRequest, opts ...grpc.CallOption) (*LtrimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LtrimResponse)
+	err := c.cc.Invoke(ctx, Cache_Ltrim_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Linsert(ctx context.Context, in *LinsertRequest, opts ...grpc.CallOption) (*LinsertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinsertResponse)
+	err := c.cc.Invoke(ctx, Cache_Linsert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Hset(ctx context.Context, in *HsetRequest, opts ...grpc.CallOption) (*HsetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HsetResponse)
+	err := c.cc.Invoke(ctx, Cache_Hset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Hgetall(ctx context.Context, in *HgetallRequest, opts ...grpc.CallOption) (*HgetallResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HgetallResponse)
+	err := c.cc.Invoke(ctx, Cache_Hgetall_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Hget(ctx context.Context, in *HgetRequest, opts ...grpc.CallOption) (*HgetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HgetResponse)
+	err := c.cc.Invoke(ctx, Cache_Hget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Hdel(ctx context.Context, in *HdelRequest, opts ...grpc.CallOption) (*HdelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HdelResponse)
+	err := c.cc.Invoke(ctx, Cache_Hdel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Hexists(ctx context.Context, in *HexistsRequest, opts ...grpc.CallOption) (*HexistsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HexistsResponse)
+	err := c.cc.Invoke(ctx, Cache_Hexists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Hlen(ctx context.Context, in *HlenRequest, opts ...grpc.CallOption) (*HlenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HlenResponse)
+	err := c.cc.Invoke(ctx, Cache_Hlen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Hkeys(ctx context.Context, in *HkeysRequest, opts ...grpc.CallOption) (*HkeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HkeysResponse)
+	err := c.cc.Invoke(ctx, Cache_Hkeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Hvals(ctx context.Context, in *HvalsRequest, opts ...grpc.CallOption) (*HvalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HvalsResponse)
+	err := c.cc.Invoke(ctx, Cache_Hvals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Hmget(ctx context.Context, in *HmgetRequest, opts ...grpc.CallOption) (*HmgetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HmgetResponse)
+	err := c.cc.Invoke(ctx, Cache_Hmget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Hsetnx(ctx context.Context, in *HsetnxRequest, opts ...grpc.CallOption) (*HsetnxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HsetnxResponse)
+	err := c.cc.Invoke(ctx, Cache_Hsetnx_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Hincrby(ctx context.Context, in *HincrbyRequest, opts ...grpc.CallOption) (*HincrbyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HincrbyResponse)
+	err := c.cc.Invoke(ctx, Cache_Hincrby_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) HincrbyFloat(ctx context.Context, in *HincrbyFloatRequest, opts ...grpc.CallOption) (*HincrbyFloatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HincrbyFloatResponse)
+	err := c.cc.Invoke(ctx, Cache_HincrbyFloat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Sadd(ctx context.Context, in *SaddRequest, opts ...grpc.CallOption) (*SaddResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaddResponse)
+	err := c.cc.Invoke(ctx, Cache_Sadd_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Srem(ctx context.Context, in *SremRequest, opts ...grpc.CallOption) (*SremResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SremResponse)
+	err := c.cc.Invoke(ctx, Cache_Srem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Sismember(ctx context.Context, in *SismemberRequest, opts ...grpc.CallOption) (*SismemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SismemberResponse)
+	err := c.cc.Invoke(ctx, Cache_Sismember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Smembers(ctx context.Context, in *SmembersRequest, opts ...grpc.CallOption) (*SmembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SmembersResponse)
+	err := c.cc.Invoke(ctx, Cache_Smembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Scard(ctx context.Context, in *ScardRequest, opts ...grpc.CallOption) (*ScardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScardResponse)
+	err := c.cc.Invoke(ctx, Cache_Scard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Spop(ctx context.Context, in *SpopRequest, opts ...grpc.CallOption) (*SpopResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpopResponse)
+	err := c.cc.Invoke(ctx, Cache_Spop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Sinter(ctx context.Context, in *SinterRequest, opts ...grpc.CallOption) (*SinterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SinterResponse)
+	err := c.cc.Invoke(ctx, Cache_Sinter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Sunion(ctx context.Context, in *SunionRequest, opts ...grpc.CallOption) (*SunionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SunionResponse)
+	err := c.cc.Invoke(ctx, Cache_Sunion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Sdiff(ctx context.Context, in *SdiffRequest, opts ...grpc.CallOption) (*SdiffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SdiffResponse)
+	err := c.cc.Invoke(ctx, Cache_Sdiff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) SinterStore(ctx context.Context, in *SinterStoreRequest, opts ...grpc.CallOption) (*SinterStoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SinterStoreResponse)
+	err := c.cc.Invoke(ctx, Cache_SinterStore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) SunionStore(ctx context.Context, in *SunionStoreRequest, opts ...grpc.CallOption) (*SunionStoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SunionStoreResponse)
+	err := c.cc.Invoke(ctx, Cache_SunionStore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) SdiffStore(ctx context.Context, in *SdiffStoreRequest, opts ...grpc.CallOption) (*SdiffStoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SdiffStoreResponse)
+	err := c.cc.Invoke(ctx, Cache_SdiffStore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Zadd(ctx context.Context, in *ZaddRequest, opts ...grpc.CallOption) (*ZaddResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZaddResponse)
+	err := c.cc.Invoke(ctx, Cache_Zadd_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Zrem(ctx context.Context, in *ZremRequest, opts ...grpc.CallOption) (*ZremResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZremResponse)
+	err := c.cc.Invoke(ctx, Cache_Zrem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Zscore(ctx context.Context, in *ZscoreRequest, opts ...grpc.CallOption) (*ZscoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZscoreResponse)
+	err := c.cc.Invoke(ctx, Cache_Zscore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Zrank(ctx context.Context, in *ZrankRequest, opts ...grpc.CallOption) (*ZrankResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZrankResponse)
+	err := c.cc.Invoke(ctx, Cache_Zrank_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Zrevrank(ctx context.Context, in *ZrevrankRequest, opts ...grpc.CallOption) (*ZrevrankResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZrevrankResponse)
+	err := c.cc.Invoke(ctx, Cache_Zrevrank_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Zrange(ctx context.Context, in *ZrangeRequest, opts ...grpc.CallOption) (*ZrangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZrangeResponse)
+	err := c.cc.Invoke(ctx, Cache_Zrange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) ZrangeByScore(ctx context.Context, in *ZrangeByScoreRequest, opts ...grpc.CallOption) (*ZrangeByScoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZrangeByScoreResponse)
+	err := c.cc.Invoke(ctx, Cache_ZrangeByScore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Zcount(ctx context.Context, in *ZcountRequest, opts ...grpc.CallOption) (*ZcountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZcountResponse)
+	err := c.cc.Invoke(ctx, Cache_Zcount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) ZpopMin(ctx context.Context, in *ZpopMinRequest, opts ...grpc.CallOption) (*ZpopMinResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZpopMinResponse)
+	err := c.cc.Invoke(ctx, Cache_ZpopMin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) ZpopMax(ctx context.Context, in *ZpopMaxRequest, opts ...grpc.CallOption) (*ZpopMaxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZpopMaxResponse)
+	err := c.cc.Invoke(ctx, Cache_ZpopMax_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatsResponse)
+	err := c.cc.Invoke(ctx, Cache_Stats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) DbSize(ctx context.Context, in *DbSizeRequest, opts ...grpc.CallOption) (*DbSizeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DbSizeResponse)
+	err := c.cc.Invoke(ctx, Cache_DbSize_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) FlushDb(ctx context.Context, in *FlushDbRequest, opts ...grpc.CallOption) (*FlushDbResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlushDbResponse)
+	err := c.cc.Invoke(ctx, Cache_FlushDb_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Move(ctx context.Context, in *MoveRequest, opts ...grpc.CallOption) (*MoveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveResponse)
+	err := c.cc.Invoke(ctx, Cache_Move_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Batch(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[Command, Result], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Cache_ServiceDesc.Streams[0], Cache_Batch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Command, Result]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cache_BatchClient = grpc.BidiStreamingClient[Command, Result]
+
+func (c *cacheClient) Tx(ctx context.Context, in *TxRequest, opts ...grpc.CallOption) (*TxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TxResponse)
+	err := c.cc.Invoke(ctx, Cache_Tx_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*PublishResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishResponse)
+	err := c.cc.Invoke(ctx, Cache_Publish_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Message], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Cache_ServiceDesc.Streams[1], Cache_Subscribe_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeRequest, Message]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cache_SubscribeClient = grpc.ServerStreamingClient[Message]
+
+func (c *cacheClient) Notifications(ctx context.Context, in *NotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[KeyspaceEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Cache_ServiceDesc.Streams[2], Cache_Notifications_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[NotificationsRequest, KeyspaceEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cache_NotificationsClient = grpc.ServerStreamingClient[KeyspaceEvent]
+
+// CacheServer is the server API for Cache service.
+// All implementations must embed UnimplementedCacheServer
+// for forward compatibility.
+//
+// Cache is the gRPC API of simplecache. Every operation of the HTTP API is
+// an RPC named like its endpoint, with the fields of its request body or
+// of its path and query parameters. Errors are returned as a status:
+// NOT_FOUND for missing keys and elements, FAILED_PRECONDITION for a wrong
+// type or a redirect of the cluster, RESOURCE_EXHAUSTED when the memory
+// limit is reached. The x-cache-db metadata selects the database of a call
+// like the X-Cache-DB header.
+type CacheServer interface {
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	Gets(context.Context, *GetsRequest) (*GetsResponse, error)
+	Version(context.Context, *VersionRequest) (*VersionResponse, error)
+	Versions(context.Context, *VersionsRequest) (*VersionsResponse, error)
+	Set(context.Context, *SetRequest) (*SetResponse, error)
+	GetSet(context.Context, *GetSetRequest) (*GetSetResponse, error)
+	// Cas fails with ABORTED when the key has another version.
+	Cas(context.Context, *CasRequest) (*CasResponse, error)
+	Incr(context.Context, *IncrRequest) (*IncrResponse, error)
+	Decr(context.Context, *DecrRequest) (*DecrResponse, error)
+	IncrBy(context.Context, *IncrByRequest) (*IncrByResponse, error)
+	IncrByFloat(context.Context, *IncrByFloatRequest) (*IncrByFloatResponse, error)
+	Unset(context.Context, *UnsetRequest) (*UnsetResponse, error)
+	Expire(context.Context, *ExpireRequest) (*ExpireResponse, error)
+	ExpireAt(context.Context, *ExpireAtRequest) (*ExpireAtResponse, error)
+	Persist(context.Context, *PersistRequest) (*PersistResponse, error)
+	Ttl(context.Context, *TtlRequest) (*TtlResponse, error)
+	Pttl(context.Context, *PttlRequest) (*PttlResponse, error)
+	Keys(context.Context, *KeysRequest) (*KeysResponse, error)
+	Scan(context.Context, *ScanRequest) (*ScanResponse, error)
+	Rpush(context.Context, *RpushRequest) (*RpushResponse, error)
+	Lpush(context.Context, *LpushRequest) (*LpushResponse, error)
+	Pop(context.Context, *PopRequest) (*PopResponse, error)
+	Lpop(context.Context, *LpopRequest) (*LpopResponse, error)
+	// Blpop and Brpop wait like their endpoints and fail with NOT_FOUND
+	// when the timeout passes.
+	Blpop(context.Context, *BlpopRequest) (*BlpopResponse, error)
+	Brpop(context.Context, *BrpopRequest) (*BrpopResponse, error)
+	Lgetall(context.Context, *LgetallRequest) (*LgetallResponse, error)
+	Lget(context.Context, *LgetRequest) (*LgetResponse, error)
+	Llen(context.Context, *LlenRequest) (*LlenResponse, error)
+	Lrange(context.Context, *LrangeRequest) (*LrangeResponse, error)
+	Lset(context.Context, *LsetRequest) (*LsetResponse, error)
+	Lrem(context.Context, *LremRequest) (*LremResponse, error)
+	Ltrim(context.Context, *LtrimRequest) (*LtrimResponse, error)
+	Linsert(context.Context, *LinsertRequest) (*LinsertResponse, error)
+	Hset(context.Context, *HsetRequest) (*HsetResponse, error)
+	Hgetall(context.Context, *HgetallRequest) (*HgetallResponse, error)
+	Hget(context.Context, *HgetRequest) (*HgetResponse, error)
+	Hdel(context.Context, *HdelRequest) (*HdelResponse, error)
+	Hexists(context.Context, *HexistsRequest) (*HexistsResponse, error)
+	Hlen(context.Context, *HlenRequest) (*HlenResponse, error)
+	Hkeys(context.Context, *HkeysRequest) (*HkeysResponse, error)
+	Hvals(context.Context, *HvalsRequest) (*HvalsResponse, error)
+	Hmget(context.Context, *HmgetRequest) (*HmgetResponse, error)
+	Hsetnx(context.Context, *HsetnxRequest) (*HsetnxResponse, error)
+	Hincrby(context.Context, *HincrbyRequest) (*HincrbyResponse, error)
+	HincrbyFloat(context.Context, *HincrbyFloatRequest) (*HincrbyFloatResponse, error)
+	Sadd(context.Context, *SaddRequest) (*SaddResponse, error)
+	Srem(context.Context, *SremRequest) (*SremResponse, error)
+	Sismember(context.Context, *SismemberRequest) (*SismemberResponse, error)
+	Smembers(context.Context, *SmembersRequest) (*SmembersResponse, error)
+	Scard(context.Context, *ScardRequest) (*ScardResponse, error)
+	Spop(context.Context, *SpopRequest) (*SpopResponse, error)
+	Sinter(context.Context, *SinterRequest) (*SinterResponse, error)
+	Sunion(context.Context, *SunionRequest) (*SunionResponse, error)
+	Sdiff(context.Context, *SdiffRequest) (*SdiffResponse, error)
+	SinterStore(context.Context, *SinterStoreRequest) (*SinterStoreResponse, error)
+	SunionStore(context.Context, *SunionStoreRequest) (*SunionStoreResponse, error)
+	SdiffStore(context.Context, *SdiffStoreRequest) (*SdiffStoreResponse, error)
+	Zadd(context.Context, *ZaddRequest) (*ZaddResponse, error)
+	Zrem(context.Context, *ZremRequest) (*ZremResponse, error)
+	Zscore(context.Context, *ZscoreRequest) (*ZscoreResponse, error)
+	Zrank(context.Context, *ZrankRequest) (*ZrankResponse, error)
+	Zrevrank(context.Context, *ZrevrankRequest) (*ZrevrankResponse, error)
+	Zrange(context.Context, *ZrangeRequest) (*ZrangeResponse, error)
+	ZrangeByScore(context.Context, *ZrangeByScoreRequest) (*ZrangeByScoreResponse, error)
+	Zcount(context.Context, *ZcountRequest) (*ZcountResponse, error)
+	ZpopMin(context.Context, *ZpopMinRequest) (*ZpopMinResponse, error)
+	ZpopMax(context.Context, *ZpopMaxRequest) (*ZpopMaxResponse, error)
+	Stats(context.Context, *StatsRequest) (*StatsResponse, error)
+	DbSize(context.Context, *DbSizeRequest) (*DbSizeResponse, error)
+	FlushDb(context.Context, *FlushDbRequest) (*FlushDbResponse, error)
+	Move(context.Context, *MoveRequest) (*MoveResponse, error)
+	// Batch runs the commands in the order they arrive and sends back a
+	// result for each of them, in the same order. Blocking pops wait.
+	Batch(grpc.BidiStreamingServer[Command, Result]) error
+	// Tx runs the commands atomically, see /tx. A changed watched key fails
+	// with ABORTED, a malformed command or a type error with
+	// FAILED_PRECONDITION.
+	Tx(context.Context, *TxRequest) (*TxResponse, error)
+	Publish(context.Context, *PublishRequest) (*PublishResponse, error)
+	// Subscribe streams the messages of the channels and patterns. A
+	// subscriber that falls behind gets RESOURCE_EXHAUSTED.
+	Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[Message]) error
+	// Notifications streams the keyspace events of the keys matching the
+	// pattern, only of the given events when there are any.
+	Notifications(*NotificationsRequest, grpc.ServerStreamingServer[KeyspaceEvent]) error
+	mustEmbedUnimplementedCacheServer()
+}
+
+// UnimplementedCacheServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCacheServer struct{}
+
+func (UnimplementedCacheServer) Get(context.Context, *GetRequest) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedCacheServer) Gets(context.Context, *GetsRequest) (*GetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Gets not implemented")
+}
+func (UnimplementedCacheServer) Version(context.Context, *VersionRequest) (*VersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
+}
+func (UnimplementedCacheServer) Versions(context.Context, *VersionsRequest) (*VersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Versions not implemented")
+}
+func (UnimplementedCacheServer) Set(context.Context, *SetRequest) (*SetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (UnimplementedCacheServer) GetSet(context.Context, *GetSetRequest) (*GetSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSet not implemented")
+}
+func (UnimplementedCacheServer) Cas(context.Context, *CasRequest) (*CasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cas not implemented")
+}
+func (UnimplementedCacheServer) Incr(context.Context, *IncrRequest) (*IncrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Incr not implemented")
+}
+func (UnimplementedCacheServer) Decr(context.Context, *DecrRequest) (*DecrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Decr not implemented")
+}
+func (UnimplementedCacheServer) IncrBy(context.Context, *IncrByRequest) (*IncrByResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncrBy not implemented")
+}
+func (UnimplementedCacheServer) IncrByFloat(context.Context, *IncrByFloatRequest) (*IncrByFloatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncrByFloat not implemented")
+}
+func (UnimplementedCacheServer) Unset(context.Context, *UnsetRequest) (*UnsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unset not implemented")
+}
+func (UnimplementedCacheServer) Expire(context.Context, *ExpireRequest) (*ExpireResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Expire not implemented")
+}
+func (UnimplementedCacheServer) ExpireAt(context.Context, *ExpireAtRequest) (*ExpireAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpireAt not implemented")
+}
+func (UnimplementedCacheServer) Persist(context.Context, *PersistRequest) (*PersistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Persist not implemented")
+}
+func (UnimplementedCacheServer) Ttl(context.Context, *TtlRequest) (*TtlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ttl not implemented")
+}
+func (UnimplementedCacheServer) Pttl(context.Context, *PttlRequest) (*PttlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pttl not implemented")
+}
+func (UnimplementedCacheServer) Keys(context.Context, *KeysRequest) (*KeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Keys not implemented")
+}
+func (UnimplementedCacheServer) Scan(context.Context, *ScanRequest) (*ScanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Scan not implemented")
+}
+func (UnimplementedCacheServer) Rpush(context.Context, *RpushRequest) (*RpushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rpush not implemented")
+}
+func (UnimplementedCacheServer) Lpush(context.Context, *LpushRequest) (*LpushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lpush not implemented")
+}
+func (UnimplementedCacheServer) Pop(context.Context, *PopRequest) (*PopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pop not implemented")
+}
+func (UnimplementedCacheServer) Lpop(context.Context, *LpopRequest) (*LpopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lpop not implemented")
+}
+func (UnimplementedCacheServer) Blpop(context.Context, *BlpopRequest) (*BlpopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Blpop not implemented")
+}
+func (UnimplementedCacheServer) Brpop(context.Context, *BrpopRequest) (*BrpopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Brpop not implemented")
+}
+func (UnimplementedCacheServer) Lgetall(context.Context, *LgetallRequest) (*LgetallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lgetall not implemented")
+}
+func (UnimplementedCacheServer) Lget(context.Context, *LgetRequest) (*LgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lget not implemented")
+}
+func (UnimplementedCacheServer) Llen(context.Context, *LlenRequest) (*LlenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Llen not implemented")
+}
+func (UnimplementedCacheServer) Lrange(context.Context, *LrangeRequest) (*LrangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lrange not implemented")
+}
+func (UnimplementedCacheServer) Lset(context.Context, *LsetRequest) (*LsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lset not implemented")
+}
+func (UnimplementedCacheServer) Lrem(context.Context, *LremRequest) (*LremResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lrem not implemented")
+}
+func (UnimplementedCacheServer) Ltrim(context.Context, *LtrimRequest) (*LtrimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ltrim not implemented")
+}
+func (UnimplementedCacheServer) Linsert(context.Context, *LinsertRequest) (*LinsertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Linsert not implemented")
+}
+func (UnimplementedCacheServer) Hset(context.Context, *HsetRequest) (*HsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hset not implemented")
+}
+func (UnimplementedCacheServer) Hgetall(context.Context, *HgetallRequest) (*HgetallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hgetall not implemented")
+}
+func (UnimplementedCacheServer) Hget(context.Context, *HgetRequest) (*HgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hget not implemented")
+}
+func (UnimplementedCacheServer) Hdel(context.Context, *HdelRequest) (*HdelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hdel not implemented")
+}
+func (UnimplementedCacheServer) Hexists(context.Context, *HexistsRequest) (*HexistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hexists not implemented")
+}
+func (UnimplementedCacheServer) Hlen(context.Context, *HlenRequest) (*HlenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hlen not implemented")
+}
+func (UnimplementedCacheServer) Hkeys(context.Context, *HkeysRequest) (*HkeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hkeys not implemented")
+}
+func (UnimplementedCacheServer) Hvals(context.Context, *HvalsRequest) (*HvalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hvals not implemented")
+}
+func (UnimplementedCacheServer) Hmget(context.Context, *HmgetRequest) (*HmgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hmget not implemented")
+}
+func (UnimplementedCacheServer) Hsetnx(context.Context, *HsetnxRequest) (*HsetnxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hsetnx not implemented")
+}
+func (UnimplementedCacheServer) Hincrby(context.Context, *HincrbyRequest) (*HincrbyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hincrby not implemented")
+}
+func (UnimplementedCacheServer) HincrbyFloat(context.Context, *HincrbyFloatRequest) (*HincrbyFloatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HincrbyFloat not implemented")
+}
+func (UnimplementedCacheServer) Sadd(context.Context, *SaddRequest) (*SaddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sadd not implemented")
+}
+func (UnimplementedCacheServer) Srem(context.Context, *SremRequest) (*SremResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Srem not implemented")
+}
+func (UnimplementedCacheServer) Sismember(context.Context, *SismemberRequest) (*SismemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sismember not implemented")
+}
+func (UnimplementedCacheServer) Smembers(context.Context, *SmembersRequest) (*SmembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Smembers not implemented")
+}
+func (UnimplementedCacheServer) Scard(context.Context, *ScardRequest) (*ScardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Scard not implemented")
+}
+func (UnimplementedCacheServer) Spop(context.Context, *SpopRequest) (*SpopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Spop not implemented")
+}
+func (UnimplementedCacheServer) Sinter(context.Context, *SinterRequest) (*SinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sinter not implemented")
+}
+func (UnimplementedCacheServer) Sunion(context.Context, *SunionRequest) (*SunionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sunion not implemented")
+}
+func (UnimplementedCacheServer) Sdiff(context.Context, *SdiffRequest) (*SdiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sdiff not implemented")
+}
+func (UnimplementedCacheServer) SinterStore(context.Context, *SinterStoreRequest) (*SinterStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SinterStore not implemented")
+}
+func (UnimplementedCacheServer) SunionStore(context.Context, *SunionStoreRequest) (*SunionStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SunionStore not implemented")
+}
+func (UnimplementedCacheServer) SdiffStore(context.Context, *SdiffStoreRequest) (*SdiffStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SdiffStore not implemented")
+}
+func (UnimplementedCacheServer) Zadd(context.Context, *ZaddRequest) (*ZaddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Zadd not implemented")
+}
+func (UnimplementedCacheServer) Zrem(context.Context, *ZremRequest) (*ZremResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Zrem not implemented")
+}
+func (UnimplementedCacheServer) Zscore(context.Context, *ZscoreRequest) (*ZscoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Zscore not implemented")
+}
+func (UnimplementedCacheServer) Zrank(context.Context, *ZrankRequest) (*ZrankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Zrank not implemented")
+}
+func (UnimplementedCacheServer) Zrevrank(context.Context, *ZrevrankRequest) (*ZrevrankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Zrevrank not implemented")
+}
+func (UnimplementedCacheServer) Zrange(context.Context, *ZrangeRequest) (*ZrangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Zrange not implemented")
+}
+func (UnimplementedCacheServer) ZrangeByScore(context.Context, *ZrangeByScoreRequest) (*ZrangeByScoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZrangeByScore not implemented")
+}
+func (UnimplementedCacheServer) Zcount(context.Context, *ZcountRequest) (*ZcountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Zcount not implemented")
+}
+func (UnimplementedCacheServer) ZpopMin(context.Context, *ZpopMinRequest) (*ZpopMinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZpopMin not implemented")
+}
+func (UnimplementedCacheServer) ZpopMax(context.Context, *ZpopMaxRequest) (*ZpopMaxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZpopMax not implemented")
+}
+func (UnimplementedCacheServer) Stats(context.Context, *StatsRequest) (*StatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (UnimplementedCacheServer) DbSize(context.Context, *DbSizeRequest) (*DbSizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DbSize not implemented")
+}
+func (UnimplementedCacheServer) FlushDb(context.Context, *FlushDbRequest) (*FlushDbResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushDb not implemented")
+}
+func (UnimplementedCacheServer) Move(context.Context, *MoveRequest) (*MoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Move not implemented")
+}
+func (UnimplementedCacheServer) Batch(grpc.BidiStreamingServer[Command, Result]) error {
+	return status.Errorf(codes.Unimplemented, "method Batch not implemented")
+}
+func (UnimplementedCacheServer) Tx(context.Context, *TxRequest) (*TxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tx not implemented")
+}
+func (UnimplementedCacheServer) Publish(context.Context, *PublishRequest) (*PublishResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
+}
+func (UnimplementedCacheServer) Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[Message]) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedCacheServer) Notifications(*NotificationsRequest, grpc.ServerStreamingServer[KeyspaceEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method Notifications not implemented")
+}
+func (UnimplementedCacheServer) mustEmbedUnimplementedCacheServer() {}
+func (UnimplementedCacheServer) testEmbeddedByValue()               {}
+
+// UnsafeCacheServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CacheServer will
+// result in compilation errors.
+type UnsafeCacheServer interface {
+	mustEmbedUnimplementedCacheServer()
+}
+
+func RegisterCacheServer(s grpc.ServiceRegistrar, srv CacheServer) {
+	// If the following call pancis, it indicates UnimplementedCacheServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Cache_ServiceDesc, srv)
+}
+
+func _Cache_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Gets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Gets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Gets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Gets(ctx, req.(*GetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Version(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Version_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Version(ctx, req.(*VersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Versions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Versions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Versions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Versions(ctx, req.(*VersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Set_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Set(ctx, req.(*SetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_GetSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).GetSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_GetSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).GetSet(ctx, req.(*GetSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Cas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Cas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Cas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Cas(ctx, req.(*CasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Incr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Incr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Incr_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Incr(ctx, req.(*IncrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Decr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Decr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Decr_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Decr(ctx, req.(*DecrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_IncrBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrByRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).IncrBy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_IncrBy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).IncrBy(ctx, req.(*IncrByRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_IncrByFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrByFloatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).IncrByFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_IncrByFloat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).IncrByFloat(ctx, req.(*IncrByFloatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Unset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Unset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Unset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Unset(ctx, req.(*UnsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Expire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpireRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Expire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Expire_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Expire(ctx, req.(*ExpireRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_ExpireAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpireAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).ExpireAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_ExpireAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).ExpireAt(ctx, req.(*ExpireAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Persist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PersistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Persist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Persist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Persist(ctx, req.(*PersistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Ttl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TtlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Ttl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Ttl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Ttl(ctx, req.(*TtlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Pttl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PttlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Pttl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Pttl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Pttl(ctx, req.(*PttlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Keys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Keys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Keys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Keys(ctx, req.(*KeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Scan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Scan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Scan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Scan(ctx, req.(*ScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Rpush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RpushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Rpush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Rpush_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Rpush(ctx, req.(*RpushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Lpush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LpushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Lpush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Lpush_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Lpush(ctx, req.(*LpushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Pop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Pop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Pop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Pop(ctx, req.(*PopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Lpop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LpopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Lpop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Lpop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Lpop(ctx, req.(*LpopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Blpop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlpopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Blpop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Blpop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Blpop(ctx, req.(*BlpopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Brpop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BrpopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Brpop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Brpop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Brpop(ctx, req.(*BrpopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Lgetall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LgetallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Lgetall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Lgetall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Lgetall(ctx, req.(*LgetallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Lget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Lget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Lget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Lget(ctx, req.(*LgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Llen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LlenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Llen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Llen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Llen(ctx, req.(*LlenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Lrange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LrangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Lrange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Lrange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Lrange(ctx, req.(*LrangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Lset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Lset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Lset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Lset(ctx, req.(*LsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Lrem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LremRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Lrem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Lrem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Lrem(ctx, req.(*LremRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Ltrim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LtrimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Ltrim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Ltrim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Ltrim(ctx, req.(*LtrimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Linsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinsertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Linsert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Linsert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Linsert(ctx, req.(*LinsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Hset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Hset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Hset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Hset(ctx, req.(*HsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Hgetall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HgetallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Hgetall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Hgetall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Hgetall(ctx, req.(*HgetallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Hget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Hget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Hget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Hget(ctx, req.(*HgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Hdel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HdelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Hdel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Hdel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Hdel(ctx, req.(*HdelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Hexists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HexistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Hexists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Hexists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Hexists(ctx, req.(*HexistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Hlen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HlenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Hlen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Hlen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Hlen(ctx, req.(*HlenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Hkeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HkeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Hkeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Hkeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Hkeys(ctx, req.(*HkeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Hvals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HvalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Hvals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Hvals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Hvals(ctx, req.(*HvalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Hmget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HmgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Hmget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Hmget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Hmget(ctx, req.(*HmgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Hsetnx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HsetnxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Hsetnx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Hsetnx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Hsetnx(ctx, req.(*HsetnxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Hincrby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HincrbyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Hincrby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Hincrby_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Hincrby(ctx, req.(*HincrbyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_HincrbyFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HincrbyFloatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).HincrbyFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_HincrbyFloat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).HincrbyFloat(ctx, req.(*HincrbyFloatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Sadd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Sadd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Sadd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Sadd(ctx, req.(*SaddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Srem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SremRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Srem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Srem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Srem(ctx, req.(*SremRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Sismember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SismemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Sismember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Sismember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Sismember(ctx, req.(*SismemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Smembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SmembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Smembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Smembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Smembers(ctx, req.(*SmembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Scard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Scard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Scard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Scard(ctx, req.(*ScardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Spop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Spop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Spop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Spop(ctx, req.(*SpopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Sinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SinterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Sinter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Sinter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Sinter(ctx, req.(*SinterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Sunion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SunionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Sunion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Sunion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Sunion(ctx, req.(*SunionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Sdiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SdiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Sdiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Sdiff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Sdiff(ctx, req.(*SdiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_SinterStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SinterStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).SinterStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_SinterStore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).SinterStore(ctx, req.(*SinterStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_SunionStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SunionStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).SunionStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_SunionStore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).SunionStore(ctx, req.(*SunionStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_SdiffStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SdiffStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).SdiffStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_SdiffStore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).SdiffStore(ctx, req.(*SdiffStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Zadd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZaddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Zadd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Zadd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Zadd(ctx, req.(*ZaddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Zrem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZremRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Zrem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Zrem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Zrem(ctx, req.(*ZremRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Zscore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZscoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Zscore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Zscore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Zscore(ctx, req.(*ZscoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Zrank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZrankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Zrank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Zrank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Zrank(ctx, req.(*ZrankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Zrevrank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZrevrankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Zrevrank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Zrevrank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Zrevrank(ctx, req.(*ZrevrankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Zrange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZrangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Zrange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Zrange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Zrange(ctx, req.(*ZrangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_ZrangeByScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZrangeByScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).ZrangeByScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_ZrangeByScore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).ZrangeByScore(ctx, req.(*ZrangeByScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Zcount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZcountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Zcount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Zcount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Zcount(ctx, req.(*ZcountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_ZpopMin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZpopMinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).ZpopMin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_ZpopMin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).ZpopMin(ctx, req.(*ZpopMinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_ZpopMax_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZpopMaxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).ZpopMax(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_ZpopMax_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).ZpopMax(ctx, req.(*ZpopMaxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Stats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Stats(ctx, req.(*StatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_DbSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DbSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).DbSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_DbSize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).DbSize(ctx, req.(*DbSizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_FlushDb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushDbRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).FlushDb(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_FlushDb_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).FlushDb(ctx, req.(*FlushDbRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Move_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Move(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Move_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Move(ctx, req.(*MoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Batch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CacheServer).Batch(&grpc.GenericServerStream[Command, Result]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cache_BatchServer = grpc.BidiStreamingServer[Command, Result]
+
+func _Cache_Tx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Tx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Tx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Tx(ctx, req.(*TxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Publish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Publish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Publish(ctx, req.(*PublishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CacheServer).Subscribe(m, &grpc.GenericServerStream[SubscribeRequest, Message]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cache_SubscribeServer = grpc.ServerStreamingServer[Message]
+
+func _Cache_Notifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(NotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CacheServer).Notifications(m, &grpc.GenericServerStream[NotificationsRequest, KeyspaceEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Cache_NotificationsServer = grpc.ServerStreamingServer[KeyspaceEvent]
+
+// Cache_ServiceDesc is the grpc.ServiceDesc for Cache service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Cache_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "simplecache.Cache",
+	HandlerType: (*CacheServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _Cache_Get_Handler,
+		},
+		{
+			MethodName: "Gets",
+			Handler:    _Cache_Gets_Handler,
+		},
+		{
+			MethodName: "Version",
+			Handler:    _Cache_Version_Handler,
+		},
+		{
+			MethodName: "Versions",
+			Handler:    _Cache_Versions_Handler,
+		},
+		{
+			MethodName: "Set",
+			Handler:    _Cache_Set_Handler,
+		},
+		{
+			MethodName: "GetSet",
+			Handler:    _Cache_GetSet_Handler,
+		},
+		{
+			MethodName: "Cas",
+			Handler:    _Cache_Cas_Handler,
+		},
+		{
+			MethodName: "Incr",
+			Handler:    _Cache_Incr_Handler,
+		},
+		{
+			MethodName: "Decr",
+			Handler:    _Cache_Decr_Handler,
+		},
+		{
+			MethodName: "IncrBy",
+			Handler:    _Cache_IncrBy_Handler,
+		},
+		{
+			MethodName: "IncrByFloat",
+			Handler:    _Cache_IncrByFloat_Handler,
+		},
+		{
+			MethodName: "Unset",
+			Handler:    _Cache_Unset_Handler,
+		},
+		{
+			MethodName: "Expire",
+			Handler:    _Cache_Expire_Handler,
+		},
+		{
+			MethodName: "ExpireAt",
+			Handler:    _Cache_ExpireAt_Handler,
+		},
+		{
+			MethodName: "Persist",
+			Handler:    _Cache_Persist_Handler,
+		},
+		{
+			MethodName: "Ttl",
+			Handler:    _Cache_Ttl_Handler,
+		},
+		{
+			MethodName: "Pttl",
+			Handler:    _Cache_Pttl_Handler,
+		},
+		{
+			MethodName: "Keys",
+			Handler:    _Cache_Keys_Handler,
+		},
+		{
+			MethodName: "Scan",
+			Handler:    _Cache_Scan_Handler,
+		},
+		{
+			MethodName: "Rpush",
+			Handler:    _Cache_Rpush_Handler,
+		},
+		{
+			MethodName: "Lpush",
+			Handler:    _Cache_Lpush_Handler,
+		},
+		{
+			MethodName: "Pop",
+			Handler:    _Cache_Pop_Handler,
+		},
+		{
+			MethodName: "Lpop",
+			Handler:    _Cache_Lpop_Handler,
+		},
+		{
+			MethodName: "Blpop",
+			Handler:    _Cache_Blpop_Handler,
+		},
+		{
+			MethodName: "Brpop",
+			Handler:    _Cache_Brpop_Handler,
+		},
+		{
+			MethodName: "Lgetall",
+			Handler:    _Cache_Lgetall_Handler,
+		},
+		{
+			MethodName: "Lget",
+			Handler:    _Cache_Lget_Handler,
+		},
+		{
+			MethodName: "Llen",
+			Handler:    _Cache_Llen_Handler,
+		},
+		{
+			MethodName: "Lrange",
+			Handler:    _Cache_Lrange_Handler,
+		},
+		{
+			MethodName: "Lset",
+			Handler:    _Cache_Lset_Handler,
+		},
+		{
+			MethodName: "Lrem",
+			Handler:    _Cache_Lrem_Handler,
+		},
+		{
+			MethodName: "Ltrim",
+			Handler:    _Cache_Ltrim_Handler,
+		},
+		{
+			MethodName: "Linsert",
+			Handler:    _Cache_Linsert_Handler,
+		},
+		{
+			MethodName: "Hset",
+			Handler:    _Cache_Hset_Handler,
+		},
+		{
+			MethodName: "Hgetall",
+			Handler:    _Cache_Hgetall_Handler,
+		},
+		{
+			MethodName: "Hget",
+			Handler:    _Cache_Hget_Handler,
+		},
+		{
+			MethodName: "Hdel",
+			Handler:    _Cache_Hdel_Handler,
+		},
+		{
+			MethodName: "Hexists",
+			Handler:    _Cache_Hexists_Handler,
+		},
+		{
+			MethodName: "Hlen",
+			Handler:    _Cache_Hlen_Handler,
+		},
+		{
+			MethodName: "Hkeys",
+			Handler:    _Cache_Hkeys_Handler,
+		},
+		{
+			MethodName: "Hvals",
+			Handler:    _Cache_Hvals_Handler,
+		},
+		{
+			MethodName: "Hmget",
+			Handler:    _Cache_Hmget_Handler,
+		},
+		{
+			MethodName: "Hsetnx",
+			Handler:    _Cache_Hsetnx_Handler,
+		},
+		{
+			MethodName: "Hincrby",
+			Handler:    _Cache_Hincrby_Handler,
+		},
+		{
+			MethodName: "HincrbyFloat",
+			Handler:    _Cache_HincrbyFloat_Handler,
+		},
+		{
+			MethodName: "Sadd",
+			Handler:    _Cache_Sadd_Handler,
+		},
+		{
+			MethodName: "Srem",
+			Handler:    _Cache_Srem_Handler,
+		},
+		{
+			MethodName: "Sismember",
+			Handler:    _Cache_Sismember_Handler,
+		},
+		{
+			MethodName: "Smembers",
+			Handler:    _Cache_Smembers_Handler,
+		},
+		{
+			MethodName: "Scard",
+			Handler:    _Cache_Scard_Handler,
+		},
+		{
+			MethodName: "Spop",
+			Handler:    _Cache_Spop_Handler,
+		},
+		{
+			MethodName: "Sinter",
+			Handler:    _Cache_Sinter_Handler,
+		},
+		{
+			MethodName: "Sunion",
+			Handler:    _Cache_Sunion_Handler,
+		},
+		{
+			MethodName: "Sdiff",
+			Handler:    _Cache_Sdiff_Handler,
+		},
+		{
+			MethodName: "SinterStore",
+			Handler:    _Cache_SinterStore_Handler,
+		},
+		{
+			MethodName: "SunionStore",
+			Handler:    _Cache_SunionStore_Handler,
+		},
+		{
+			MethodName: "SdiffStore",
+			Handler:    _Cache_SdiffStore_Handler,
+		},
+		{
+			MethodName: "Zadd",
+			Handler:    _Cache_Zadd_Handler,
+		},
+		{
+			MethodName: "Zrem",
+			Handler:    _Cache_Zrem_Handler,
+		},
+		{
+			MethodName: "Zscore",
+			Handler:    _Cache_Zscore_Handler,
+		},
+		{
+			MethodName: "Zrank",
+			Handler:    _Cache_Zrank_Handler,
+		},
+		{
+			MethodName: "Zrevrank",
+			Handler:    _Cache_Zrevrank_Handler,
+		},
+		{
+			MethodName: "Zrange",
+			Handler:    _Cache_Zrange_Handler,
+		},
+		{
+			MethodName: "ZrangeByScore",
+			Handler:    _Cache_ZrangeByScore_Handler,
+		},
+		{
+			MethodName: "Zcount",
+			Handler:    _Cache_Zcount_Handler,
+		},
+		{
+			MethodName: "ZpopMin",
+			Handler:    _Cache_ZpopMin_Handler,
+		},
+		{
+			MethodName: "ZpopMax",
+			Handler:    _Cache_ZpopMax_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _Cache_Stats_Handler,
+		},
+		{
+			MethodName: "DbSize",
+			Handler:    _Cache_DbSize_Handler,
+		},
+		{
+			MethodName: "FlushDb",
+			Handler:    _Cache_FlushDb_Handler,
+		},
+		{
+			MethodName: "Move",
+			Handler:    _Cache_Move_Handler,
+		},
+		{
+			MethodName: "Tx",
+			Handler:    _Cache_Tx_Handler,
+		},
+		{
+			MethodName: "Publish",
+			Handler:    _Cache_Publish_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Batch",
+			Handler:       _Cache_Batch_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Cache_Subscribe_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Notifications",
+			Handler:       _Cache_Notifications_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cache.proto",
+}
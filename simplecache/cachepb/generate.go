@@ -0,0 +1,4 @@
+// Package cachepb is the gRPC API of simplecache generated from cache.proto.
+package cachepb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative cache.proto
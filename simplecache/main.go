@@ -1,11 +1,71 @@
 package main
 
 import (
+	"flag"
 	"github.com/iqOptionTest/simplecache/app"
+	"log"
+	"strings"
+	"time"
+)
+
+var (
+	shards              = flag.Int("shards", 32, "Number of independently locked parts of the keyspace")
+	snapshotPath        = flag.String("snapshot", "dump.json", "File the keyspace is saved to, empty to disable snapshots")
+	snapshotInterval    = flag.Duration("snapshot-interval", time.Minute, "How often the snapshot is written")
+	aofPath             = flag.String("aof", "", "Append-only log of all writes, empty to disable it")
+	aofFsync            = flag.String("aof-fsync", app.FsyncEverySec, "When the append-only log is synced to disk: always, everysec or no")
+	aofRewriteMinSize   = flag.Int64("aof-rewrite-min-size", 64*1024*1024, "Size in bytes the append-only log must reach before it is compacted")
+	maxMemory           = flag.Int64("maxmemory", 0, "Estimated memory in bytes the values may use, 0 for no limit")
+	maxKeys             = flag.Int64("maxkeys", 0, "Number of keys the cache may hold, 0 for no limit")
+	evictionPolicy      = flag.String("eviction-policy", app.NoEviction, "What to do when a limit is reached: noeviction, allkeys-lru, allkeys-lfu, allkeys-random, volatile-lru or volatile-ttl")
+	evictionSamples     = flag.Int("eviction-samples", 5, "Number of keys sampled per shard to pick a key to evict")
+	subscriberBuffer    = flag.Int("subscriber-buffer", 256, "Number of messages a pub/sub subscriber may fall behind before it is disconnected")
+	respAddr            = flag.String("resp-addr", "", "Address of the Redis protocol listener, e.g. :6379, empty to disable it")
+	grpcAddr            = flag.String("grpc-addr", "", "Address of the gRPC server, e.g. :9013, empty to disable it")
+	databases           = flag.Int("databases", 16, "Number of logical databases, selected by the /db/{db} path prefix or the X-Cache-DB header")
+	follow              = flag.String("follow", "", "URL of the leader to replicate as a read-only follower, e.g. http://leader:9003")
+	replBacklogSize     = flag.Int("repl-backlog-size", 1024*1024, "Size in bytes of the latest writes kept for followers that reconnect")
+	clusterAddr         = flag.String("cluster-addr", "", "URL other nodes and clients reach the node at, e.g. http://10.0.0.1:9003, enables cluster mode")
+	raftAddr            = flag.String("raft-addr", "", "URL the other members of the raft group reach the node at, e.g. http://10.0.0.1:9003, enables raft mode")
+	raftPeers           = flag.String("raft-peers", "", "Comma-separated URLs of the members of a new raft group including the node, empty to join a running group")
+	raftDir             = flag.String("raft-dir", "raft", "Directory of the raft log and snapshots")
+	raftSnapshotEntries = flag.Int("raft-snapshot-entries", 10000, "Number of applied raft log entries after which the log is compacted into a snapshot")
 )
 
 func main() {
-	a := app.NewApp()
+	flag.Parse()
+
+	var peers []string
+	if *raftPeers != "" {
+		peers = strings.Split(*raftPeers, ",")
+	}
+
+	a, err := app.NewApp(app.Config{
+		Shards:              *shards,
+		SnapshotPath:        *snapshotPath,
+		SnapshotInterval:    *snapshotInterval,
+		AOFPath:             *aofPath,
+		AOFFsync:            *aofFsync,
+		AOFRewriteMinSize:   *aofRewriteMinSize,
+		MaxMemory:           *maxMemory,
+		MaxKeys:             *maxKeys,
+		EvictionPolicy:      *evictionPolicy,
+		EvictionSamples:     *evictionSamples,
+		SubscriberBuffer:    *subscriberBuffer,
+		RESPAddr:            *respAddr,
+		GRPCAddr:            *grpcAddr,
+		Databases:           *databases,
+		Follow:              *follow,
+		ReplBacklogSize:     *replBacklogSize,
+		ClusterAddr:         *clusterAddr,
+		RaftAddr:            *raftAddr,
+		RaftPeers:           peers,
+		RaftDir:             *raftDir,
+		RaftSnapshotEntries: *raftSnapshotEntries,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
 	a.Initialize()
 	a.Run(":9003")
 }
@@ -0,0 +1,148 @@
+package cacheclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"golang.org/x/net/context"
+	"golang.org/x/net/context/ctxhttp"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// ErrRawOverGRPC is returned by the raw value methods of a gRPC client.
+var ErrRawOverGRPC = errors.New("raw values are served over HTTP only")
+
+// SetBytes stores what r reads at key as a raw value with contentType,
+// application/octet-stream when it's empty. The data is streamed to the
+// server, not buffered. A positive expired sets a TTL like Set does.
+func (c *Client) SetBytes(ctx context.Context, key string, r io.Reader, contentType string, expired int) error {
+	_, err := c.writeRaw(ctx, "PUT", "setbytes/"+key, key, r, contentType, expired)
+
+	return err
+}
+
+// AppendBytes adds what r reads to the end of the string value at key and
+// returns its new length. A missing key is created with contentType.
+func (c *Client) AppendBytes(ctx context.Context, key string, r io.Reader, contentType string) (int, error) {
+	return c.writeRaw(ctx, "POST", "append/"+key, key, r, contentType, 0)
+}
+
+// GetBytes returns a reader of the raw value at key and its content type.
+// The data is streamed from the server, and the caller must close the
+// reader.
+func (c *Client) GetBytes(ctx context.Context, key string) (io.ReadCloser, string, error) {
+	return c.readRaw(ctx, key, "")
+}
+
+// GetBytesRange is GetBytes for the bytes from start to end inclusive. A
+// negative end reads to the end of the value.
+func (c *Client) GetBytesRange(ctx context.Context, key string, start int64, end int64) (io.ReadCloser, string, error) {
+	rng := fmt.Sprintf("bytes=%d-", start)
+	if end >= 0 {
+		rng += strconv.FormatInt(end, 10)
+	}
+
+	return c.readRaw(ctx, key, rng)
+}
+
+// rawRequest makes a request on a raw value. A cluster client sends it
+// straight to the node serving key.
+func (c *Client) rawRequest(ctx context.Context, method string, path string, key string, body io.Reader) (*http.Request, error) {
+	if c.grpc != nil {
+		return nil, ErrRawOverGRPC
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-c.rateLimiter:
+		// Execute request.
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+	c.setDB(req)
+
+	if c.cluster != nil {
+		if !c.cluster.loaded() {
+			if err := c.cluster.load(ctx, c.httpClient, c.baseURL); err != nil {
+				return nil, err
+			}
+		}
+		if node := c.cluster.node(keySlot(key)); node != "" {
+			setNode(req, node)
+		}
+	}
+
+	return req, nil
+}
+
+// writeRaw streams r as the body of a request. The body can't be sent
+// again, so a redirect of a cluster node is returned as an error.
+func (c *Client) writeRaw(ctx context.Context, method string, path string, key string, r io.Reader, contentType string, expired int) (int, error) {
+	if expired > 0 {
+		path += "?expired=" + strconv.Itoa(expired)
+	}
+	req, err := c.rawRequest(ctx, method, path, key, r)
+	if err != nil {
+		return 0, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := ctxhttp.Do(ctx, c.httpClient, req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return 0, responseError(resp)
+	}
+
+	var response struct {
+		Length int `json:"length"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return 0, err
+	}
+
+	return response.Length, nil
+}
+
+func (c *Client) readRaw(ctx context.Context, key string, rng string) (io.ReadCloser, string, error) {
+	path := "getbytes/" + key
+	req, err := c.rawRequest(ctx, "GET", path, key, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	if rng != "" {
+		req.Header.Set("Range", rng)
+	}
+
+	resp, err := c.send(ctx, req, path, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusPartialContent {
+		defer resp.Body.Close()
+		return nil, "", responseError(resp)
+	}
+
+	return resp.Body, resp.Header.Get("Content-Type"), nil
+}
+
+// responseError returns the error of a failed response.
+func responseError(resp *http.Response) error {
+	respErr := map[string]string{}
+	if err := json.NewDecoder(resp.Body).Decode(&respErr); err != nil || respErr["error"] == "" {
+		return errors.New(resp.Status)
+	}
+
+	return errors.New(respErr["error"])
+}
@@ -0,0 +1,149 @@
+package cacheclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"golang.org/x/net/context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rawValue is a value stored by bytesServer.
+type rawValue struct {
+	data        []byte
+	contentType string
+	expired     string
+	// chunked is whether the body was streamed without a length.
+	chunked bool
+}
+
+func bytesServer(t *testing.T) (*httptest.Server, map[string]*rawValue) {
+	values := map[string]*rawValue{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		segments := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+		key := segments[1]
+		switch {
+		case segments[0] == "setbytes" && r.Method == "PUT", segments[0] == "append" && r.Method == "POST":
+			data, _ := io.ReadAll(r.Body)
+			v := values[key]
+			if v == nil || segments[0] == "setbytes" {
+				v = &rawValue{contentType: r.Header.Get("Content-Type"), expired: r.URL.Query().Get("expired")}
+				values[key] = v
+			}
+			v.data = append(v.data, data...)
+			v.chunked = r.ContentLength == -1
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(map[string]int{"length": len(v.data)})
+		case segments[0] == "getbytes" && r.Method == "GET":
+			v := values[key]
+			if v == nil {
+				w.WriteHeader(http.StatusNotFound)
+				json.NewEncoder(w).Encode(map[string]string{"error": "not found"})
+				return
+			}
+			w.Header().Set("Content-Type", v.contentType)
+			http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(v.data))
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server, values
+}
+
+func readAll(t *testing.T, r io.ReadCloser) string {
+	t.Helper()
+	defer r.Close()
+	b, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(b)
+}
+
+func TestSetBytes(t *testing.T) {
+	server, values := bytesServer(t)
+	c := NewClient(server.URL + "/")
+	ctx := context.Background()
+
+	// The body is streamed from a reader of unknown length.
+	pr, pw := io.Pipe()
+	go func() {
+		pw.Write([]byte("hello, "))
+		pw.Write([]byte("world"))
+		pw.Close()
+	}()
+	if err := c.SetBytes(ctx, "k", pr, "text/plain", 60); err != nil {
+		t.Fatal(err)
+	}
+	v := values["k"]
+	if string(v.data) != "hello, world" || v.contentType != "text/plain" || v.expired != "60" || !v.chunked {
+		t.Error("Value isn't streamed with its content type and TTL", v)
+	}
+
+	if n, err := c.AppendBytes(ctx, "k", strings.NewReader("!"), ""); err != nil || n != 13 {
+		t.Error("AppendBytes doesn't return the length", n, err)
+	}
+
+	if err := c.SetBytes(ctx, "k", strings.NewReader("x"), "", 0); err != nil {
+		t.Fatal(err)
+	}
+	if v := values["k"]; v.expired != "" {
+		t.Error("TTL is sent without expired", v)
+	}
+}
+
+func TestGetBytes(t *testing.T) {
+	server, values := bytesServer(t)
+	values["k"] = &rawValue{data: []byte("0123456789"), contentType: "image/png"}
+	c := NewClient(server.URL + "/")
+	ctx := context.Background()
+
+	r, contentType, err := c.GetBytes(ctx, "k")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data := readAll(t, r); data != "0123456789" || contentType != "image/png" {
+		t.Error("Value is returned wrong", data, contentType)
+	}
+
+	for _, tc := range []struct {
+		start, end int64
+		want       string
+	}{
+		{2, 4, "234"},
+		{7, -1, "789"},
+	} {
+		r, _, err := c.GetBytesRange(ctx, "k", tc.start, tc.end)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if data := readAll(t, r); data != tc.want {
+			t.Error("Range is read wrong", tc.start, tc.end, data)
+		}
+	}
+
+	if _, _, err := c.GetBytes(ctx, "missing"); err == nil || err.Error() != "not found" {
+		t.Error("Error isn't returned", err)
+	}
+	if _, _, err := c.GetBytesRange(ctx, "k", 20, -1); err == nil {
+		t.Error("Unsatisfiable range doesn't fail")
+	}
+}
+
+func TestBytes_GRPC(t *testing.T) {
+	_, c := newFakeGRPCClient(t)
+
+	if err := c.SetBytes(context.Background(), "k", strings.NewReader("x"), "", 0); err != ErrRawOverGRPC {
+		t.Error("Raw value is sent over gRPC", err)
+	}
+	if _, _, err := c.GetBytes(context.Background(), "k"); err != ErrRawOverGRPC {
+		t.Error("Raw value is read over gRPC", err)
+	}
+}
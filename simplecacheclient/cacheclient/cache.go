@@ -2,7 +2,10 @@ package cacheclient
 
 import (
 	"encoding/json"
+	"fmt"
+	"github.com/iqOptionTest/simplecache/cachepb"
 	"golang.org/x/net/context"
+	"net/url"
 	"strconv"
 )
 
@@ -13,35 +16,65 @@ type SetBody struct {
 }
 
 func (c *Client) Set(ctx context.Context, body *SetBody) (map[string]string, error) {
+	if c.grpc != nil {
+		value, err := protoValue(body.Value)
+		if err != nil {
+			return nil, err
+		}
+		r, err := c.grpc.cache.Set(c.outgoing(ctx), &cachepb.SetRequest{Key: body.Key, Value: value, Expired: int64(body.Expired)})
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return map[string]string{"result": "success", "set": strconv.FormatBool(r.Set), "version": strconv.FormatInt(r.Version, 10)}, nil
+	}
+
 	b, _ := json.Marshal(body)
 	config := &apiConfig{
 		path: "set",
 	}
-	response := map[string]string{}
+	var response map[string]interface{}
 	err := c.postJSON(ctx, config, b, &response)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return response, nil
+	return stringify(response), nil
 }
 
+// Get returns the value as it was written: a string, a json.Number, or a
+// json.RawMessage for objects and arrays.
 func (c *Client) Get(ctx context.Context, key string) (interface{}, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Get(c.outgoing(ctx), &cachepb.GetRequest{Key: key})
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return goValue(r.Value), nil
+	}
+
 	config := &apiConfig{
 		path: "get/" + key,
 	}
-	var response interface{}
+	var response json.RawMessage
 	err := c.getJSON(ctx, config, &response)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return response, err
+	return jsonValue(response), err
 }
 
 func (c *Client) Keys(ctx context.Context) ([]string, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Keys(c.outgoing(ctx), &cachepb.KeysRequest{})
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return r.Keys, nil
+	}
+
 	config := &apiConfig{
 		path: "keys",
 	}
@@ -56,6 +89,13 @@ func (c *Client) Keys(ctx context.Context) ([]string, error) {
 }
 
 func (c *Client) Unset(ctx context.Context, key string) (map[string]string, error) {
+	if c.grpc != nil {
+		if _, err := c.grpc.cache.Unset(c.outgoing(ctx), &cachepb.UnsetRequest{Key: key}); err != nil {
+			return nil, grpcError(err)
+		}
+		return map[string]string{"result": "success"}, nil
+	}
+
 	config := &apiConfig{
 		path: "unset/" + key,
 	}
@@ -70,21 +110,52 @@ func (c *Client) Unset(ctx context.Context, key string) (map[string]string, erro
 }
 
 func (c *Client) Rpush(ctx context.Context, body *SetBody) (map[string]string, error) {
+	if c.grpc != nil {
+		value, err := protoValue(body.Value)
+		if err != nil {
+			return nil, err
+		}
+		r, err := c.grpc.cache.Rpush(c.outgoing(ctx), &cachepb.RpushRequest{Key: body.Key, Values: []*cachepb.Value{value}, Expired: int64(body.Expired)})
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return map[string]string{"result": "success", "length": strconv.FormatInt(r.Length, 10)}, nil
+	}
+
 	b, _ := json.Marshal(body)
 	config := &apiConfig{
 		path: "rpush",
 	}
-	var response map[string]string
+	var response map[string]interface{}
 	err := c.postJSON(ctx, config, b, &response)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return response, nil
+	return stringify(response), nil
+}
+
+// stringify converts a response that also has numbers or booleans, like
+// the length of a list or the version of a key.
+func stringify(response map[string]interface{}) map[string]string {
+	result := map[string]string{}
+	for k, v := range response {
+		result[k] = fmt.Sprint(v)
+	}
+
+	return result
 }
 
 func (c *Client) Pop(ctx context.Context, key string) (interface{}, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Pop(c.outgoing(ctx), &cachepb.PopRequest{Key: key})
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return decodedValue(r.Value), nil
+	}
+
 	config := &apiConfig{
 		path: "pop/" + key,
 	}
@@ -99,6 +170,14 @@ func (c *Client) Pop(ctx context.Context, key string) (interface{}, error) {
 }
 
 func (c *Client) Lget(ctx context.Context, key string, i int) (interface{}, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Lget(c.outgoing(ctx), &cachepb.LgetRequest{Key: key, Index: int64(i)})
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return decodedValue(r.Value), nil
+	}
+
 	config := &apiConfig{
 		path: "lget/" + key + "/" + strconv.Itoa(i),
 	}
@@ -113,6 +192,14 @@ func (c *Client) Lget(ctx context.Context, key string, i int) (interface{}, erro
 }
 
 func (c *Client) Lgetall(ctx context.Context, key string) ([]interface{}, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Lgetall(c.outgoing(ctx), &cachepb.LgetallRequest{Key: key})
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return decodedValues(r.Values), nil
+	}
+
 	config := &apiConfig{
 		path: "lgetall/" + key,
 	}
@@ -127,6 +214,17 @@ func (c *Client) Lgetall(ctx context.Context, key string) ([]interface{}, error)
 }
 
 func (c *Client) Hset(ctx context.Context, body *SetBody) (map[string]string, error) {
+	if c.grpc != nil {
+		fields, err := protoFields(body.Value)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := c.grpc.cache.Hset(c.outgoing(ctx), &cachepb.HsetRequest{Key: body.Key, Fields: fields, Expired: int64(body.Expired)}); err != nil {
+			return nil, grpcError(err)
+		}
+		return map[string]string{"result": "success"}, nil
+	}
+
 	b, _ := json.Marshal(body)
 	config := &apiConfig{
 		path: "hset",
@@ -141,25 +239,155 @@ func (c *Client) Hset(ctx context.Context, body *SetBody) (map[string]string, er
 	return response, nil
 }
 
+// Hgetall returns the fields with their values as Get does.
 func (c *Client) Hgetall(ctx context.Context, key string) (map[string]interface{}, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Hgetall(c.outgoing(ctx), &cachepb.HgetallRequest{Key: key})
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		fields := make(map[string]interface{}, len(r.Fields))
+		for k, v := range r.Fields {
+			fields[k] = goValue(v)
+		}
+		return fields, nil
+	}
+
 	config := &apiConfig{
 		path: "hgetall/" + key,
 	}
-	var response map[string]interface{}
+	var response map[string]json.RawMessage
 	err := c.getJSON(ctx, config, &response)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return response, nil
+	return jsonFields(response), nil
 }
 
 func (c *Client) Hget(ctx context.Context, key string, i string) (interface{}, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Hget(c.outgoing(ctx), &cachepb.HgetRequest{Key: key, Field: i})
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return goValue(r.Value), nil
+	}
+
 	config := &apiConfig{
 		path: "hget/" + key + "/" + i,
 	}
-	var response interface{}
+	var response json.RawMessage
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonValue(response), nil
+}
+
+type hdelBody struct {
+	Key    string   `json:"key"`
+	Fields []string `json:"fields"`
+}
+
+type hsetnxBody struct {
+	Key     string      `json:"key"`
+	Expired int         `json:"expired"`
+	Field   string      `json:"field"`
+	Value   interface{} `json:"value"`
+}
+
+type hincrbyBody struct {
+	Key   string      `json:"key"`
+	Field string      `json:"field"`
+	Value interface{} `json:"value"`
+}
+
+func (c *Client) Hdel(ctx context.Context, key string, fields ...string) (int, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Hdel(c.outgoing(ctx), &cachepb.HdelRequest{Key: key, Fields: fields})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return int(r.Removed), nil
+	}
+
+	b, _ := json.Marshal(&hdelBody{Key: key, Fields: fields})
+	config := &apiConfig{
+		path: "hdel",
+	}
+	var response struct {
+		Removed int `json:"removed"`
+	}
+	err := c.postJSON(ctx, config, b, &response)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Removed, nil
+}
+
+func (c *Client) Hexists(ctx context.Context, key string, field string) (bool, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Hexists(c.outgoing(ctx), &cachepb.HexistsRequest{Key: key, Field: field})
+		if err != nil {
+			return false, grpcError(err)
+		}
+		return r.Exists, nil
+	}
+
+	config := &apiConfig{
+		path: "hexists/" + key + "/" + field,
+	}
+	var response bool
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		return false, err
+	}
+
+	return response, nil
+}
+
+func (c *Client) Hlen(ctx context.Context, key string) (int, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Hlen(c.outgoing(ctx), &cachepb.HlenRequest{Key: key})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return int(r.Length), nil
+	}
+
+	config := &apiConfig{
+		path: "hlen/" + key,
+	}
+	var response int
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response, nil
+}
+
+func (c *Client) Hkeys(ctx context.Context, key string) ([]string, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Hkeys(c.outgoing(ctx), &cachepb.HkeysRequest{Key: key})
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return r.Fields, nil
+	}
+
+	config := &apiConfig{
+		path: "hkeys/" + key,
+	}
+	var response []string
 	err := c.getJSON(ctx, config, &response)
 
 	if err != nil {
@@ -168,3 +396,131 @@ func (c *Client) Hget(ctx context.Context, key string, i string) (interface{}, e
 
 	return response, nil
 }
+
+// Hvals returns the values ordered by their fields.
+func (c *Client) Hvals(ctx context.Context, key string) ([]interface{}, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Hvals(c.outgoing(ctx), &cachepb.HvalsRequest{Key: key})
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return goValues(r.Values), nil
+	}
+
+	config := &apiConfig{
+		path: "hvals/" + key,
+	}
+	var response []json.RawMessage
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonValues(response), nil
+}
+
+// Hmget returns the values of the fields, nil for missing ones.
+func (c *Client) Hmget(ctx context.Context, key string, fields ...string) ([]interface{}, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Hmget(c.outgoing(ctx), &cachepb.HmgetRequest{Key: key, Fields: fields})
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return goValues(r.Values), nil
+	}
+
+	query := url.Values{"field": fields}
+	config := &apiConfig{
+		path: "hmget/" + key + "?" + query.Encode(),
+	}
+	var response []json.RawMessage
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonValues(response), nil
+}
+
+// Hsetnx sets the field only when it doesn't exist and reports whether it
+// was set.
+func (c *Client) Hsetnx(ctx context.Context, key string, field string, value interface{}, expired int) (bool, error) {
+	if c.grpc != nil {
+		pv, err := protoValue(value)
+		if err != nil {
+			return false, err
+		}
+		r, err := c.grpc.cache.Hsetnx(c.outgoing(ctx), &cachepb.HsetnxRequest{Key: key, Field: field, Value: pv, Expired: int64(expired)})
+		if err != nil {
+			return false, grpcError(err)
+		}
+		return r.Set, nil
+	}
+
+	b, _ := json.Marshal(&hsetnxBody{Key: key, Expired: expired, Field: field, Value: value})
+	config := &apiConfig{
+		path: "hsetnx",
+	}
+	var response struct {
+		Set bool `json:"set"`
+	}
+	err := c.postJSON(ctx, config, b, &response)
+
+	if err != nil {
+		return false, err
+	}
+
+	return response.Set, nil
+}
+
+func (c *Client) Hincrby(ctx context.Context, key string, field string, delta int64) (int64, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Hincrby(c.outgoing(ctx), &cachepb.HincrbyRequest{Key: key, Field: field, Value: delta})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return r.Value, nil
+	}
+
+	b, _ := json.Marshal(&hincrbyBody{Key: key, Field: field, Value: delta})
+	config := &apiConfig{
+		path: "hincrby",
+	}
+	var response struct {
+		Value int64 `json:"value"`
+	}
+	err := c.postJSON(ctx, config, b, &response)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Value, nil
+}
+
+func (c *Client) HincrbyFloat(ctx context.Context, key string, field string, delta float64) (float64, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.HincrbyFloat(c.outgoing(ctx), &cachepb.HincrbyFloatRequest{Key: key, Field: field, Value: delta})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return r.Value, nil
+	}
+
+	b, _ := json.Marshal(&hincrbyBody{Key: key, Field: field, Value: delta})
+	config := &apiConfig{
+		path: "hincrbyfloat",
+	}
+	var response struct {
+		Value float64 `json:"value"`
+	}
+	err := c.postJSON(ctx, config, b, &response)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Value, nil
+}
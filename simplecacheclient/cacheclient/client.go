@@ -6,7 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"golang.org/x/net/context"
-	"golang.org/x/net/context/ctxhttp"
 	"net/http"
 	"time"
 )
@@ -16,8 +15,18 @@ type Client struct {
 	baseURL           string
 	requestsPerSecond int
 	rateLimiter       chan int
+	// grpc is set for clients of the gRPC API, see NewGRPCClient.
+	grpc *grpcTransport
+	// db is the database the requests go to, the server's default one
+	// when it's empty.
+	db string
+	// cluster is the slot map of a cluster client, see WithCluster.
+	cluster *clusterRouter
 }
 
+// ClientOption configures a Client made by NewClient.
+type ClientOption func(*Client)
+
 type apiConfig struct {
 	host string
 	path string
@@ -25,12 +34,15 @@ type apiConfig struct {
 
 var defaultRequestsPerSecond = 10
 
-func NewClient(host string) *Client {
+func NewClient(host string, opts ...ClientOption) *Client {
 	c := &Client{
 		requestsPerSecond: defaultRequestsPerSecond,
 		httpClient:        &http.Client{},
 		baseURL:           host,
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
 
 	// Implement a bursty rate limiter.
 	// Allow up to 1 second worth of requests to be made at once.
@@ -67,8 +79,9 @@ func (c *Client) get(ctx context.Context, config *apiConfig) (*http.Response, er
 	if err != nil {
 		return nil, err
 	}
+	c.setDB(req)
 
-	return ctxhttp.Do(ctx, c.httpClient, req)
+	return c.send(ctx, req, config.path, nil)
 }
 
 func (c *Client) post(ctx context.Context, config *apiConfig, body []byte) (*http.Response, error) {
@@ -88,8 +101,9 @@ func (c *Client) post(ctx context.Context, config *apiConfig, body []byte) (*htt
 		fmt.Println("post err ", err)
 		return nil, err
 	}
+	c.setDB(req)
 
-	return ctxhttp.Do(ctx, c.httpClient, req)
+	return c.send(ctx, req, config.path, body)
 }
 
 func (c *Client) delete(ctx context.Context, config *apiConfig) (*http.Response, error) {
@@ -108,8 +122,9 @@ func (c *Client) delete(ctx context.Context, config *apiConfig) (*http.Response,
 	if err != nil {
 		return nil, err
 	}
+	c.setDB(req)
 
-	return ctxhttp.Do(ctx, c.httpClient, req)
+	return c.send(ctx, req, config.path, nil)
 }
 
 func (c *Client) getJSON(ctx context.Context, config *apiConfig, resp interface{}) error {
@@ -127,7 +142,7 @@ func (c *Client) getJSON(ctx context.Context, config *apiConfig, resp interface{
 		return errors.New(respErr["error"])
 	}
 
-	return json.NewDecoder(httpResp.Body).Decode(&resp)
+	return decodeJSON(httpResp.Body, resp)
 }
 
 func (c *Client) postJSON(ctx context.Context, config *apiConfig, body []byte, resp interface{}) error {
@@ -137,7 +152,7 @@ func (c *Client) postJSON(ctx context.Context, config *apiConfig, body []byte, r
 	}
 	defer httpResp.Body.Close()
 
-	if httpResp.StatusCode != http.StatusCreated {
+	if httpResp.StatusCode != http.StatusCreated && httpResp.StatusCode != http.StatusOK {
 		respErr := map[string]string{}
 		if err := json.NewDecoder(httpResp.Body).Decode(&respErr); err != nil {
 			return err
@@ -146,7 +161,7 @@ func (c *Client) postJSON(ctx context.Context, config *apiConfig, body []byte, r
 		return errors.New(respErr["error"])
 	}
 
-	return json.NewDecoder(httpResp.Body).Decode(&resp)
+	return decodeJSON(httpResp.Body, resp)
 }
 
 func (c *Client) deleteJSON(ctx context.Context, config *apiConfig, resp interface{}) error {
@@ -164,7 +179,7 @@ func (c *Client) deleteJSON(ctx context.Context, config *apiConfig, resp interfa
 		return errors.New(respErr["error"])
 	}
 
-	if err := json.NewDecoder(httpResp.Body).Decode(&resp); err != nil {
+	if err := decodeJSON(httpResp.Body, resp); err != nil {
 		return err
 	}
 
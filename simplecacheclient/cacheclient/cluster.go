@@ -0,0 +1,278 @@
+package cacheclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"golang.org/x/net/context"
+	"golang.org/x/net/context/ctxhttp"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// clusterSlots is the number of hash slots of a cluster.
+const clusterSlots = 16384
+
+// askingHeader marks a request sent again after an ASK redirect.
+const askingHeader = "X-Cache-Asking"
+
+// maxClusterRedirects is how many redirects a request follows before it
+// fails.
+const maxClusterRedirects = 5
+
+// clusterRouter is the slot map of a cluster client.
+type clusterRouter struct {
+	mu sync.RWMutex
+	// slots are the URLs of the nodes serving the slots, nil until the
+	// map is loaded.
+	slots []string
+}
+
+// WithCluster makes a client of a cluster: it loads the slot map from the
+// node at host and sends requests on keys straight to the node serving
+// their slot. Redirects of the nodes update the map. Requests without keys
+// go to host. It applies to HTTP clients only.
+func WithCluster() ClientOption {
+	return func(c *Client) {
+		c.cluster = &clusterRouter{}
+		c.httpClient.CheckRedirect = func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+	}
+}
+
+// load fetches the slot map from the node at addr.
+func (r *clusterRouter) load(ctx context.Context, client *http.Client, addr string) error {
+	resp, err := ctxhttp.Get(ctx, client, strings.TrimSuffix(addr, "/")+"/cluster/slots")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.New("node isn't in cluster mode")
+	}
+
+	var state struct {
+		Slots []struct {
+			Start int    `json:"start"`
+			End   int    `json:"end"`
+			Node  string `json:"node"`
+		} `json:"slots"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&state); err != nil {
+		return err
+	}
+
+	slots := make([]string, clusterSlots)
+	for _, s := range state.Slots {
+		for slot := s.Start; slot <= s.End && slot < clusterSlots; slot++ {
+			slots[slot] = s.Node
+		}
+	}
+
+	r.mu.Lock()
+	r.slots = slots
+	r.mu.Unlock()
+
+	return nil
+}
+
+func (r *clusterRouter) loaded() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.slots != nil
+}
+
+// node returns the URL of the node serving slot, empty when it's unknown.
+func (r *clusterRouter) node(slot int) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.slots == nil {
+		return ""
+	}
+
+	return r.slots[slot]
+}
+
+// send sends a request built for the host of the client. A cluster client
+// sends it to the node serving the keys of the request instead and follows
+// the redirects of the nodes.
+func (c *Client) send(ctx context.Context, req *http.Request, path string, body []byte) (*http.Response, error) {
+	if c.cluster == nil {
+		return ctxhttp.Do(ctx, c.httpClient, req)
+	}
+
+	if slot, ok := requestSlot(path, body); ok {
+		if !c.cluster.loaded() {
+			if err := c.cluster.load(ctx, c.httpClient, c.baseURL); err != nil {
+				return nil, err
+			}
+		}
+		if node := c.cluster.node(slot); node != "" {
+			setNode(req, node)
+		}
+	}
+
+	for i := 0; i < maxClusterRedirects; i++ {
+		resp, err := ctxhttp.Do(ctx, c.httpClient, req)
+		if err != nil {
+			return nil, err
+		}
+		switch resp.StatusCode {
+		case http.StatusPermanentRedirect, http.StatusTemporaryRedirect, http.StatusServiceUnavailable:
+		default:
+			return resp, nil
+		}
+
+		respErr := map[string]string{}
+		json.NewDecoder(resp.Body).Decode(&respErr)
+		resp.Body.Close()
+
+		next := req.Clone(ctx)
+		if body != nil {
+			next.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		next.Header.Del(askingHeader)
+
+		// MOVED <slot> <node>, ASK <slot> <node> or TRYAGAIN while the
+		// keys of the request are split by a migration.
+		fields := strings.Fields(respErr["error"])
+		switch {
+		case len(fields) == 3 && fields[0] == "MOVED":
+			if err := c.cluster.load(ctx, c.httpClient, fields[2]); err != nil {
+				return nil, err
+			}
+			setNode(next, fields[2])
+		case len(fields) == 3 && fields[0] == "ASK":
+			next.Header.Set(askingHeader, "1")
+			setNode(next, fields[2])
+		case len(fields) > 0 && fields[0] == "TRYAGAIN":
+			time.Sleep(10 * time.Millisecond)
+		default:
+			return nil, errors.New(respErr["error"])
+		}
+		req = next
+	}
+
+	return nil, errors.New("too many cluster redirects")
+}
+
+// setNode points req at the node with the URL addr.
+func setNode(req *http.Request, addr string) {
+	u, err := url.Parse(addr)
+	if err != nil {
+		return
+	}
+	req.URL.Scheme = u.Scheme
+	req.URL.Host = u.Host
+	req.Host = u.Host
+}
+
+// requestSlot returns the slot of the keys of a request: the key of its
+// path, the key query parameters and the keys named in its body.
+func requestSlot(path string, body []byte) (int, bool) {
+	u, err := url.Parse(path)
+	if err != nil {
+		return 0, false
+	}
+
+	var keys []string
+	if segments := strings.Split(u.Path, "/"); len(segments) > 1 {
+		keys = append(keys, segments[1])
+	}
+	keys = append(keys, u.Query()["key"]...)
+	if body != nil {
+		var v interface{}
+		if err := json.Unmarshal(body, &v); err == nil {
+			keys = append(keys, argKeys(v)...)
+		}
+	}
+	if len(keys) == 0 {
+		return 0, false
+	}
+
+	return keySlot(keys[0]), true
+}
+
+// argKeys returns the keys named in the args of a command: key, keys and
+// destination, and for transactions the keys of the commands and the
+// watched keys.
+func argKeys(v interface{}) []string {
+	var keys []string
+	switch v := v.(type) {
+	case []interface{}:
+		for _, cmd := range v {
+			if cmd, ok := cmd.(map[string]interface{}); ok {
+				keys = append(keys, argKeys(jsonField(cmd, "args"))...)
+			}
+		}
+	case map[string]interface{}:
+		for _, name := range []string{"key", "destination"} {
+			if key, ok := jsonField(v, name).(string); ok {
+				keys = append(keys, key)
+			}
+		}
+		if list, ok := jsonField(v, "keys").([]interface{}); ok {
+			for _, key := range list {
+				if key, ok := key.(string); ok {
+					keys = append(keys, key)
+				}
+			}
+		}
+		if watch, ok := jsonField(v, "watch").(map[string]interface{}); ok {
+			for key := range watch {
+				keys = append(keys, key)
+			}
+		}
+		if commands, ok := jsonField(v, "commands").([]interface{}); ok {
+			keys = append(keys, argKeys(commands)...)
+		}
+	}
+
+	return keys
+}
+
+// jsonField returns the field name of a JSON object, matched without case
+// like encoding/json does when it decodes the request.
+func jsonField(v map[string]interface{}, name string) interface{} {
+	if field, ok := v[name]; ok {
+		return field
+	}
+	for k, field := range v {
+		if strings.EqualFold(k, name) {
+			return field
+		}
+	}
+
+	return nil
+}
+
+// keySlot returns the hash slot of key, see the server.
+func keySlot(key string) int {
+	if start := strings.IndexByte(key, '{'); start >= 0 {
+		if end := strings.IndexByte(key[start+1:], '}'); end > 0 {
+			key = key[start+1 : start+1+end]
+		}
+	}
+
+	var crc uint16
+	for i := 0; i < len(key); i++ {
+		crc ^= uint16(key[i]) << 8
+		for j := 0; j < 8; j++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+
+	return int(crc) % clusterSlots
+}
@@ -0,0 +1,140 @@
+package cacheclient
+
+import (
+	"encoding/json"
+	"golang.org/x/net/context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestKeySlot(t *testing.T) {
+	if slot := keySlot("123456789"); slot != 0x31c3 {
+		t.Error("Slot isn't CRC16 of the key", slot)
+	}
+	if keySlot("{user1}.following") != keySlot("{user1}.followers") {
+		t.Error("Keys with the same hash tag are in different slots")
+	}
+	if keySlot("{}.a") == keySlot("{}.b") {
+		t.Error("Empty hash tag is used")
+	}
+}
+
+// clusterNode is an httptest server serving all slots from the node at
+// the URL owner returns. It records the paths it gets with the asking
+// header.
+type clusterNode struct {
+	*httptest.Server
+	requests []string
+}
+
+func newClusterNode(t *testing.T, owner func() string, handle func(w http.ResponseWriter, r *http.Request)) *clusterNode {
+	n := &clusterNode{}
+	n.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/cluster/slots" {
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"slots": []map[string]interface{}{{"start": 0, "end": clusterSlots - 1, "node": owner()}},
+			})
+			return
+		}
+		n.requests = append(n.requests, r.URL.Path+" "+r.Header.Get(askingHeader))
+		handle(w, r)
+	}))
+	t.Cleanup(n.Close)
+
+	return n
+}
+
+func redirect(w http.ResponseWriter, status int, message string) {
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
+
+func TestCluster_Redirects(t *testing.T) {
+	var a, b *clusterNode
+	// a still thinks it serves all slots, which moved to b.
+	a = newClusterNode(t, func() string { return a.URL }, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/get/k":
+			redirect(w, http.StatusPermanentRedirect, "MOVED 7 "+b.URL)
+		case "/get/migrating":
+			if r.Header.Get(askingHeader) == "" {
+				redirect(w, http.StatusPermanentRedirect, "MOVED 8 "+b.URL)
+				return
+			}
+			json.NewEncoder(w).Encode("a")
+		case "/dbsize":
+			json.NewEncoder(w).Encode(7)
+		}
+	})
+	b = newClusterNode(t, func() string { return b.URL }, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/get/k":
+			json.NewEncoder(w).Encode("b")
+		case "/get/migrating":
+			redirect(w, http.StatusTemporaryRedirect, "ASK 8 "+a.URL)
+		case "/get/down":
+			redirect(w, http.StatusServiceUnavailable, "CLUSTERDOWN slot isn't served")
+		}
+	})
+	c := NewClient(a.URL+"/", WithCluster())
+	ctx := context.Background()
+
+	// MOVED is followed and reloads the map from the new node.
+	if v, err := c.Get(ctx, "k"); err != nil || v != "b" {
+		t.Error("MOVED isn't followed", v, err)
+	}
+	if v, err := c.Get(ctx, "k"); err != nil || v != "b" {
+		t.Error("Get fails", v, err)
+	}
+	if len(a.requests) != 1 || len(b.requests) != 2 {
+		t.Error("Keys aren't sent to the node of the reloaded map", a.requests, b.requests)
+	}
+
+	// ASK is followed once with the asking header, the map is kept.
+	a.requests, b.requests = nil, nil
+	for i := 0; i < 2; i++ {
+		if v, err := c.Get(ctx, "migrating"); err != nil || v != "a" {
+			t.Error("ASK isn't followed", v, err)
+		}
+	}
+	if len(a.requests) != 2 || a.requests[0] != "/get/migrating 1" || len(b.requests) != 2 {
+		t.Error("ASK is followed wrong", a.requests, b.requests)
+	}
+
+	// Requests without keys go to the host.
+	if size, err := c.DBSize(ctx); err != nil || size != 7 {
+		t.Error("Request without keys isn't sent to the host", size, err)
+	}
+
+	if _, err := c.Get(ctx, "down"); err == nil || err.Error() != "CLUSTERDOWN slot isn't served" {
+		t.Error("Cluster error isn't returned", err)
+	}
+}
+
+func TestCluster_Routing(t *testing.T) {
+	var a, b *clusterNode
+	a = newClusterNode(t, func() string { return b.URL }, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode("a")
+	})
+	b = newClusterNode(t, func() string { return b.URL }, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"result": "success", "key": "k", "value": 1})
+	})
+	c := NewClient(a.URL+"/", WithCluster())
+	ctx := context.Background()
+
+	// The keys are read from the path, the query and the body.
+	if _, err := c.Set(ctx, &SetBody{Key: "k", Value: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := c.BPop(ctx, time.Second, "k", "l"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get(ctx, "k"); err != nil {
+		t.Fatal(err)
+	}
+	if len(a.requests) != 0 || len(b.requests) != 3 {
+		t.Error("Keys aren't sent to the node of their slot", a.requests, b.requests)
+	}
+}
@@ -0,0 +1,98 @@
+package cacheclient
+
+import (
+	"encoding/json"
+	"github.com/iqOptionTest/simplecache/cachepb"
+	"golang.org/x/net/context"
+)
+
+// CounterBody names the counter. Expired is applied when the counter is
+// created, and on every change when Refresh is set.
+type CounterBody struct {
+	Key     string `json:"key"`
+	Expired int    `json:"expired"`
+	Refresh bool   `json:"refresh"`
+}
+
+type incrBody struct {
+	CounterBody
+	Value interface{} `json:"value"`
+}
+
+func (c *Client) Incr(ctx context.Context, body *CounterBody) (int64, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Incr(c.outgoing(ctx), &cachepb.IncrRequest{Key: body.Key, Expired: int64(body.Expired), Refresh: body.Refresh})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return r.Value, nil
+	}
+
+	return c.incr(ctx, "incr", &incrBody{CounterBody: *body})
+}
+
+func (c *Client) Decr(ctx context.Context, body *CounterBody) (int64, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Decr(c.outgoing(ctx), &cachepb.DecrRequest{Key: body.Key, Expired: int64(body.Expired), Refresh: body.Refresh})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return r.Value, nil
+	}
+
+	return c.incr(ctx, "decr", &incrBody{CounterBody: *body})
+}
+
+func (c *Client) IncrBy(ctx context.Context, body *CounterBody, delta int64) (int64, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.IncrBy(c.outgoing(ctx), &cachepb.IncrByRequest{Key: body.Key, Expired: int64(body.Expired), Refresh: body.Refresh, Value: delta})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return r.Value, nil
+	}
+
+	return c.incr(ctx, "incrby", &incrBody{CounterBody: *body, Value: delta})
+}
+
+func (c *Client) incr(ctx context.Context, path string, body *incrBody) (int64, error) {
+	b, _ := json.Marshal(body)
+	config := &apiConfig{
+		path: path,
+	}
+	var response struct {
+		Value int64 `json:"value"`
+	}
+	err := c.postJSON(ctx, config, b, &response)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Value, nil
+}
+
+func (c *Client) IncrByFloat(ctx context.Context, body *CounterBody, delta float64) (float64, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.IncrByFloat(c.outgoing(ctx), &cachepb.IncrByFloatRequest{Key: body.Key, Expired: int64(body.Expired), Refresh: body.Refresh, Value: delta})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return r.Value, nil
+	}
+
+	b, _ := json.Marshal(&incrBody{CounterBody: *body, Value: delta})
+	config := &apiConfig{
+		path: "incrbyfloat",
+	}
+	var response struct {
+		Value float64 `json:"value"`
+	}
+	err := c.postJSON(ctx, config, b, &response)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Value, nil
+}
@@ -0,0 +1,110 @@
+package cacheclient
+
+import (
+	"encoding/json"
+	"github.com/iqOptionTest/simplecache/cachepb"
+	"golang.org/x/net/context"
+	"net/http"
+	"strconv"
+)
+
+// dbHeader selects the database of a request.
+const dbHeader = "X-Cache-DB"
+
+// WithDB pins a client to the logical database db of the server.
+func WithDB(db int) ClientOption {
+	return func(c *Client) {
+		c.db = strconv.Itoa(db)
+	}
+}
+
+// Select returns a client for the logical database db that shares the
+// connection and the rate limit of c. It pins gRPC clients as well.
+func (c *Client) Select(db int) *Client {
+	selected := *c
+	selected.db = strconv.Itoa(db)
+
+	return &selected
+}
+
+func (c *Client) setDB(req *http.Request) {
+	if c.db != "" {
+		req.Header.Set(dbHeader, c.db)
+	}
+}
+
+// DBSize returns the number of keys in the database.
+func (c *Client) DBSize(ctx context.Context) (int64, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.DbSize(c.outgoing(ctx), &cachepb.DbSizeRequest{})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return r.Size, nil
+	}
+
+	config := &apiConfig{
+		path: "dbsize",
+	}
+	var size int64
+	err := c.getJSON(ctx, config, &size)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
+
+// FlushDB removes all keys of the database and returns how many there
+// were.
+func (c *Client) FlushDB(ctx context.Context) (int, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.FlushDb(c.outgoing(ctx), &cachepb.FlushDbRequest{})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return int(r.Deleted), nil
+	}
+
+	config := &apiConfig{
+		path: "flushdb",
+	}
+	var response struct {
+		Deleted int `json:"deleted"`
+	}
+	err := c.postJSON(ctx, config, nil, &response)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Deleted, nil
+}
+
+// Move moves the key to the database db. It returns false when db already
+// has the key.
+func (c *Client) Move(ctx context.Context, key string, db int) (bool, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Move(c.outgoing(ctx), &cachepb.MoveRequest{Key: key, Db: int64(db)})
+		if err != nil {
+			return false, grpcError(err)
+		}
+		return r.Moved, nil
+	}
+
+	b, _ := json.Marshal(map[string]interface{}{"key": key, "db": db})
+	config := &apiConfig{
+		path: "move",
+	}
+	var response struct {
+		Moved bool `json:"moved"`
+	}
+	err := c.postJSON(ctx, config, b, &response)
+
+	if err != nil {
+		return false, err
+	}
+
+	return response.Moved, nil
+}
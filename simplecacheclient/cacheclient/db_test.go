@@ -0,0 +1,98 @@
+package cacheclient
+
+import (
+	"encoding/json"
+	"golang.org/x/net/context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// dbRequest is a request seen by dbServer.
+type dbRequest struct {
+	method, path, db string
+	body             map[string]interface{}
+}
+
+func dbServer(t *testing.T) (*httptest.Server, *[]dbRequest) {
+	var requests []dbRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := dbRequest{method: r.Method, path: r.URL.Path, db: r.Header.Get(dbHeader)}
+		json.NewDecoder(r.Body).Decode(&req.body)
+		requests = append(requests, req)
+
+		switch r.URL.Path {
+		case "/dbsize":
+			json.NewEncoder(w).Encode(5)
+		case "/flushdb":
+			json.NewEncoder(w).Encode(map[string]int{"deleted": 3})
+		case "/move":
+			json.NewEncoder(w).Encode(map[string]bool{"moved": true})
+		default:
+			json.NewEncoder(w).Encode("value")
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func TestWithDB(t *testing.T) {
+	server, requests := dbServer(t)
+	ctx := context.Background()
+
+	if _, err := NewClient(server.URL+"/").Get(ctx, "k"); err != nil {
+		t.Fatal(err)
+	}
+	if db := (*requests)[0].db; db != "" {
+		t.Error("Database is sent without WithDB", db)
+	}
+
+	c := NewClient(server.URL+"/", WithDB(2))
+	if v, err := c.Get(ctx, "k"); err != nil || v != "value" {
+		t.Error("Get fails", v, err)
+	}
+	if req := (*requests)[1]; req.path != "/get/k" || req.db != "2" {
+		t.Error("Database isn't sent", req)
+	}
+
+	// Select pins a copy and leaves c on its database.
+	if _, err := c.Select(3).Get(ctx, "k"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get(ctx, "k"); err != nil {
+		t.Fatal(err)
+	}
+	if selected, pinned := (*requests)[2].db, (*requests)[3].db; selected != "3" || pinned != "2" {
+		t.Error("Select doesn't pin the copy only", selected, pinned)
+	}
+}
+
+func TestDB(t *testing.T) {
+	server, requests := dbServer(t)
+	c := NewClient(server.URL+"/", WithDB(1))
+	ctx := context.Background()
+
+	if size, err := c.DBSize(ctx); err != nil || size != 5 {
+		t.Error("DBSize is wrong", size, err)
+	}
+	if deleted, err := c.FlushDB(ctx); err != nil || deleted != 3 {
+		t.Error("FlushDB is wrong", deleted, err)
+	}
+	if moved, err := c.Move(ctx, "k", 4); err != nil || !moved {
+		t.Error("Move is wrong", moved, err)
+	}
+
+	for i, want := range []dbRequest{
+		{method: "GET", path: "/dbsize"},
+		{method: "POST", path: "/flushdb"},
+		{method: "POST", path: "/move"},
+	} {
+		if req := (*requests)[i]; req.method != want.method || req.path != want.path || req.db != "1" {
+			t.Error("Request is wrong", req)
+		}
+	}
+	if body := (*requests)[2].body; body["key"] != "k" || body["db"] != 4.0 {
+		t.Error("Move body is wrong", body)
+	}
+}
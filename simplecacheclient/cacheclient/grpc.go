@@ -0,0 +1,705 @@
+package cacheclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/iqOptionTest/simplecache/cachepb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"io"
+	"strconv"
+)
+
+// grpcTransport is the connection of a gRPC client. The methods of the
+// Client call the RPC of their operation with its typed request, see
+// cachepb/cache.proto.
+type grpcTransport struct {
+	conn  *grpc.ClientConn
+	cache cachepb.CacheClient
+}
+
+// NewGRPCClient returns a Client that uses the gRPC API at target, e.g.
+// "localhost:9013", instead of HTTP. The connection is insecure when no
+// options are given. Its requests aren't rate limited.
+func NewGRPCClient(target string, opts ...grpc.DialOption) (*Client, error) {
+	if len(opts) == 0 {
+		opts = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	}
+
+	conn, err := grpc.NewClient(target, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{grpc: &grpcTransport{conn: conn, cache: cachepb.NewCacheClient(conn)}}, nil
+}
+
+// Close closes the connection of a gRPC client.
+func (c *Client) Close() error {
+	if c.grpc == nil {
+		return nil
+	}
+
+	return c.grpc.conn.Close()
+}
+
+// outgoing adds the database of the client to the metadata of a call, as
+// the header of an HTTP request.
+func (c *Client) outgoing(ctx context.Context) context.Context {
+	if c.db == "" {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, dbHeader, c.db)
+}
+
+// grpcError returns the message of an error status as a plain error, so
+// it compares like the errors of the HTTP API.
+func grpcError(err error) error {
+	if s, ok := status.FromError(err); ok {
+		return errors.New(s.Message())
+	}
+
+	return err
+}
+
+// protoValue converts a value to write. Strings, numbers, booleans and nil
+// are sent as such, anything else as its JSON.
+func protoValue(v interface{}) (*cachepb.Value, error) {
+	switch v := v.(type) {
+	case nil:
+		return &cachepb.Value{Kind: &cachepb.Value_NullValue{NullValue: true}}, nil
+	case bool:
+		return &cachepb.Value{Kind: &cachepb.Value_BoolValue{BoolValue: v}}, nil
+	case string:
+		return &cachepb.Value{Kind: &cachepb.Value_StringValue{StringValue: v}}, nil
+	case json.Number:
+		return &cachepb.Value{Kind: &cachepb.Value_NumberValue{NumberValue: string(v)}}, nil
+	case int:
+		return protoValue(json.Number(strconv.Itoa(v)))
+	case int64:
+		return protoValue(json.Number(strconv.FormatInt(v, 10)))
+	case json.RawMessage:
+		if raw, ok := jsonValue(v).(json.RawMessage); ok {
+			return &cachepb.Value{Kind: &cachepb.Value_JsonValue{JsonValue: raw}}, nil
+		}
+		return protoValue(jsonValue(v))
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return protoValue(json.RawMessage(b))
+}
+
+func protoValues(values []interface{}) ([]*cachepb.Value, error) {
+	result := make([]*cachepb.Value, len(values))
+	for i, v := range values {
+		var err error
+		if result[i], err = protoValue(v); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// protoFields converts the fields of a hash to write, given as a map or a
+// struct.
+func protoFields(v interface{}) (map[string]*cachepb.Value, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]*cachepb.Value, len(raw))
+	for k, v := range raw {
+		if fields[k], err = protoValue(v); err != nil {
+			return nil, err
+		}
+	}
+
+	return fields, nil
+}
+
+// goValue converts a read value like jsonValue does: a string, a
+// json.Number, a bool, nil, or a json.RawMessage for objects and arrays.
+func goValue(v *cachepb.Value) interface{} {
+	switch kind := v.GetKind().(type) {
+	case *cachepb.Value_BoolValue:
+		return kind.BoolValue
+	case *cachepb.Value_NumberValue:
+		return json.Number(kind.NumberValue)
+	case *cachepb.Value_StringValue:
+		return kind.StringValue
+	case *cachepb.Value_JsonValue:
+		return json.RawMessage(kind.JsonValue)
+	}
+
+	return nil
+}
+
+func goValues(values []*cachepb.Value) []interface{} {
+	result := make([]interface{}, len(values))
+	for i, v := range values {
+		result[i] = goValue(v)
+	}
+
+	return result
+}
+
+// decodedValue converts a read value like decodeJSON into an interface{}
+// does, for the methods that return objects and arrays decoded.
+func decodedValue(v *cachepb.Value) interface{} {
+	raw, ok := goValue(v).(json.RawMessage)
+	if !ok {
+		return goValue(v)
+	}
+
+	var result interface{}
+	decodeJSON(bytes.NewReader(raw), &result)
+
+	return result
+}
+
+func decodedValues(values []*cachepb.Value) []interface{} {
+	result := make([]interface{}, len(values))
+	for i, v := range values {
+		result[i] = decodedValue(v)
+	}
+
+	return result
+}
+
+func zmembers(members []*cachepb.ZMember) []ZMember {
+	result := make([]ZMember, len(members))
+	for i, zm := range members {
+		result[i] = ZMember{Member: zm.Member, Score: zm.Score}
+	}
+
+	return result
+}
+
+// commandArgs are the args of a TxCommand by the names of the request body
+// of its endpoint, or of its path and query parameters.
+type commandArgs struct {
+	Key          string            `json:"key"`
+	Keys         []string          `json:"keys"`
+	Destination  string            `json:"destination"`
+	Field        string            `json:"field"`
+	Fields       []string          `json:"fields"`
+	Member       string            `json:"member"`
+	Value        json.RawMessage   `json:"value"`
+	Values       []json.RawMessage `json:"values"`
+	Expired      int64             `json:"expired"`
+	Refresh      bool              `json:"refresh"`
+	NX           bool              `json:"nx"`
+	XX           bool              `json:"xx"`
+	Incr         bool              `json:"incr"`
+	Version      int64             `json:"version"`
+	Seconds      int64             `json:"seconds"`
+	Milliseconds int64             `json:"milliseconds"`
+	Timestamp    int64             `json:"timestamp"`
+	TimestampMs  int64             `json:"timestamp_ms"`
+	Index        int64             `json:"index"`
+	Start        int64             `json:"start"`
+	Stop         int64             `json:"stop"`
+	Count        *int64            `json:"count"`
+	Position     string            `json:"position"`
+	Pivot        json.RawMessage   `json:"pivot"`
+	Min          string            `json:"min"`
+	Max          string            `json:"max"`
+	Offset       int64             `json:"offset"`
+	Rev          bool              `json:"rev"`
+	Pattern      string            `json:"pattern"`
+	Cursor       string            `json:"cursor"`
+	Match        string            `json:"match"`
+	Type         string            `json:"type"`
+	Timeout      float64           `json:"timeout"`
+	DB           int64             `json:"db"`
+}
+
+// value decodes the value arg into v, the type it has for the operation.
+func (a *commandArgs) value(v interface{}) error {
+	if a.Value == nil {
+		return nil
+	}
+
+	return json.Unmarshal(a.Value, v)
+}
+
+func (a *commandArgs) count() int64 {
+	if a.Count == nil {
+		return 0
+	}
+
+	return *a.Count
+}
+
+var commandFields = (&cachepb.Command{}).ProtoReflect().Descriptor().Fields()
+
+// grpcCommand converts a command of Tx or Batch to the typed request of
+// its operation.
+func grpcCommand(cmd TxCommand) (*cachepb.Command, error) {
+	b, err := json.Marshal(cmd.Args)
+	if err != nil {
+		return nil, err
+	}
+	var a commandArgs
+	if cmd.Args != nil {
+		if err := json.Unmarshal(b, &a); err != nil {
+			return nil, err
+		}
+	}
+
+	var req proto.Message
+	switch cmd.Op {
+	case "get":
+		req = &cachepb.GetRequest{Key: a.Key}
+	case "gets":
+		req = &cachepb.GetsRequest{Key: a.Key}
+	case "version":
+		req = &cachepb.VersionRequest{Key: a.Key}
+	case "set":
+		value, err := protoValue(a.Value)
+		if err != nil {
+			return nil, err
+		}
+		req = &cachepb.SetRequest{Key: a.Key, Value: value, Expired: a.Expired, Nx: a.NX, Xx: a.XX}
+	case "getset":
+		value, err := protoValue(a.Value)
+		if err != nil {
+			return nil, err
+		}
+		req = &cachepb.GetSetRequest{Key: a.Key, Value: value, Expired: a.Expired}
+	case "cas":
+		value, err := protoValue(a.Value)
+		if err != nil {
+			return nil, err
+		}
+		req = &cachepb.CasRequest{Key: a.Key, Value: value, Expired: a.Expired, Version: a.Version}
+	case "incr":
+		req = &cachepb.IncrRequest{Key: a.Key, Expired: a.Expired, Refresh: a.Refresh}
+	case "decr":
+		req = &cachepb.DecrRequest{Key: a.Key, Expired: a.Expired, Refresh: a.Refresh}
+	case "incrby":
+		r := &cachepb.IncrByRequest{Key: a.Key, Expired: a.Expired, Refresh: a.Refresh}
+		req, err = r, a.value(&r.Value)
+	case "incrbyfloat":
+		r := &cachepb.IncrByFloatRequest{Key: a.Key, Expired: a.Expired, Refresh: a.Refresh}
+		req, err = r, a.value(&r.Value)
+	case "unset":
+		req = &cachepb.UnsetRequest{Key: a.Key}
+	case "expire":
+		req = &cachepb.ExpireRequest{Key: a.Key, Seconds: a.Seconds, Milliseconds: a.Milliseconds}
+	case "expireat":
+		req = &cachepb.ExpireAtRequest{Key: a.Key, Timestamp: a.Timestamp, TimestampMs: a.TimestampMs}
+	case "persist":
+		req = &cachepb.PersistRequest{Key: a.Key}
+	case "ttl":
+		req = &cachepb.TtlRequest{Key: a.Key}
+	case "pttl":
+		req = &cachepb.PttlRequest{Key: a.Key}
+	case "keys":
+		req = &cachepb.KeysRequest{Pattern: a.Pattern}
+	case "scan":
+		req = &cachepb.ScanRequest{Cursor: a.Cursor, Count: a.count(), Match: a.Match, Type: a.Type}
+	case "rpush", "lpush":
+		values := a.Values
+		if len(values) == 0 && a.Value != nil {
+			values = []json.RawMessage{a.Value}
+		}
+		pv := make([]*cachepb.Value, len(values))
+		for i, v := range values {
+			if pv[i], err = protoValue(v); err != nil {
+				return nil, err
+			}
+		}
+		if cmd.Op == "rpush" {
+			req = &cachepb.RpushRequest{Key: a.Key, Values: pv, Expired: a.Expired}
+		} else {
+			req = &cachepb.LpushRequest{Key: a.Key, Values: pv, Expired: a.Expired}
+		}
+	case "pop":
+		req = &cachepb.PopRequest{Key: a.Key}
+	case "lpop":
+		req = &cachepb.LpopRequest{Key: a.Key}
+	case "blpop":
+		req = &cachepb.BlpopRequest{Keys: a.Keys, Timeout: a.Timeout}
+	case "brpop":
+		req = &cachepb.BrpopRequest{Keys: a.Keys, Timeout: a.Timeout}
+	case "lgetall":
+		req = &cachepb.LgetallRequest{Key: a.Key}
+	case "lget":
+		req = &cachepb.LgetRequest{Key: a.Key, Index: a.Index}
+	case "llen":
+		req = &cachepb.LlenRequest{Key: a.Key}
+	case "lrange":
+		req = &cachepb.LrangeRequest{Key: a.Key, Start: a.Start, Stop: a.Stop}
+	case "lset":
+		value, err := protoValue(a.Value)
+		if err != nil {
+			return nil, err
+		}
+		req = &cachepb.LsetRequest{Key: a.Key, Index: a.Index, Value: value}
+	case "lrem":
+		value, err := protoValue(a.Value)
+		if err != nil {
+			return nil, err
+		}
+		req = &cachepb.LremRequest{Key: a.Key, Count: a.count(), Value: value}
+	case "ltrim":
+		req = &cachepb.LtrimRequest{Key: a.Key, Start: a.Start, Stop: a.Stop}
+	case "linsert":
+		pivot, err := protoValue(a.Pivot)
+		if err != nil {
+			return nil, err
+		}
+		value, err := protoValue(a.Value)
+		if err != nil {
+			return nil, err
+		}
+		req = &cachepb.LinsertRequest{Key: a.Key, Before: a.Position == "before", Pivot: pivot, Value: value}
+	case "hset":
+		var fields map[string]json.RawMessage
+		if err := a.value(&fields); err != nil {
+			return nil, err
+		}
+		r := &cachepb.HsetRequest{Key: a.Key, Fields: make(map[string]*cachepb.Value, len(fields)), Expired: a.Expired}
+		for k, v := range fields {
+			if r.Fields[k], err = protoValue(v); err != nil {
+				return nil, err
+			}
+		}
+		req = r
+	case "hgetall":
+		req = &cachepb.HgetallRequest{Key: a.Key}
+	case "hget":
+		req = &cachepb.HgetRequest{Key: a.Key, Field: a.Field}
+	case "hdel":
+		req = &cachepb.HdelRequest{Key: a.Key, Fields: a.Fields}
+	case "hexists":
+		req = &cachepb.HexistsRequest{Key: a.Key, Field: a.Field}
+	case "hlen":
+		req = &cachepb.HlenRequest{Key: a.Key}
+	case "hkeys":
+		req = &cachepb.HkeysRequest{Key: a.Key}
+	case "hvals":
+		req = &cachepb.HvalsRequest{Key: a.Key}
+	case "hmget":
+		req = &cachepb.HmgetRequest{Key: a.Key, Fields: a.Fields}
+	case "hsetnx":
+		value, err := protoValue(a.Value)
+		if err != nil {
+			return nil, err
+		}
+		req = &cachepb.HsetnxRequest{Key: a.Key, Field: a.Field, Value: value, Expired: a.Expired}
+	case "hincrby":
+		r := &cachepb.HincrbyRequest{Key: a.Key, Field: a.Field}
+		req, err = r, a.value(&r.Value)
+	case "hincrbyfloat":
+		r := &cachepb.HincrbyFloatRequest{Key: a.Key, Field: a.Field}
+		req, err = r, a.value(&r.Value)
+	case "sadd":
+		r := &cachepb.SaddRequest{Key: a.Key, Expired: a.Expired}
+		req, err = r, a.value(&r.Members)
+	case "srem":
+		r := &cachepb.SremRequest{Key: a.Key}
+		req, err = r, a.value(&r.Members)
+	case "sismember":
+		req = &cachepb.SismemberRequest{Key: a.Key, Member: a.Member}
+	case "smembers":
+		req = &cachepb.SmembersRequest{Key: a.Key}
+	case "scard":
+		req = &cachepb.ScardRequest{Key: a.Key}
+	case "spop":
+		req = &cachepb.SpopRequest{Key: a.Key, Count: a.Count}
+	case "sinter":
+		req = &cachepb.SinterRequest{Keys: a.Keys}
+	case "sunion":
+		req = &cachepb.SunionRequest{Keys: a.Keys}
+	case "sdiff":
+		req = &cachepb.SdiffRequest{Keys: a.Keys}
+	case "sinterstore":
+		req = &cachepb.SinterStoreRequest{Destination: a.Destination, Keys: a.Keys}
+	case "sunionstore":
+		req = &cachepb.SunionStoreRequest{Destination: a.Destination, Keys: a.Keys}
+	case "sdiffstore":
+		req = &cachepb.SdiffStoreRequest{Destination: a.Destination, Keys: a.Keys}
+	case "zadd":
+		var members []ZMember
+		if err := a.value(&members); err != nil {
+			return nil, err
+		}
+		r := &cachepb.ZaddRequest{Key: a.Key, Expired: a.Expired, Nx: a.NX, Xx: a.XX, Incr: a.Incr}
+		for _, zm := range members {
+			r.Members = append(r.Members, &cachepb.ZMember{Member: zm.Member, Score: zm.Score})
+		}
+		req = r
+	case "zrem":
+		r := &cachepb.ZremRequest{Key: a.Key}
+		req, err = r, a.value(&r.Members)
+	case "zscore":
+		req = &cachepb.ZscoreRequest{Key: a.Key, Member: a.Member}
+	case "zrank":
+		req = &cachepb.ZrankRequest{Key: a.Key, Member: a.Member}
+	case "zrevrank":
+		req = &cachepb.ZrevrankRequest{Key: a.Key, Member: a.Member}
+	case "zrange":
+		req = &cachepb.ZrangeRequest{Key: a.Key, Start: a.Start, Stop: a.Stop, Rev: a.Rev}
+	case "zrangebyscore":
+		req = &cachepb.ZrangeByScoreRequest{Key: a.Key, Min: a.Min, Max: a.Max, Offset: a.Offset, Count: a.Count, Rev: a.Rev}
+	case "zcount":
+		req = &cachepb.ZcountRequest{Key: a.Key, Min: a.Min, Max: a.Max}
+	case "zpopmin":
+		req = &cachepb.ZpopMinRequest{Key: a.Key, Count: a.Count}
+	case "zpopmax":
+		req = &cachepb.ZpopMaxRequest{Key: a.Key, Count: a.Count}
+	case "stats":
+		req = &cachepb.StatsRequest{}
+	case "dbsize":
+		req = &cachepb.DbSizeRequest{}
+	case "flushdb":
+		req = &cachepb.FlushDbRequest{}
+	case "move":
+		req = &cachepb.MoveRequest{Key: a.Key, Db: a.DB}
+	default:
+		return nil, errors.New("unknown command " + cmd.Op)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The fields of the command are named like the operations.
+	command := &cachepb.Command{}
+	command.ProtoReflect().Set(commandFields.ByName(protoreflect.Name(cmd.Op)), protoreflect.ValueOfMessage(req.ProtoReflect()))
+
+	return command, nil
+}
+
+// successOps are the operations whose response body is "result":
+// "success" with the fields of their response.
+var successOps = map[string]bool{
+	"set": true, "getset": true, "cas": true, "incr": true, "decr": true, "incrby": true, "incrbyfloat": true,
+	"unset": true, "expire": true, "expireat": true, "persist": true,
+	"rpush": true, "lpush": true, "lset": true, "lrem": true, "ltrim": true, "linsert": true,
+	"hset": true, "hdel": true, "hsetnx": true, "hincrby": true, "hincrbyfloat": true,
+	"sadd": true, "srem": true, "sinterstore": true, "sunionstore": true, "sdiffstore": true,
+	"zadd": true, "zrem": true, "flushdb": true, "move": true,
+}
+
+// txResult converts the result of a command of Tx or Batch to the response
+// body of its endpoint, as the HTTP API returns it.
+func txResult(r *cachepb.Result) TxResult {
+	if r.Error != "" {
+		return TxResult{Error: r.Error}
+	}
+
+	m := r.ProtoReflect()
+	field := m.WhichOneof(m.Descriptor().Oneofs().ByName("result"))
+	if field == nil {
+		return TxResult{}
+	}
+	op, response := string(field.Name()), m.Get(field).Message()
+
+	fields := response.Descriptor().Fields()
+	if !successOps[op] && fields.Len() == 1 {
+		return TxResult{Result: bodyValue(fields.Get(0), response.Get(fields.Get(0)))}
+	}
+	body := messageBody(response)
+	if successOps[op] {
+		body["result"] = "success"
+	}
+
+	return TxResult{Result: body}
+}
+
+func messageBody(m protoreflect.Message) map[string]interface{} {
+	body := map[string]interface{}{}
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.HasPresence() && !m.Has(fd) {
+			body[string(fd.Name())] = nil
+			continue
+		}
+		body[string(fd.Name())] = bodyValue(fd, m.Get(fd))
+	}
+
+	return body
+}
+
+// bodyValue converts a field of a response like decodeJSON decodes it from
+// a response body.
+func bodyValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch {
+	case fd.IsList():
+		list := v.List()
+		result := make([]interface{}, list.Len())
+		for i := range result {
+			result[i] = scalarValue(fd, list.Get(i))
+		}
+		return result
+	case fd.IsMap():
+		result := map[string]interface{}{}
+		v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			result[k.String()] = scalarValue(fd.MapValue(), v)
+			return true
+		})
+		return result
+	}
+
+	return scalarValue(fd, v)
+}
+
+func scalarValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch fd.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Int64Kind:
+		return json.Number(strconv.FormatInt(v.Int(), 10))
+	case protoreflect.DoubleKind:
+		return json.Number(strconv.FormatFloat(v.Float(), 'g', -1, 64))
+	case protoreflect.MessageKind:
+		if value, ok := v.Message().Interface().(*cachepb.Value); ok {
+			return decodedValue(value)
+		}
+		return messageBody(v.Message())
+	}
+
+	return v.Interface()
+}
+
+// tx runs a transaction of typed commands.
+func (t *grpcTransport) tx(ctx context.Context, watch map[string]int64, commands []TxCommand) ([]TxResult, error) {
+	req := &cachepb.TxRequest{Watch: watch, Commands: make([]*cachepb.Command, len(commands))}
+	for i, cmd := range commands {
+		var err error
+		if req.Commands[i], err = grpcCommand(cmd); err != nil {
+			return nil, errors.New("command " + strconv.Itoa(i) + ": " + err.Error())
+		}
+	}
+
+	r, err := t.cache.Tx(ctx, req)
+	if err != nil {
+		if status.Code(err) == codes.Aborted {
+			return nil, ErrWatchConflict
+		}
+		return nil, grpcError(err)
+	}
+
+	results := make([]TxResult, len(r.Results))
+	for i, result := range r.Results {
+		results[i] = txResult(result)
+	}
+
+	return results, nil
+}
+
+// batch sends the commands on the stream of the Batch RPC while it
+// receives their results.
+func (t *grpcTransport) batch(ctx context.Context, commands []TxCommand) ([]TxResult, error) {
+	requests := make([]*cachepb.Command, len(commands))
+	for i, cmd := range commands {
+		var err error
+		if requests[i], err = grpcCommand(cmd); err != nil {
+			return nil, errors.New("command " + strconv.Itoa(i) + ": " + err.Error())
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := t.cache.Batch(ctx)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	go func() {
+		for _, req := range requests {
+			if stream.Send(req) != nil {
+				return
+			}
+		}
+		stream.CloseSend()
+	}()
+
+	results := make([]TxResult, len(requests))
+	for i := range results {
+		r, err := stream.Recv()
+		if err == io.EOF {
+			return nil, io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		results[i] = txResult(r)
+	}
+
+	return results, nil
+}
+
+// subscribe opens the stream of the messages of a subscription, see
+// Client.stream.
+func (t *grpcTransport) subscribe(ctx context.Context, req *cachepb.SubscribeRequest, send func(Message) bool) (func(), error) {
+	messages, err := t.cache.Subscribe(ctx, req)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	if err := opened(messages, func() error { _, err := messages.Recv(); return err }); err != nil {
+		return nil, err
+	}
+
+	return func() {
+		for {
+			m, err := messages.Recv()
+			if err != nil || !send(Message{Channel: m.Channel, Pattern: m.Pattern, Payload: decodedValue(m.Payload)}) {
+				return
+			}
+		}
+	}, nil
+}
+
+// notifications opens the stream of keyspace events, see Client.stream.
+func (t *grpcTransport) notifications(ctx context.Context, req *cachepb.NotificationsRequest, send func(KeyspaceEvent) bool) (func(), error) {
+	events, err := t.cache.Notifications(ctx, req)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	if err := opened(events, func() error { _, err := events.Recv(); return err }); err != nil {
+		return nil, err
+	}
+
+	return func() {
+		for {
+			e, err := events.Recv()
+			if err != nil || !send(KeyspaceEvent{Key: e.Key, Op: e.Op, Type: e.Type, Timestamp: e.Timestamp.AsTime()}) {
+				return
+			}
+		}
+	}, nil
+}
+
+// opened waits until the server accepted a stream. The server sends the
+// headers once it subscribed, or fails without them and the status is
+// returned by the first receive.
+func opened(stream grpc.ClientStream, recv func() error) error {
+	md, err := stream.Header()
+	if err == nil && md == nil {
+		err = recv()
+	}
+	if err != nil {
+		return grpcError(err)
+	}
+
+	return nil
+}
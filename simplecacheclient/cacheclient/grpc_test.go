@@ -0,0 +1,281 @@
+package cacheclient
+
+import (
+	"encoding/json"
+	"github.com/iqOptionTest/simplecache/cachepb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"net"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeCache is a gRPC server that records the requests it gets and the
+// database of their metadata.
+type fakeCache struct {
+	cachepb.UnimplementedCacheServer
+	mu       sync.Mutex
+	requests []proto.Message
+	dbs      []string
+}
+
+func (f *fakeCache) record(ctx context.Context, req proto.Message) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, req)
+	f.dbs = append(f.dbs, append(md.Get(dbHeader), "")[0])
+}
+
+func (f *fakeCache) last() (proto.Message, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.requests[len(f.requests)-1], f.dbs[len(f.dbs)-1]
+}
+
+func (f *fakeCache) Set(ctx context.Context, req *cachepb.SetRequest) (*cachepb.SetResponse, error) {
+	f.record(ctx, req)
+	return &cachepb.SetResponse{Set: true, Version: 7}, nil
+}
+
+func (f *fakeCache) Get(ctx context.Context, req *cachepb.GetRequest) (*cachepb.GetResponse, error) {
+	f.record(ctx, req)
+	switch req.Key {
+	case "big":
+		return &cachepb.GetResponse{Value: &cachepb.Value{Kind: &cachepb.Value_NumberValue{NumberValue: "9007199254740993"}}}, nil
+	case "object":
+		return &cachepb.GetResponse{Value: &cachepb.Value{Kind: &cachepb.Value_JsonValue{JsonValue: []byte(`{"a":1}`)}}}, nil
+	}
+	return nil, status.Error(codes.NotFound, "not found")
+}
+
+func (f *fakeCache) Cas(ctx context.Context, req *cachepb.CasRequest) (*cachepb.CasResponse, error) {
+	f.record(ctx, req)
+	return nil, status.Error(codes.Aborted, "version mismatch")
+}
+
+func (f *fakeCache) Brpop(ctx context.Context, req *cachepb.BrpopRequest) (*cachepb.BrpopResponse, error) {
+	f.record(ctx, req)
+	return nil, status.Error(codes.NotFound, "timeout")
+}
+
+func (f *fakeCache) Hset(ctx context.Context, req *cachepb.HsetRequest) (*cachepb.HsetResponse, error) {
+	f.record(ctx, req)
+	return &cachepb.HsetResponse{}, nil
+}
+
+func (f *fakeCache) Tx(ctx context.Context, req *cachepb.TxRequest) (*cachepb.TxResponse, error) {
+	f.record(ctx, req)
+	if len(req.Watch) > 0 {
+		return nil, status.Error(codes.Aborted, "watched key changed")
+	}
+	return &cachepb.TxResponse{Results: []*cachepb.Result{
+		{Result: &cachepb.Result_Rpush{Rpush: &cachepb.RpushResponse{Length: 2}}},
+		{Result: &cachepb.Result_Lrange{Lrange: &cachepb.LrangeResponse{Values: []*cachepb.Value{
+			{Kind: &cachepb.Value_StringValue{StringValue: "a"}},
+			{Kind: &cachepb.Value_JsonValue{JsonValue: []byte(`[1]`)}},
+		}}}},
+		{Error: "wrong object type", Code: int32(codes.FailedPrecondition)},
+	}}, nil
+}
+
+func (f *fakeCache) Batch(stream cachepb.Cache_BatchServer) error {
+	for n := int64(1); ; n++ {
+		cmd, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		f.record(stream.Context(), cmd)
+		if err := stream.Send(&cachepb.Result{Result: &cachepb.Result_Incr{Incr: &cachepb.IncrResponse{Value: n}}}); err != nil {
+			return err
+		}
+	}
+}
+
+func (f *fakeCache) Subscribe(req *cachepb.SubscribeRequest, stream cachepb.Cache_SubscribeServer) error {
+	if len(req.Channels) == 0 {
+		return status.Error(codes.InvalidArgument, "no channels given")
+	}
+	stream.SendHeader(metadata.MD{})
+	stream.Send(&cachepb.Message{Channel: req.Channels[0], Payload: &cachepb.Value{Kind: &cachepb.Value_StringValue{StringValue: "hello"}}})
+	<-stream.Context().Done()
+
+	return nil
+}
+
+func newFakeGRPCClient(t *testing.T) (*fakeCache, *Client) {
+	l := bufconn.Listen(1024 * 1024)
+	f := &fakeCache{}
+	server := grpc.NewServer()
+	cachepb.RegisterCacheServer(server, f)
+	go server.Serve(l)
+	t.Cleanup(server.Stop)
+
+	c, err := NewGRPCClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return l.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+
+	return f, c
+}
+
+func TestGRPC_Values(t *testing.T) {
+	f, c := newFakeGRPCClient(t)
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		value interface{}
+		want  *cachepb.Value
+	}{
+		{"a", &cachepb.Value{Kind: &cachepb.Value_StringValue{StringValue: "a"}}},
+		{int64(1) << 62, &cachepb.Value{Kind: &cachepb.Value_NumberValue{NumberValue: "4611686018427387904"}}},
+		{1.5, &cachepb.Value{Kind: &cachepb.Value_NumberValue{NumberValue: "1.5"}}},
+		{true, &cachepb.Value{Kind: &cachepb.Value_BoolValue{BoolValue: true}}},
+		{nil, &cachepb.Value{Kind: &cachepb.Value_NullValue{NullValue: true}}},
+		{map[string]int{"a": 1}, &cachepb.Value{Kind: &cachepb.Value_JsonValue{JsonValue: []byte(`{"a":1}`)}}},
+		{json.RawMessage(`"b"`), &cachepb.Value{Kind: &cachepb.Value_StringValue{StringValue: "b"}}},
+	} {
+		if _, err := c.Set(ctx, &SetBody{Key: "k", Value: tc.value}); err != nil {
+			t.Fatal(err)
+		}
+		req, _ := f.last()
+		if value := req.(*cachepb.SetRequest).Value; !proto.Equal(value, tc.want) {
+			t.Error("Value is sent wrong", tc.value, value)
+		}
+	}
+
+	if v, err := c.Get(ctx, "big"); err != nil || v != json.Number("9007199254740993") {
+		t.Error("Number isn't returned exactly", v, err)
+	}
+	if v, err := c.Get(ctx, "object"); err != nil || !reflect.DeepEqual(v, json.RawMessage(`{"a":1}`)) {
+		t.Error("Object isn't returned as written", v, err)
+	}
+	if _, err := c.Get(ctx, "missing"); err == nil || err.Error() != "not found" {
+		t.Error("Error isn't returned like over HTTP", err)
+	}
+
+	if _, err := c.Hset(ctx, &SetBody{Key: "h", Value: map[string]interface{}{"f": 1, "g": "x"}}); err != nil {
+		t.Fatal(err)
+	}
+	req, _ := f.last()
+	if fields := req.(*cachepb.HsetRequest).Fields; fields["f"].GetNumberValue() != "1" || fields["g"].GetStringValue() != "x" {
+		t.Error("Fields are sent wrong", fields)
+	}
+}
+
+func TestGRPC_Errors(t *testing.T) {
+	_, c := newFakeGRPCClient(t)
+	ctx := context.Background()
+
+	if _, err := c.CompareAndSwap(ctx, &SetBody{Key: "k", Value: 1}, 3); err != ErrVersionMismatch {
+		t.Error("Aborted Cas isn't ErrVersionMismatch", err)
+	}
+	if _, _, err := c.BPop(ctx, time.Millisecond, "l"); err != ErrPopTimeout {
+		t.Error("Blocking pop doesn't time out", err)
+	}
+	if _, err := c.TxWatch(ctx, map[string]int64{"k": 1}, TxCommand{Op: "incr", Args: CounterBody{Key: "k"}}); err != ErrWatchConflict {
+		t.Error("Aborted transaction isn't ErrWatchConflict", err)
+	}
+	if _, err := c.Tx(ctx, TxCommand{Op: "unknown"}); err == nil {
+		t.Error("Unknown command is sent")
+	}
+}
+
+func TestGRPC_Tx(t *testing.T) {
+	f, c := newFakeGRPCClient(t)
+
+	results, err := c.Select(2).Tx(context.Background(),
+		TxCommand{Op: "rpush", Args: PushBody{Key: "l", Values: []interface{}{"a", 1}}},
+		TxCommand{Op: "lrange", Args: map[string]interface{}{"key": "l", "start": 0, "stop": -1}},
+		TxCommand{Op: "incr", Args: CounterBody{Key: "l"}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, db := f.last()
+	commands := req.(*cachepb.TxRequest).Commands
+	if db != "2" {
+		t.Error("Database isn't sent", db)
+	}
+	if rpush := commands[0].GetRpush(); rpush.GetKey() != "l" || len(rpush.GetValues()) != 2 || rpush.Values[1].GetNumberValue() != "1" {
+		t.Error("Rpush is sent wrong", commands[0])
+	}
+	if lrange := commands[1].GetLrange(); lrange.GetKey() != "l" || lrange.GetStart() != 0 || lrange.GetStop() != -1 {
+		t.Error("Lrange is sent wrong", commands[1])
+	}
+	if commands[2].GetIncr().GetKey() != "l" {
+		t.Error("Incr is sent wrong", commands[2])
+	}
+
+	want := []TxResult{
+		{Result: map[string]interface{}{"result": "success", "length": json.Number("2")}},
+		{Result: []interface{}{"a", []interface{}{json.Number("1")}}},
+		{Error: "wrong object type"},
+	}
+	if !reflect.DeepEqual(results, want) {
+		t.Error("Results aren't the response bodies", results)
+	}
+}
+
+func TestGRPC_Batch(t *testing.T) {
+	f, c := newFakeGRPCClient(t)
+
+	commands := make([]TxCommand, 50)
+	for i := range commands {
+		commands[i] = TxCommand{Op: "incr", Args: CounterBody{Key: "n"}}
+	}
+	results, err := c.Batch(context.Background(), commands...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 50 || results[49].Result.(map[string]interface{})["value"] != json.Number("50") {
+		t.Error("Results aren't in order", results)
+	}
+	if cmd, _ := f.last(); cmd.(*cachepb.Command).GetIncr().GetKey() != "n" {
+		t.Error("Command is sent wrong", cmd)
+	}
+
+	if _, err := NewClient("http://localhost").Batch(context.Background(), commands...); err != ErrBatchOverHTTP {
+		t.Error("Batch over HTTP doesn't fail", err)
+	}
+}
+
+func TestGRPC_Subscribe(t *testing.T) {
+	_, c := newFakeGRPCClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if _, err := c.PSubscribe(ctx, "news.*"); err == nil || err.Error() != "no channels given" {
+		t.Error("Failed subscription isn't reported", err)
+	}
+
+	messages, err := c.Subscribe(ctx, "news")
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case m := <-messages:
+		if m.Channel != "news" || m.Payload != "hello" {
+			t.Error("Message is wrong", m)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Message isn't received")
+	}
+}
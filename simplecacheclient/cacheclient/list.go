@@ -0,0 +1,330 @@
+package cacheclient
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/iqOptionTest/simplecache/cachepb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ErrPopTimeout is returned by BPop and BLPop when no element arrives in
+// time.
+var ErrPopTimeout = errors.New("timeout")
+
+type PushBody struct {
+	Key     string        `json:"key"`
+	Expired int           `json:"expired"`
+	Values  []interface{} `json:"values"`
+}
+
+type lsetBody struct {
+	Key   string      `json:"key"`
+	Index int         `json:"index"`
+	Value interface{} `json:"value"`
+}
+
+type lremBody struct {
+	Key   string      `json:"key"`
+	Count int         `json:"count"`
+	Value interface{} `json:"value"`
+}
+
+type ltrimBody struct {
+	Key   string `json:"key"`
+	Start int    `json:"start"`
+	Stop  int    `json:"stop"`
+}
+
+type linsertBody struct {
+	Key      string      `json:"key"`
+	Position string      `json:"position"`
+	Pivot    interface{} `json:"pivot"`
+	Value    interface{} `json:"value"`
+}
+
+// Lpush adds the values to the head of the list one by one and returns
+// the length of the list.
+func (c *Client) Lpush(ctx context.Context, body *PushBody) (int, error) {
+	if c.grpc != nil {
+		values, err := protoValues(body.Values)
+		if err != nil {
+			return 0, err
+		}
+		r, err := c.grpc.cache.Lpush(c.outgoing(ctx), &cachepb.LpushRequest{Key: body.Key, Values: values, Expired: int64(body.Expired)})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return int(r.Length), nil
+	}
+
+	return c.push(ctx, "lpush", body)
+}
+
+// RpushValues adds the values to the tail of the list and returns the
+// length of the list.
+func (c *Client) RpushValues(ctx context.Context, body *PushBody) (int, error) {
+	if c.grpc != nil {
+		values, err := protoValues(body.Values)
+		if err != nil {
+			return 0, err
+		}
+		r, err := c.grpc.cache.Rpush(c.outgoing(ctx), &cachepb.RpushRequest{Key: body.Key, Values: values, Expired: int64(body.Expired)})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return int(r.Length), nil
+	}
+
+	return c.push(ctx, "rpush", body)
+}
+
+func (c *Client) push(ctx context.Context, path string, body *PushBody) (int, error) {
+	b, _ := json.Marshal(body)
+	config := &apiConfig{
+		path: path,
+	}
+	var response struct {
+		Length int `json:"length"`
+	}
+	err := c.postJSON(ctx, config, b, &response)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Length, nil
+}
+
+func (c *Client) Lpop(ctx context.Context, key string) (interface{}, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Lpop(c.outgoing(ctx), &cachepb.LpopRequest{Key: key})
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return decodedValue(r.Value), nil
+	}
+
+	config := &apiConfig{
+		path: "lpop/" + key,
+	}
+	var response interface{}
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// BPop waits for an element at the tail of any of the lists for up to
+// timeout and returns the key it was popped from. A zero timeout waits
+// until ctx is done.
+func (c *Client) BPop(ctx context.Context, timeout time.Duration, keys ...string) (string, interface{}, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Brpop(c.outgoing(ctx), &cachepb.BrpopRequest{Keys: keys, Timeout: timeout.Seconds()})
+		if status.Code(err) == codes.NotFound {
+			return "", nil, ErrPopTimeout
+		}
+		if err != nil {
+			return "", nil, grpcError(err)
+		}
+		return r.Key, decodedValue(r.Value), nil
+	}
+
+	return c.bpop(ctx, "brpop", timeout, keys)
+}
+
+// BLPop is BPop that takes elements from the head of the lists.
+func (c *Client) BLPop(ctx context.Context, timeout time.Duration, keys ...string) (string, interface{}, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Blpop(c.outgoing(ctx), &cachepb.BlpopRequest{Keys: keys, Timeout: timeout.Seconds()})
+		if status.Code(err) == codes.NotFound {
+			return "", nil, ErrPopTimeout
+		}
+		if err != nil {
+			return "", nil, grpcError(err)
+		}
+		return r.Key, decodedValue(r.Value), nil
+	}
+
+	return c.bpop(ctx, "blpop", timeout, keys)
+}
+
+func (c *Client) bpop(ctx context.Context, path string, timeout time.Duration, keys []string) (string, interface{}, error) {
+	query := url.Values{"key": keys}
+	query.Set("timeout", strconv.FormatFloat(timeout.Seconds(), 'f', -1, 64))
+	config := &apiConfig{
+		path: path + "?" + query.Encode(),
+	}
+	var response struct {
+		Key   string      `json:"key"`
+		Value interface{} `json:"value"`
+	}
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		if err.Error() == ErrPopTimeout.Error() {
+			return "", nil, ErrPopTimeout
+		}
+		return "", nil, err
+	}
+
+	return response.Key, response.Value, nil
+}
+
+func (c *Client) Llen(ctx context.Context, key string) (int, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Llen(c.outgoing(ctx), &cachepb.LlenRequest{Key: key})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return int(r.Length), nil
+	}
+
+	config := &apiConfig{
+		path: "llen/" + key,
+	}
+	var response int
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response, nil
+}
+
+// Lrange returns the values from start to stop inclusive, negative indexes
+// count from the end.
+func (c *Client) Lrange(ctx context.Context, key string, start int, stop int) ([]interface{}, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Lrange(c.outgoing(ctx), &cachepb.LrangeRequest{Key: key, Start: int64(start), Stop: int64(stop)})
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return decodedValues(r.Values), nil
+	}
+
+	config := &apiConfig{
+		path: "lrange/" + key + "/" + strconv.Itoa(start) + "/" + strconv.Itoa(stop),
+	}
+	var response []interface{}
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (c *Client) Lset(ctx context.Context, key string, index int, value interface{}) error {
+	if c.grpc != nil {
+		pv, err := protoValue(value)
+		if err != nil {
+			return err
+		}
+		_, err = c.grpc.cache.Lset(c.outgoing(ctx), &cachepb.LsetRequest{Key: key, Index: int64(index), Value: pv})
+		return grpcError(err)
+	}
+
+	b, _ := json.Marshal(&lsetBody{Key: key, Index: index, Value: value})
+	config := &apiConfig{
+		path: "lset",
+	}
+	var response map[string]string
+
+	return c.postJSON(ctx, config, b, &response)
+}
+
+// Lrem removes values equal to value: count of them from the head when
+// count is positive, from the tail when it is negative, and all of them
+// when it is zero.
+func (c *Client) Lrem(ctx context.Context, key string, count int, value interface{}) (int, error) {
+	if c.grpc != nil {
+		pv, err := protoValue(value)
+		if err != nil {
+			return 0, err
+		}
+		r, err := c.grpc.cache.Lrem(c.outgoing(ctx), &cachepb.LremRequest{Key: key, Count: int64(count), Value: pv})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return int(r.Removed), nil
+	}
+
+	b, _ := json.Marshal(&lremBody{Key: key, Count: count, Value: value})
+	config := &apiConfig{
+		path: "lrem",
+	}
+	var response struct {
+		Removed int `json:"removed"`
+	}
+	err := c.postJSON(ctx, config, b, &response)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Removed, nil
+}
+
+func (c *Client) Ltrim(ctx context.Context, key string, start int, stop int) error {
+	if c.grpc != nil {
+		_, err := c.grpc.cache.Ltrim(c.outgoing(ctx), &cachepb.LtrimRequest{Key: key, Start: int64(start), Stop: int64(stop)})
+		return grpcError(err)
+	}
+
+	b, _ := json.Marshal(&ltrimBody{Key: key, Start: start, Stop: stop})
+	config := &apiConfig{
+		path: "ltrim",
+	}
+	var response map[string]string
+
+	return c.postJSON(ctx, config, b, &response)
+}
+
+// Linsert inserts value before or after pivot and returns the length of
+// the list, -1 when pivot isn't found.
+func (c *Client) Linsert(ctx context.Context, key string, before bool, pivot interface{}, value interface{}) (int, error) {
+	if c.grpc != nil {
+		pp, err := protoValue(pivot)
+		if err != nil {
+			return 0, err
+		}
+		pv, err := protoValue(value)
+		if err != nil {
+			return 0, err
+		}
+		r, err := c.grpc.cache.Linsert(c.outgoing(ctx), &cachepb.LinsertRequest{Key: key, Before: before, Pivot: pp, Value: pv})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return int(r.Length), nil
+	}
+
+	position := "after"
+	if before {
+		position = "before"
+	}
+	b, _ := json.Marshal(&linsertBody{Key: key, Position: position, Pivot: pivot, Value: value})
+	config := &apiConfig{
+		path: "linsert",
+	}
+	var response struct {
+		Length int `json:"length"`
+	}
+	err := c.postJSON(ctx, config, b, &response)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Length, nil
+}
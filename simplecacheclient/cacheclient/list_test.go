@@ -0,0 +1,52 @@
+package cacheclient
+
+import (
+	"encoding/json"
+	"golang.org/x/net/context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBPop(t *testing.T) {
+	var paths []string
+	var keys [][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		keys = append(keys, r.URL.Query()["key"])
+		switch r.URL.Query().Get("timeout") {
+		case "0.25":
+			json.NewEncoder(w).Encode(map[string]interface{}{"key": "b", "value": 5})
+		case "0.01":
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(map[string]string{"error": "timeout"})
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "timeout is not a positive number"})
+		}
+	}))
+	defer server.Close()
+	c := NewClient(server.URL + "/")
+	ctx := context.Background()
+
+	key, value, err := c.BPop(ctx, 250*time.Millisecond, "a", "b")
+	if err != nil || key != "b" || value != json.Number("5") {
+		t.Error("BPop doesn't return the popped element", key, value, err)
+	}
+	if paths[0] != "/brpop" || !reflect.DeepEqual(keys[0], []string{"a", "b"}) {
+		t.Error("BPop isn't sent to brpop with the keys", paths[0], keys[0])
+	}
+
+	if _, _, err := c.BLPop(ctx, 10*time.Millisecond, "a"); err != ErrPopTimeout {
+		t.Error("Timeout isn't ErrPopTimeout", err)
+	}
+	if paths[1] != "/blpop" {
+		t.Error("BLPop isn't sent to blpop", paths[1])
+	}
+
+	if _, _, err := c.BPop(ctx, -time.Second, "a"); err == nil || err.Error() != "timeout is not a positive number" {
+		t.Error("Error isn't returned", err)
+	}
+}
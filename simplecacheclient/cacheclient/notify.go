@@ -0,0 +1,57 @@
+package cacheclient
+
+import (
+	"encoding/json"
+	"github.com/iqOptionTest/simplecache/cachepb"
+	"golang.org/x/net/context"
+	"net/url"
+	"time"
+)
+
+// KeyspaceEvent tells that a key was changed. Op is the operation, like
+// set, del, rpush, pop, hset, expired or evicted, and Type is the type of
+// the item: string, list, dict, zset or set.
+type KeyspaceEvent struct {
+	Key       string    `json:"key"`
+	Op        string    `json:"op"`
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Notifications returns the keyspace events of the keys matching the glob
+// pattern, all keys if it's empty. Only the given events are sent, all of
+// them if none are given. Like Subscribe, the stream reconnects and events
+// meanwhile are lost. The channel is closed when ctx is done.
+func (c *Client) Notifications(ctx context.Context, pattern string, events ...string) (<-chan KeyspaceEvent, error) {
+	notifications := make(chan KeyspaceEvent, messageBuffer)
+	send := func(e KeyspaceEvent) bool {
+		select {
+		case notifications <- e:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	open := func(ctx context.Context) (func(), error) {
+		if c.grpc != nil {
+			return c.grpc.notifications(c.outgoing(ctx), &cachepb.NotificationsRequest{Pattern: pattern, Events: events}, send)
+		}
+
+		query := url.Values{"event": events}
+		if pattern != "" {
+			query.Set("pattern", pattern)
+		}
+		return c.openEvents(ctx, "notifications?"+query.Encode(), func(data []byte) bool {
+			var e KeyspaceEvent
+			if json.Unmarshal(data, &e) != nil {
+				return true
+			}
+			return send(e)
+		})
+	}
+	if err := stream(ctx, open, func() { close(notifications) }); err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
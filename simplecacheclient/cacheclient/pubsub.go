@@ -0,0 +1,201 @@
+package cacheclient
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"github.com/iqOptionTest/simplecache/cachepb"
+	"golang.org/x/net/context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Message is a message published to a channel. Pattern is set when it was
+// received by a pattern subscription.
+type Message struct {
+	Channel string      `json:"channel"`
+	Pattern string      `json:"pattern"`
+	Payload interface{} `json:"payload"`
+}
+
+const (
+	messageBuffer       = 256
+	minReconnectBackoff = 100 * time.Millisecond
+	maxReconnectBackoff = 5 * time.Second
+)
+
+// Publish sends the payload to the subscribers of the channel and returns
+// how many of them got it.
+func (c *Client) Publish(ctx context.Context, channel string, payload interface{}) (int, error) {
+	if c.grpc != nil {
+		message, err := protoValue(payload)
+		if err != nil {
+			return 0, err
+		}
+		r, err := c.grpc.cache.Publish(c.outgoing(ctx), &cachepb.PublishRequest{Channel: channel, Message: message})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return int(r.Receivers), nil
+	}
+
+	b, _ := json.Marshal(map[string]interface{}{"channel": channel, "message": payload})
+	config := &apiConfig{
+		path: "publish",
+	}
+	var response struct {
+		Receivers int `json:"receivers"`
+	}
+	err := c.postJSON(ctx, config, b, &response)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Receivers, nil
+}
+
+// Subscribe returns the messages published to the channels. The stream
+// reconnects after network errors or when the server drops it for falling
+// behind, messages published meanwhile are lost. The channel is closed
+// when ctx is done.
+func (c *Client) Subscribe(ctx context.Context, channels ...string) (<-chan Message, error) {
+	return c.subscribe(ctx, channels, nil)
+}
+
+// PSubscribe is Subscribe to the channels matching glob patterns.
+func (c *Client) PSubscribe(ctx context.Context, patterns ...string) (<-chan Message, error) {
+	return c.subscribe(ctx, nil, patterns)
+}
+
+func (c *Client) subscribe(ctx context.Context, channels []string, patterns []string) (<-chan Message, error) {
+	messages := make(chan Message, messageBuffer)
+	send := func(m Message) bool {
+		select {
+		case messages <- m:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	open := func(ctx context.Context) (func(), error) {
+		if c.grpc != nil {
+			return c.grpc.subscribe(c.outgoing(ctx), &cachepb.SubscribeRequest{Channels: channels, Patterns: patterns}, send)
+		}
+
+		query := url.Values{"channel": channels, "pattern": patterns}
+		return c.openEvents(ctx, "subscribe?"+query.Encode(), func(data []byte) bool {
+			var m Message
+			if json.Unmarshal(data, &m) != nil {
+				return true
+			}
+			return send(m)
+		})
+	}
+	if err := stream(ctx, open, func() { close(messages) }); err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+// stream calls the function returned by open, which reads a stream, until
+// ctx is done. It opens the stream again with a backoff when it ends, and
+// then calls done.
+func stream(ctx context.Context, open func(ctx context.Context) (func(), error), done func()) error {
+	// The first connection is made here, so a bad request is reported to
+	// the caller instead of being retried forever.
+	read, err := open(ctx)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		defer done()
+
+		backoff := minReconnectBackoff
+		for {
+			if read != nil {
+				read()
+				backoff = minReconnectBackoff
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			if backoff *= 2; backoff > maxReconnectBackoff {
+				backoff = maxReconnectBackoff
+			}
+
+			read, _ = open(ctx)
+		}
+	}()
+
+	return nil
+}
+
+// openEvents opens the server-sent events at path and returns the
+// function passing their data to send.
+func (c *Client) openEvents(ctx context.Context, path string, send func([]byte) bool) (func(), error) {
+	resp, err := c.openStream(ctx, &apiConfig{path: path})
+	if err != nil {
+		return nil, err
+	}
+
+	return func() {
+		defer resp.Body.Close()
+		readEvents(resp, send)
+	}, nil
+}
+
+func (c *Client) openStream(ctx context.Context, config *apiConfig) (*http.Response, error) {
+	resp, err := c.get(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		respErr := map[string]string{}
+		if err := json.NewDecoder(resp.Body).Decode(&respErr); err != nil {
+			return nil, err
+		}
+		return nil, errors.New(respErr["error"])
+	}
+
+	return resp, nil
+}
+
+// readEvents passes the data of the server-sent events of the stream to
+// send until the stream ends or send returns false.
+func readEvents(resp *http.Response, send func([]byte) bool) {
+	reader := bufio.NewReader(resp.Body)
+	var event, data string
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			data += strings.TrimPrefix(line, "data: ")
+		case line == "":
+			if event == "error" {
+				return
+			}
+
+			if data != "" && !send([]byte(data)) {
+				return
+			}
+			event, data = "", ""
+		}
+	}
+}
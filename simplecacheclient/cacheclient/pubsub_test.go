@@ -0,0 +1,106 @@
+package cacheclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"golang.org/x/net/context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// eventsServer drops the first subscription with an error event after one
+// message, like a server disconnecting a slow consumer, and keeps the
+// next ones open.
+func eventsServer(t *testing.T) (*httptest.Server, *int32) {
+	var connections int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/subscribe" || r.URL.Query().Get("channel") == "" && r.URL.Query().Get("pattern") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "no channels given"})
+			return
+		}
+
+		n := atomic.AddInt32(&connections, 1)
+		w.Header().Set("Content-Type", "text/event-stream")
+		b, _ := json.Marshal(Message{Channel: "news", Pattern: r.URL.Query().Get("pattern"), Payload: fmt.Sprint("message ", n)})
+		fmt.Fprintf(w, "data: %s\n\n", b)
+		if n == 1 {
+			fmt.Fprint(w, "event: error\ndata: {\"error\":\"slow consumer\"}\n\n")
+			return
+		}
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &connections
+}
+
+func receive(t *testing.T, messages <-chan Message) Message {
+	t.Helper()
+	select {
+	case m, ok := <-messages:
+		if !ok {
+			t.Fatal("Messages are closed")
+		}
+		return m
+	case <-time.After(time.Second):
+		t.Fatal("Message isn't received")
+	}
+
+	return Message{}
+}
+
+func TestSubscribe_Reconnect(t *testing.T) {
+	server, connections := eventsServer(t)
+	c := NewClient(server.URL + "/")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	messages, err := c.Subscribe(ctx, "news")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m := receive(t, messages); m.Channel != "news" || m.Payload != "message 1" {
+		t.Error("Message is wrong", m)
+	}
+	// The dropped stream is opened again on the same channel.
+	if m := receive(t, messages); m.Payload != "message 2" {
+		t.Error("Stream isn't reconnected", m)
+	}
+	if n := atomic.LoadInt32(connections); n != 2 {
+		t.Error("Stream is opened a wrong number of times", n)
+	}
+
+	cancel()
+	select {
+	case _, ok := <-messages:
+		if ok {
+			t.Error("Message is received after cancel")
+		}
+	case <-time.After(time.Second):
+		t.Error("Messages aren't closed when ctx is done")
+	}
+}
+
+func TestPSubscribe(t *testing.T) {
+	server, _ := eventsServer(t)
+	c := NewClient(server.URL + "/")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if _, err := c.PSubscribe(ctx); err == nil || err.Error() != "no channels given" {
+		t.Error("Failed subscription isn't reported", err)
+	}
+
+	messages, err := c.PSubscribe(ctx, "news.*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m := receive(t, messages); m.Pattern != "news.*" {
+		t.Error("Pattern isn't sent", m)
+	}
+}
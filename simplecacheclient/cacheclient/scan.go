@@ -0,0 +1,84 @@
+package cacheclient
+
+import (
+	"github.com/iqOptionTest/simplecache/cachepb"
+	"golang.org/x/net/context"
+	"net/url"
+	"strconv"
+)
+
+// ScanDone is the cursor that starts an iteration and is returned by Scan
+// when it is finished.
+const ScanDone = "0"
+
+// ScanOptions filter the keys returned by Scan. Count is a hint of how many
+// keys are examined in one call. Match is a glob pattern and Type is one of
+// string, list, dict, set or zset. Zero fields aren't applied.
+type ScanOptions struct {
+	Count int
+	Match string
+	Type  string
+}
+
+// KeysMatching returns all keys matching a glob pattern at once.
+func (c *Client) KeysMatching(ctx context.Context, pattern string) ([]string, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Keys(c.outgoing(ctx), &cachepb.KeysRequest{Pattern: pattern})
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return r.Keys, nil
+	}
+
+	config := &apiConfig{
+		path: "keys?" + url.Values{"pattern": {pattern}}.Encode(),
+	}
+	var response []string
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// Scan returns the next batch of keys after the cursor and the cursor to
+// continue from. Every key that exists for the whole iteration is returned
+// at least once.
+func (c *Client) Scan(ctx context.Context, cursor string, options ScanOptions) (string, []string, error) {
+	if c.grpc != nil {
+		req := &cachepb.ScanRequest{Cursor: cursor, Count: int64(options.Count), Match: options.Match, Type: options.Type}
+		r, err := c.grpc.cache.Scan(c.outgoing(ctx), req)
+		if err != nil {
+			return "", nil, grpcError(err)
+		}
+		return r.Cursor, r.Keys, nil
+	}
+
+	query := url.Values{"cursor": {cursor}}
+	if options.Count > 0 {
+		query.Set("count", strconv.Itoa(options.Count))
+	}
+	if options.Match != "" {
+		query.Set("match", options.Match)
+	}
+	if options.Type != "" {
+		query.Set("type", options.Type)
+	}
+
+	config := &apiConfig{
+		path: "scan?" + query.Encode(),
+	}
+	var response struct {
+		Cursor string   `json:"cursor"`
+		Keys   []string `json:"keys"`
+	}
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	return response.Cursor, response.Keys, nil
+}
@@ -0,0 +1,271 @@
+package cacheclient
+
+import (
+	"encoding/json"
+	"github.com/iqOptionTest/simplecache/cachepb"
+	"golang.org/x/net/context"
+	"net/url"
+	"strconv"
+)
+
+type SAddBody struct {
+	Key     string   `json:"key"`
+	Expired int      `json:"expired"`
+	Value   []string `json:"value"`
+}
+
+type sremBody struct {
+	Key   string   `json:"key"`
+	Value []string `json:"value"`
+}
+
+type setStoreBody struct {
+	Destination string   `json:"destination"`
+	Keys        []string `json:"keys"`
+}
+
+// Sadd returns the number of members that weren't in the set.
+func (c *Client) Sadd(ctx context.Context, body *SAddBody) (int, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Sadd(c.outgoing(ctx), &cachepb.SaddRequest{Key: body.Key, Members: body.Value, Expired: int64(body.Expired)})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return int(r.Added), nil
+	}
+
+	b, _ := json.Marshal(body)
+	config := &apiConfig{
+		path: "sadd",
+	}
+	var response struct {
+		Added int `json:"added"`
+	}
+	err := c.postJSON(ctx, config, b, &response)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Added, nil
+}
+
+func (c *Client) Srem(ctx context.Context, key string, members ...string) (int, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Srem(c.outgoing(ctx), &cachepb.SremRequest{Key: key, Members: members})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return int(r.Removed), nil
+	}
+
+	b, _ := json.Marshal(&sremBody{Key: key, Value: members})
+	config := &apiConfig{
+		path: "srem",
+	}
+	var response struct {
+		Removed int `json:"removed"`
+	}
+	err := c.postJSON(ctx, config, b, &response)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Removed, nil
+}
+
+func (c *Client) Sismember(ctx context.Context, key string, member string) (bool, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Sismember(c.outgoing(ctx), &cachepb.SismemberRequest{Key: key, Member: member})
+		if err != nil {
+			return false, grpcError(err)
+		}
+		return r.Member, nil
+	}
+
+	config := &apiConfig{
+		path: "sismember/" + key + "/" + member,
+	}
+	var response bool
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		return false, err
+	}
+
+	return response, nil
+}
+
+func (c *Client) Smembers(ctx context.Context, key string) ([]string, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Smembers(c.outgoing(ctx), &cachepb.SmembersRequest{Key: key})
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return r.Members, nil
+	}
+
+	config := &apiConfig{
+		path: "smembers/" + key,
+	}
+	var response []string
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (c *Client) Scard(ctx context.Context, key string) (int, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Scard(c.outgoing(ctx), &cachepb.ScardRequest{Key: key})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return int(r.Count), nil
+	}
+
+	config := &apiConfig{
+		path: "scard/" + key,
+	}
+	var response int
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response, nil
+}
+
+func (c *Client) Spop(ctx context.Context, key string, count int) ([]string, error) {
+	if c.grpc != nil {
+		n := int64(count)
+		r, err := c.grpc.cache.Spop(c.outgoing(ctx), &cachepb.SpopRequest{Key: key, Count: &n})
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return r.Members, nil
+	}
+
+	config := &apiConfig{
+		path: "spop/" + key + "?count=" + strconv.Itoa(count),
+	}
+	var response []string
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (c *Client) Sinter(ctx context.Context, keys ...string) ([]string, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Sinter(c.outgoing(ctx), &cachepb.SinterRequest{Keys: keys})
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return r.Members, nil
+	}
+
+	return c.setOperation(ctx, "sinter", keys)
+}
+
+func (c *Client) Sunion(ctx context.Context, keys ...string) ([]string, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Sunion(c.outgoing(ctx), &cachepb.SunionRequest{Keys: keys})
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return r.Members, nil
+	}
+
+	return c.setOperation(ctx, "sunion", keys)
+}
+
+func (c *Client) Sdiff(ctx context.Context, keys ...string) ([]string, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Sdiff(c.outgoing(ctx), &cachepb.SdiffRequest{Keys: keys})
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return r.Members, nil
+	}
+
+	return c.setOperation(ctx, "sdiff", keys)
+}
+
+func (c *Client) setOperation(ctx context.Context, path string, keys []string) ([]string, error) {
+	query := url.Values{"key": keys}
+	config := &apiConfig{
+		path: path + "?" + query.Encode(),
+	}
+	var response []string
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// SinterStore replaces destination with the intersection of the sets and
+// returns its size.
+func (c *Client) SinterStore(ctx context.Context, destination string, keys ...string) (int, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.SinterStore(c.outgoing(ctx), &cachepb.SinterStoreRequest{Destination: destination, Keys: keys})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return int(r.Count), nil
+	}
+
+	return c.setOperationStore(ctx, "sinterstore", destination, keys)
+}
+
+func (c *Client) SunionStore(ctx context.Context, destination string, keys ...string) (int, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.SunionStore(c.outgoing(ctx), &cachepb.SunionStoreRequest{Destination: destination, Keys: keys})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return int(r.Count), nil
+	}
+
+	return c.setOperationStore(ctx, "sunionstore", destination, keys)
+}
+
+func (c *Client) SdiffStore(ctx context.Context, destination string, keys ...string) (int, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.SdiffStore(c.outgoing(ctx), &cachepb.SdiffStoreRequest{Destination: destination, Keys: keys})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return int(r.Count), nil
+	}
+
+	return c.setOperationStore(ctx, "sdiffstore", destination, keys)
+}
+
+func (c *Client) setOperationStore(ctx context.Context, path string, destination string, keys []string) (int, error) {
+	b, _ := json.Marshal(&setStoreBody{Destination: destination, Keys: keys})
+	config := &apiConfig{
+		path: path,
+	}
+	var response struct {
+		Count int `json:"count"`
+	}
+	err := c.postJSON(ctx, config, b, &response)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Count, nil
+}
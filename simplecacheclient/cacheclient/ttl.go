@@ -0,0 +1,130 @@
+package cacheclient
+
+import (
+	"encoding/json"
+	"github.com/iqOptionTest/simplecache/cachepb"
+	"golang.org/x/net/context"
+	"time"
+)
+
+// NoExpiry is returned by TTL and PTTL for a key that never expires.
+const NoExpiry time.Duration = -1
+
+type expireBody struct {
+	Key          string `json:"key"`
+	Milliseconds int64  `json:"milliseconds"`
+}
+
+type expireAtBody struct {
+	Key         string `json:"key"`
+	TimestampMs int64  `json:"timestamp_ms"`
+}
+
+// Expire sets the TTL of a key of any type. A non-positive ttl deletes the
+// key.
+func (c *Client) Expire(ctx context.Context, key string, ttl time.Duration) error {
+	if c.grpc != nil {
+		_, err := c.grpc.cache.Expire(c.outgoing(ctx), &cachepb.ExpireRequest{Key: key, Milliseconds: ttl.Milliseconds()})
+		return grpcError(err)
+	}
+
+	b, _ := json.Marshal(&expireBody{Key: key, Milliseconds: ttl.Milliseconds()})
+	config := &apiConfig{
+		path: "expire",
+	}
+	var response map[string]string
+
+	return c.postJSON(ctx, config, b, &response)
+}
+
+// ExpireAt sets the expiry of a key of any type. A time in the past deletes
+// the key.
+func (c *Client) ExpireAt(ctx context.Context, key string, at time.Time) error {
+	if c.grpc != nil {
+		_, err := c.grpc.cache.ExpireAt(c.outgoing(ctx), &cachepb.ExpireAtRequest{Key: key, TimestampMs: at.UnixMilli()})
+		return grpcError(err)
+	}
+
+	b, _ := json.Marshal(&expireAtBody{Key: key, TimestampMs: at.UnixMilli()})
+	config := &apiConfig{
+		path: "expireat",
+	}
+	var response map[string]string
+
+	return c.postJSON(ctx, config, b, &response)
+}
+
+// Persist removes the expiry of a key and reports whether it had one.
+func (c *Client) Persist(ctx context.Context, key string) (bool, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Persist(c.outgoing(ctx), &cachepb.PersistRequest{Key: key})
+		if err != nil {
+			return false, grpcError(err)
+		}
+		return r.Persisted, nil
+	}
+
+	config := &apiConfig{
+		path: "persist/" + key,
+	}
+	var response struct {
+		Persisted bool `json:"persisted"`
+	}
+	err := c.postJSON(ctx, config, nil, &response)
+
+	if err != nil {
+		return false, err
+	}
+
+	return response.Persisted, nil
+}
+
+// TTL returns the time left until the key expires rounded to seconds, or
+// NoExpiry.
+func (c *Client) TTL(ctx context.Context, key string) (time.Duration, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Ttl(c.outgoing(ctx), &cachepb.TtlRequest{Key: key})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return ttlDuration(r.Ttl, time.Second), nil
+	}
+
+	return c.ttl(ctx, "ttl/"+key, time.Second)
+}
+
+// PTTL returns the time left until the key expires rounded to milliseconds,
+// or NoExpiry.
+func (c *Client) PTTL(ctx context.Context, key string) (time.Duration, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Pttl(c.outgoing(ctx), &cachepb.PttlRequest{Key: key})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return ttlDuration(r.Ttl, time.Millisecond), nil
+	}
+
+	return c.ttl(ctx, "pttl/"+key, time.Millisecond)
+}
+
+func (c *Client) ttl(ctx context.Context, path string, unit time.Duration) (time.Duration, error) {
+	config := &apiConfig{
+		path: path,
+	}
+	var response int64
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return ttlDuration(response, unit), nil
+}
+
+func ttlDuration(ttl int64, unit time.Duration) time.Duration {
+	if ttl < 0 {
+		return NoExpiry
+	}
+
+	return time.Duration(ttl) * unit
+}
@@ -0,0 +1,174 @@
+package cacheclient
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/iqOptionTest/simplecache/cachepb"
+	"golang.org/x/net/context"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ErrWatchConflict is returned by TxWatch when a watched key was changed
+// after its version was read.
+var ErrWatchConflict = errors.New("watched key changed")
+
+// ErrBatchOverHTTP is returned by Batch of an HTTP client.
+var ErrBatchOverHTTP = errors.New("batches are served over gRPC only")
+
+// minWatchBackoff and maxWatchBackoff bound the wait of Watch after a
+// conflict.
+const (
+	minWatchBackoff = time.Millisecond
+	maxWatchBackoff = 100 * time.Millisecond
+)
+
+// TxCommand is one command of a transaction or a batch. Op is the name of
+// the endpoint and Args is its request body, or its path and query
+// parameters by name for endpoints without a body, e.g.
+// TxCommand{Op: "lrange", Args: map[string]interface{}{"key": "l", "start": 0, "stop": -1}}.
+type TxCommand struct {
+	Op   string      `json:"op"`
+	Args interface{} `json:"args"`
+}
+
+// TxResult is the response body of the command's endpoint, or its error.
+type TxResult struct {
+	Result interface{} `json:"result"`
+	Error  string      `json:"error"`
+}
+
+// Tx runs the commands atomically and returns their results in order. When
+// a command is malformed or works on a key of the wrong type nothing is
+// applied and an error is returned.
+func (c *Client) Tx(ctx context.Context, commands ...TxCommand) ([]TxResult, error) {
+	if c.grpc != nil {
+		return c.grpc.tx(c.outgoing(ctx), nil, commands)
+	}
+
+	b, _ := json.Marshal(commands)
+	config := &apiConfig{
+		path: "tx",
+	}
+	var response []TxResult
+	err := c.postJSON(ctx, config, b, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// Batch sends the commands on one stream and returns their results in
+// order. Unlike Tx, the commands aren't atomic: each of them is applied on
+// its own and a failed one doesn't stop the others.
+func (c *Client) Batch(ctx context.Context, commands ...TxCommand) ([]TxResult, error) {
+	if c.grpc == nil {
+		return nil, ErrBatchOverHTTP
+	}
+
+	return c.grpc.batch(c.outgoing(ctx), commands)
+}
+
+// Versions returns the versions of the keys read at the same moment, 0 for
+// missing keys.
+func (c *Client) Versions(ctx context.Context, keys ...string) (map[string]int64, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Versions(c.outgoing(ctx), &cachepb.VersionsRequest{Keys: keys})
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return r.Versions, nil
+	}
+
+	config := &apiConfig{
+		path: "versions?" + url.Values{"key": keys}.Encode(),
+	}
+	var response map[string]int64
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// TxWatch is Tx that only applies when the watched keys still have the
+// versions returned by Versions. Otherwise it returns ErrWatchConflict.
+func (c *Client) TxWatch(ctx context.Context, watch map[string]int64, commands ...TxCommand) ([]TxResult, error) {
+	if c.grpc != nil {
+		return c.grpc.tx(c.outgoing(ctx), watch, commands)
+	}
+
+	b, _ := json.Marshal(map[string]interface{}{"watch": watch, "commands": commands})
+	config := &apiConfig{
+		path: "tx",
+	}
+	httpResp, err := c.post(ctx, config, b)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	switch httpResp.StatusCode {
+	case http.StatusOK:
+	case http.StatusConflict:
+		return nil, ErrWatchConflict
+	default:
+		return nil, responseError(httpResp)
+	}
+
+	var response []TxResult
+	if err := decodeJSON(httpResp.Body, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// Watch runs a read-modify-write cycle over keys. It reads their versions,
+// calls fn to read the current values and build the commands, and submits
+// them with TxWatch. On a conflict it starts over after a random backoff,
+// which grows up to maxWatchBackoff, until the commands apply or ctx is
+// done, e.g.
+//
+//	c.Watch(ctx, []string{"stock"}, func(ctx context.Context) ([]TxCommand, error) {
+//		stock, err := c.Get(ctx, "stock")
+//		if err != nil {
+//			return nil, err
+//		}
+//		return []TxCommand{{Op: "set", Args: SetBody{Key: "stock", Value: update(stock)}}}, nil
+//	})
+func (c *Client) Watch(ctx context.Context, keys []string, fn func(ctx context.Context) ([]TxCommand, error)) ([]TxResult, error) {
+	backoff := minWatchBackoff
+	for {
+		versions, err := c.Versions(ctx, keys...)
+		if err != nil {
+			return nil, err
+		}
+
+		commands, err := fn(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		results, err := c.TxWatch(ctx, versions, commands...)
+		if err != ErrWatchConflict {
+			return results, err
+		}
+
+		// The jitter spreads the clients that conflicted at once.
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))):
+		}
+		if backoff *= 2; backoff > maxWatchBackoff {
+			backoff = maxWatchBackoff
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package cacheclient
+
+import (
+	"encoding/json"
+	"golang.org/x/net/context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// conflictServer answers /tx with 409 for the first conflicts requests.
+func conflictServer(t *testing.T, conflicts int32) (*httptest.Server, *int32) {
+	var attempts int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/versions", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]int64{"stock": 1})
+	})
+	mux.HandleFunc("/tx", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) <= conflicts {
+			w.WriteHeader(http.StatusConflict)
+			json.NewEncoder(w).Encode(map[string]string{"error": "some other message"})
+			return
+		}
+		json.NewEncoder(w).Encode([]TxResult{{Result: map[string]interface{}{"result": "success"}}})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server, &attempts
+}
+
+func TestTxWatch_Conflict(t *testing.T) {
+	server, _ := conflictServer(t, 1)
+	c := NewClient(server.URL + "/")
+
+	// The conflict is told by the status, not by the message.
+	_, err := c.TxWatch(context.Background(), map[string]int64{"stock": 1}, TxCommand{Op: "incr", Args: CounterBody{Key: "stock"}})
+	if err != ErrWatchConflict {
+		t.Error("409 isn't ErrWatchConflict", err)
+	}
+	if results, err := c.TxWatch(context.Background(), map[string]int64{"stock": 1}); err != nil || len(results) != 1 {
+		t.Error("Transaction without a conflict fails", results, err)
+	}
+}
+
+func TestWatch_Backoff(t *testing.T) {
+	server, attempts := conflictServer(t, 3)
+	c := NewClient(server.URL + "/")
+
+	start := time.Now()
+	results, err := c.Watch(context.Background(), []string{"stock"}, func(ctx context.Context) ([]TxCommand, error) {
+		return []TxCommand{{Op: "incr", Args: CounterBody{Key: "stock"}}}, nil
+	})
+	if err != nil || len(results) != 1 {
+		t.Fatal("Watch doesn't apply after conflicts", results, err)
+	}
+	if n := atomic.LoadInt32(attempts); n != 4 {
+		t.Error("Watch doesn't retry every conflict", n)
+	}
+	// The waits are at least half of 1, 2 and 4ms.
+	if elapsed := time.Since(start); elapsed < 3500*time.Microsecond {
+		t.Error("Watch retries without a backoff", elapsed)
+	}
+
+	server, _ = conflictServer(t, 1<<30)
+	c = NewClient(server.URL + "/")
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := c.Watch(ctx, []string{"stock"}, func(ctx context.Context) ([]TxCommand, error) { return nil, nil }); err != context.DeadlineExceeded {
+		t.Error("Watch doesn't stop when ctx is done", err)
+	}
+}
@@ -0,0 +1,69 @@
+package cacheclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+)
+
+// decodeJSON decodes a response body into v. Numbers of untyped values are
+// decoded as json.Number, so large integers keep their precision.
+func decodeJSON(r io.Reader, v interface{}) error {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+
+	return decoder.Decode(v)
+}
+
+// jsonValue converts a stored value read as raw JSON the way the server
+// stores it: strings are returned as strings, numbers as json.Number and
+// objects and arrays as json.RawMessage with the bytes that were written.
+func jsonValue(raw json.RawMessage) interface{} {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return nil
+	}
+
+	switch raw[0] {
+	case '"':
+		var s string
+		json.Unmarshal(raw, &s)
+		return s
+	case '{', '[':
+		return raw
+	case 't':
+		return true
+	case 'f':
+		return false
+	case 'n':
+		return nil
+	}
+
+	return json.Number(raw)
+}
+
+func jsonValues(raw []json.RawMessage) []interface{} {
+	if raw == nil {
+		return nil
+	}
+
+	values := make([]interface{}, len(raw))
+	for i, v := range raw {
+		values[i] = jsonValue(v)
+	}
+
+	return values
+}
+
+func jsonFields(raw map[string]json.RawMessage) map[string]interface{} {
+	if raw == nil {
+		return nil
+	}
+
+	fields := make(map[string]interface{}, len(raw))
+	for k, v := range raw {
+		fields[k] = jsonValue(v)
+	}
+
+	return fields
+}
@@ -0,0 +1,185 @@
+package cacheclient
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/iqOptionTest/simplecache/cachepb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrVersionMismatch is returned by CompareAndSwap when the key was changed
+// since its version was read.
+var ErrVersionMismatch = errors.New("version mismatch")
+
+type setCondBody struct {
+	SetBody
+	NX bool `json:"nx"`
+	XX bool `json:"xx"`
+}
+
+type casBody struct {
+	SetBody
+	Version int64 `json:"version"`
+}
+
+// SetNX sets the value only when the key doesn't exist. It reports whether
+// the value was set.
+func (c *Client) SetNX(ctx context.Context, body *SetBody) (bool, error) {
+	return c.setCond(ctx, &setCondBody{SetBody: *body, NX: true})
+}
+
+// SetXX sets the value only when the key already exists.
+func (c *Client) SetXX(ctx context.Context, body *SetBody) (bool, error) {
+	return c.setCond(ctx, &setCondBody{SetBody: *body, XX: true})
+}
+
+func (c *Client) setCond(ctx context.Context, body *setCondBody) (bool, error) {
+	if c.grpc != nil {
+		value, err := protoValue(body.Value)
+		if err != nil {
+			return false, err
+		}
+		req := &cachepb.SetRequest{Key: body.Key, Value: value, Expired: int64(body.Expired), Nx: body.NX, Xx: body.XX}
+		r, err := c.grpc.cache.Set(c.outgoing(ctx), req)
+		if err != nil {
+			return false, grpcError(err)
+		}
+		return r.Set, nil
+	}
+
+	b, _ := json.Marshal(body)
+	config := &apiConfig{
+		path: "set",
+	}
+	var response struct {
+		Set bool `json:"set"`
+	}
+	err := c.postJSON(ctx, config, b, &response)
+
+	if err != nil {
+		return false, err
+	}
+
+	return response.Set, nil
+}
+
+// Gets returns the value with its version for CompareAndSwap.
+func (c *Client) Gets(ctx context.Context, key string) (interface{}, int64, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Gets(c.outgoing(ctx), &cachepb.GetsRequest{Key: key})
+		if err != nil {
+			return nil, 0, grpcError(err)
+		}
+		return goValue(r.Value), r.Version, nil
+	}
+
+	config := &apiConfig{
+		path: "gets/" + key,
+	}
+	var response struct {
+		Value   json.RawMessage `json:"value"`
+		Version int64           `json:"version"`
+	}
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return jsonValue(response.Value), response.Version, nil
+}
+
+// Version returns the version of a key of any type.
+func (c *Client) Version(ctx context.Context, key string) (int64, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Version(c.outgoing(ctx), &cachepb.VersionRequest{Key: key})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return r.Version, nil
+	}
+
+	config := &apiConfig{
+		path: "version/" + key,
+	}
+	var response int64
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response, nil
+}
+
+// CompareAndSwap sets the value only when the key still has the given
+// version and returns the new one. A zero version expects that the key
+// doesn't exist.
+func (c *Client) CompareAndSwap(ctx context.Context, body *SetBody, version int64) (int64, error) {
+	if c.grpc != nil {
+		value, err := protoValue(body.Value)
+		if err != nil {
+			return 0, err
+		}
+		req := &cachepb.CasRequest{Key: body.Key, Value: value, Expired: int64(body.Expired), Version: version}
+		r, err := c.grpc.cache.Cas(c.outgoing(ctx), req)
+		if status.Code(err) == codes.Aborted {
+			return 0, ErrVersionMismatch
+		}
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return r.Version, nil
+	}
+
+	b, _ := json.Marshal(&casBody{SetBody: *body, Version: version})
+	config := &apiConfig{
+		path: "cas",
+	}
+	var response struct {
+		Version int64 `json:"version"`
+	}
+	err := c.postJSON(ctx, config, b, &response)
+
+	if err != nil {
+		if err.Error() == ErrVersionMismatch.Error() {
+			return 0, ErrVersionMismatch
+		}
+		return 0, err
+	}
+
+	return response.Version, nil
+}
+
+// GetSet sets the value and returns the old one, nil when there was no such
+// key.
+func (c *Client) GetSet(ctx context.Context, body *SetBody) (interface{}, error) {
+	if c.grpc != nil {
+		value, err := protoValue(body.Value)
+		if err != nil {
+			return nil, err
+		}
+		r, err := c.grpc.cache.GetSet(c.outgoing(ctx), &cachepb.GetSetRequest{Key: body.Key, Value: value, Expired: int64(body.Expired)})
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return goValue(r.Value), nil
+	}
+
+	b, _ := json.Marshal(body)
+	config := &apiConfig{
+		path: "getset",
+	}
+	var response struct {
+		Value json.RawMessage `json:"value"`
+	}
+	err := c.postJSON(ctx, config, b, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonValue(response.Value), nil
+}
@@ -0,0 +1,271 @@
+package cacheclient
+
+import (
+	"encoding/json"
+	"github.com/iqOptionTest/simplecache/cachepb"
+	"golang.org/x/net/context"
+	"net/url"
+	"strconv"
+)
+
+type ZMember struct {
+	Member string  `json:"member"`
+	Score  float64 `json:"score"`
+}
+
+type ZAddBody struct {
+	Key     string    `json:"key"`
+	Expired int       `json:"expired"`
+	Value   []ZMember `json:"value"`
+	NX      bool      `json:"nx"`
+	XX      bool      `json:"xx"`
+	Incr    bool      `json:"incr"`
+}
+
+type zremBody struct {
+	Key   string   `json:"key"`
+	Value []string `json:"value"`
+}
+
+// Zadd returns the number of added members in "added", or the new score
+// in "score" when body.Incr is set.
+func (c *Client) Zadd(ctx context.Context, body *ZAddBody) (map[string]interface{}, error) {
+	if c.grpc != nil {
+		req := &cachepb.ZaddRequest{Key: body.Key, Expired: int64(body.Expired), Nx: body.NX, Xx: body.XX, Incr: body.Incr}
+		for _, zm := range body.Value {
+			req.Members = append(req.Members, &cachepb.ZMember{Member: zm.Member, Score: zm.Score})
+		}
+		r, err := c.grpc.cache.Zadd(c.outgoing(ctx), req)
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		if !body.Incr {
+			return map[string]interface{}{"result": "success", "added": json.Number(strconv.FormatInt(r.Added, 10))}, nil
+		}
+		var score interface{}
+		if r.Score != nil {
+			score = json.Number(strconv.FormatFloat(*r.Score, 'g', -1, 64))
+		}
+		return map[string]interface{}{"result": "success", "score": score}, nil
+	}
+
+	b, _ := json.Marshal(body)
+	config := &apiConfig{
+		path: "zadd",
+	}
+	var response map[string]interface{}
+	err := c.postJSON(ctx, config, b, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (c *Client) Zrem(ctx context.Context, key string, members ...string) (int, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Zrem(c.outgoing(ctx), &cachepb.ZremRequest{Key: key, Members: members})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return int(r.Removed), nil
+	}
+
+	b, _ := json.Marshal(&zremBody{Key: key, Value: members})
+	config := &apiConfig{
+		path: "zrem",
+	}
+	var response struct {
+		Removed int `json:"removed"`
+	}
+	err := c.postJSON(ctx, config, b, &response)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Removed, nil
+}
+
+func (c *Client) Zscore(ctx context.Context, key string, member string) (float64, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Zscore(c.outgoing(ctx), &cachepb.ZscoreRequest{Key: key, Member: member})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return r.Score, nil
+	}
+
+	config := &apiConfig{
+		path: "zscore/" + key + "/" + member,
+	}
+	var response float64
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response, nil
+}
+
+func (c *Client) Zrank(ctx context.Context, key string, member string) (int, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Zrank(c.outgoing(ctx), &cachepb.ZrankRequest{Key: key, Member: member})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return int(r.Rank), nil
+	}
+
+	return c.zrank(ctx, "zrank/", key, member)
+}
+
+func (c *Client) Zrevrank(ctx context.Context, key string, member string) (int, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Zrevrank(c.outgoing(ctx), &cachepb.ZrevrankRequest{Key: key, Member: member})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return int(r.Rank), nil
+	}
+
+	return c.zrank(ctx, "zrevrank/", key, member)
+}
+
+func (c *Client) zrank(ctx context.Context, path string, key string, member string) (int, error) {
+	config := &apiConfig{
+		path: path + key + "/" + member,
+	}
+	var response int
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response, nil
+}
+
+// Zrange returns members by rank from start to stop inclusive, negative
+// indexes count from the end.
+func (c *Client) Zrange(ctx context.Context, key string, start int, stop int, rev bool) ([]ZMember, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Zrange(c.outgoing(ctx), &cachepb.ZrangeRequest{Key: key, Start: int64(start), Stop: int64(stop), Rev: rev})
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return zmembers(r.Members), nil
+	}
+
+	config := &apiConfig{
+		path: "zrange/" + key + "/" + strconv.Itoa(start) + "/" + strconv.Itoa(stop) + "?rev=" + strconv.FormatBool(rev),
+	}
+	var response []ZMember
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// ZrangeByScore returns members with scores between min and max. The ends
+// are written as "1.5", "(1.5" to exclude it, "-inf" or "+inf". A negative
+// count returns all members after offset.
+func (c *Client) ZrangeByScore(ctx context.Context, key string, min string, max string, offset int, count int, rev bool) ([]ZMember, error) {
+	if c.grpc != nil {
+		n := int64(count)
+		req := &cachepb.ZrangeByScoreRequest{Key: key, Min: min, Max: max, Offset: int64(offset), Count: &n, Rev: rev}
+		r, err := c.grpc.cache.ZrangeByScore(c.outgoing(ctx), req)
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return zmembers(r.Members), nil
+	}
+
+	query := url.Values{}
+	query.Set("min", min)
+	query.Set("max", max)
+	query.Set("offset", strconv.Itoa(offset))
+	query.Set("count", strconv.Itoa(count))
+	query.Set("rev", strconv.FormatBool(rev))
+	config := &apiConfig{
+		path: "zrangebyscore/" + key + "?" + query.Encode(),
+	}
+	var response []ZMember
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (c *Client) Zcount(ctx context.Context, key string, min string, max string) (int, error) {
+	if c.grpc != nil {
+		r, err := c.grpc.cache.Zcount(c.outgoing(ctx), &cachepb.ZcountRequest{Key: key, Min: min, Max: max})
+		if err != nil {
+			return 0, grpcError(err)
+		}
+		return int(r.Count), nil
+	}
+
+	query := url.Values{}
+	query.Set("min", min)
+	query.Set("max", max)
+	config := &apiConfig{
+		path: "zcount/" + key + "?" + query.Encode(),
+	}
+	var response int
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return response, nil
+}
+
+func (c *Client) ZpopMin(ctx context.Context, key string, count int) ([]ZMember, error) {
+	if c.grpc != nil {
+		n := int64(count)
+		r, err := c.grpc.cache.ZpopMin(c.outgoing(ctx), &cachepb.ZpopMinRequest{Key: key, Count: &n})
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return zmembers(r.Members), nil
+	}
+
+	return c.zpop(ctx, "zpopmin/", key, count)
+}
+
+func (c *Client) ZpopMax(ctx context.Context, key string, count int) ([]ZMember, error) {
+	if c.grpc != nil {
+		n := int64(count)
+		r, err := c.grpc.cache.ZpopMax(c.outgoing(ctx), &cachepb.ZpopMaxRequest{Key: key, Count: &n})
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return zmembers(r.Members), nil
+	}
+
+	return c.zpop(ctx, "zpopmax/", key, count)
+}
+
+func (c *Client) zpop(ctx context.Context, path string, key string, count int) ([]ZMember, error) {
+	config := &apiConfig{
+		path: path + key + "?count=" + strconv.Itoa(count),
+	}
+	var response []ZMember
+	err := c.getJSON(ctx, config, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}